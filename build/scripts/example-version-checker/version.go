@@ -14,7 +14,7 @@ import (
 const examplesDir = "examples"
 
 var excludedPatterns = [...]string{"*.md", "*.yaml", "*.yml", "OWNERS", ".gitignore"}
-var excludedExamples = []string{"autoscaler-wasm"}
+var excludedExamples = []string{"allocation-scoring-wasm", "autoscaler-wasm"}
 
 func dirIsExample(dirName string) bool {
 	makefileName := fmt.Sprintf("%s/Makefile", dirName)
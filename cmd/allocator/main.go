@@ -29,13 +29,16 @@ import (
 	"agones.dev/agones/pkg"
 	"agones.dev/agones/pkg/allocation/converters"
 	pb "agones.dev/agones/pkg/allocation/go"
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
 	"agones.dev/agones/pkg/client/clientset/versioned"
 	"agones.dev/agones/pkg/client/informers/externalversions"
+	listerv1 "agones.dev/agones/pkg/client/listers/agones/v1"
 	"agones.dev/agones/pkg/gameserverallocations"
 	"agones.dev/agones/pkg/gameservers"
 	"agones.dev/agones/pkg/metrics"
 	"agones.dev/agones/pkg/processor"
+	"agones.dev/agones/pkg/util/connectiontoken"
 	"agones.dev/agones/pkg/util/fswatch"
 	"github.com/heptiolabs/healthcheck"
 	"github.com/pkg/errors"
@@ -50,11 +53,18 @@ import (
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	corev1lister "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	"agones.dev/agones/pkg/util/httpserver"
 	"agones.dev/agones/pkg/util/runtime"
@@ -91,6 +101,14 @@ const (
 	processorGRPCAddress             = "processor-grpc-address"
 	processorGRPCPort                = "processor-grpc-port"
 	processorMaxBatchSize            = "processor-max-batch-size"
+	processorFallbackEnabledFlag     = "processor-fallback-enabled"
+	processorFallbackThresholdFlag   = "processor-fallback-threshold"
+	processorHighPriorityClientsFlag = "processor-high-priority-clients"
+	allocationQuotasFlag             = "allocation-quotas"
+	tokenAuthConfigFlag              = "token-auth-config"
+	connectionTokenSigningKeyFlag    = "connection-token-signing-key"
+	connectionTokenTTLFlag           = "connection-token-ttl"
+	wasmScoringAllowedURLsFlag       = "wasm-scoring-allowed-urls"
 )
 
 func parseEnvFlags() config {
@@ -112,6 +130,14 @@ func parseEnvFlags() config {
 	viper.SetDefault(processorGRPCAddress, "agones-processor.agones-system.svc.cluster.local")
 	viper.SetDefault(processorGRPCPort, 9090)
 	viper.SetDefault(processorMaxBatchSize, 100)
+	viper.SetDefault(processorFallbackEnabledFlag, false)
+	viper.SetDefault(processorFallbackThresholdFlag, 10*time.Second)
+	viper.SetDefault(processorHighPriorityClientsFlag, "")
+	viper.SetDefault(allocationQuotasFlag, "")
+	viper.SetDefault(tokenAuthConfigFlag, "")
+	viper.SetDefault(connectionTokenSigningKeyFlag, "")
+	viper.SetDefault(connectionTokenTTLFlag, connectiontoken.DefaultTTL)
+	viper.SetDefault(wasmScoringAllowedURLsFlag, "")
 
 	pflag.Int32(httpPortFlag, viper.GetInt32(httpPortFlag), "Port to listen on for REST requests")
 	pflag.Int32(grpcPortFlag, viper.GetInt32(grpcPortFlag), "Port to listen on for gRPC requests")
@@ -132,6 +158,14 @@ func parseEnvFlags() config {
 	pflag.String(processorGRPCAddress, viper.GetString(processorGRPCAddress), "The gRPC address of the Agones Processor service")
 	pflag.Int32(processorGRPCPort, viper.GetInt32(processorGRPCPort), "The gRPC port of the Agones Processor service")
 	pflag.Int32(processorMaxBatchSize, viper.GetInt32(processorMaxBatchSize), "The maximum batch size to send to the Agones Processor service")
+	pflag.Bool(processorFallbackEnabledFlag, viper.GetBool(processorFallbackEnabledFlag), "Allocate locally while the Agones Processor service is unhealthy. Can also use PROCESSOR_FALLBACK_ENABLED env variable.")
+	pflag.Duration(processorFallbackThresholdFlag, viper.GetDuration(processorFallbackThresholdFlag), "How long the Agones Processor service can be unreachable before falling back to local allocation. Can also use PROCESSOR_FALLBACK_THRESHOLD env variable.")
+	pflag.String(processorHighPriorityClientsFlag, viper.GetString(processorHighPriorityClientsFlag), "Comma separated client certificate identities that can send High priority allocation requests to the Agones Processor service. High priority requests from other clients are downgraded to Normal, unless their bearer token grant allows High priority. Can also use PROCESSOR_HIGH_PRIORITY_CLIENTS env variable.")
+	pflag.String(allocationQuotasFlag, viper.GetString(allocationQuotasFlag), "YAML or JSON per-client allocation quota rules. Only used when the AllocatorQuotas feature gate is enabled. Can also use ALLOCATION_QUOTAS env variable.")
+	pflag.String(tokenAuthConfigFlag, viper.GetString(tokenAuthConfigFlag), "YAML or JSON bearer token issuers and grants. Only used when the AllocatorTokenAuth feature gate is enabled. Can also use TOKEN_AUTH_CONFIG env variable.")
+	pflag.String(connectionTokenSigningKeyFlag, viper.GetString(connectionTokenSigningKeyFlag), "Path to the PEM encoded private key that signs allocation connection tokens. Only used when the ConnectionTokens feature gate is enabled. Can also use CONNECTION_TOKEN_SIGNING_KEY env variable.")
+	pflag.Duration(connectionTokenTTLFlag, viper.GetDuration(connectionTokenTTLFlag), "How long allocation connection tokens are valid for. Can also use CONNECTION_TOKEN_TTL env variable.")
+	pflag.String(wasmScoringAllowedURLsFlag, viper.GetString(wasmScoringAllowedURLsFlag), "Comma separated URLs of the Wasm scoring modules that allocations can use. Only used when the WasmAllocationScoring feature gate is enabled. Can also use WASM_SCORING_ALLOWED_URLS env variable.")
 
 	runtime.FeaturesBindFlags()
 	pflag.Parse()
@@ -153,6 +187,14 @@ func parseEnvFlags() config {
 	runtime.Must(viper.BindEnv(allocationBatchWaitTime))
 	runtime.Must(viper.BindEnv(readinessShutdownDuration))
 	runtime.Must(viper.BindEnv(httpUnallocatedStatusCode))
+	runtime.Must(viper.BindEnv(allocationQuotasFlag))
+	runtime.Must(viper.BindEnv(tokenAuthConfigFlag))
+	runtime.Must(viper.BindEnv(connectionTokenSigningKeyFlag))
+	runtime.Must(viper.BindEnv(connectionTokenTTLFlag))
+	runtime.Must(viper.BindEnv(wasmScoringAllowedURLsFlag))
+	runtime.Must(viper.BindEnv(processorFallbackEnabledFlag))
+	runtime.Must(viper.BindEnv(processorFallbackThresholdFlag))
+	runtime.Must(viper.BindEnv(processorHighPriorityClientsFlag))
 	runtime.Must(viper.BindPFlags(pflag.CommandLine))
 	runtime.Must(runtime.FeaturesBindEnv())
 
@@ -178,6 +220,14 @@ func parseEnvFlags() config {
 		processorGRPCAddress:         viper.GetString(processorGRPCAddress),
 		processorGRPCPort:            int(viper.GetInt32(processorGRPCPort)),
 		processorMaxBatchSize:        int(viper.GetInt32(processorMaxBatchSize)),
+		processorFallbackEnabled:     viper.GetBool(processorFallbackEnabledFlag),
+		processorFallbackThreshold:   viper.GetDuration(processorFallbackThresholdFlag),
+		processorHighPriorityClients: strings.Split(viper.GetString(processorHighPriorityClientsFlag), ","),
+		allocationQuotas:             viper.GetString(allocationQuotasFlag),
+		tokenAuthConfig:              viper.GetString(tokenAuthConfigFlag),
+		connectionTokenSigningKey:    viper.GetString(connectionTokenSigningKeyFlag),
+		connectionTokenTTL:           viper.GetDuration(connectionTokenTTLFlag),
+		wasmScoringAllowedURLs:       strings.Split(viper.GetString(wasmScoringAllowedURLsFlag), ","),
 	}
 }
 
@@ -201,6 +251,14 @@ type config struct {
 	processorGRPCAddress         string
 	processorGRPCPort            int
 	processorMaxBatchSize        int
+	processorFallbackEnabled     bool
+	processorFallbackThreshold   time.Duration
+	processorHighPriorityClients []string
+	allocationQuotas             string
+	tokenAuthConfig              string
+	connectionTokenSigningKey    string
+	connectionTokenTTL           time.Duration
+	wasmScoringAllowedURLs       []string
 }
 
 // grpcHandlerFunc returns an http.Handler that delegates to grpcServer on incoming gRPC
@@ -232,6 +290,10 @@ func main() {
 		runtime.SetLevel(logrus.InfoLevel)
 	}
 
+	if runtime.FeatureEnabled(runtime.FeatureWasmAllocationScoring) {
+		allocationv1.SetAllowedWasmScoringURLs(conf.wasmScoringAllowedURLs)
+	}
+
 	if !validPort(conf.GRPCPort) && !validPort(conf.HTTPPort) {
 		logger.WithField("grpc-port", conf.GRPCPort).WithField("http-port", conf.HTTPPort).Fatal("Must specify a valid gRPC port or an HTTP port for the allocator service")
 	}
@@ -283,16 +345,22 @@ func main() {
 	workerCtx, cancelWorkerCtx := context.WithCancel(context.Background())
 
 	var h *serviceHandler
+	var processorClient processor.Client
 	if runtime.FeatureEnabled(runtime.FeatureProcessorAllocator) {
 		processorConfig := processor.Config{
-			ClientID:          os.Getenv("POD_NAME"),
-			ProcessorAddress:  fmt.Sprintf("%s:%d", conf.processorGRPCAddress, conf.processorGRPCPort),
-			MaxBatchSize:      conf.processorMaxBatchSize,
-			AllocationTimeout: 30 * time.Second,
-			ReconnectInterval: 5 * time.Second,
+			ClientID:           os.Getenv("POD_NAME"),
+			ProcessorAddress:   fmt.Sprintf("%s:%d", conf.processorGRPCAddress, conf.processorGRPCPort),
+			MaxBatchSize:       conf.processorMaxBatchSize,
+			AllocationTimeout:  30 * time.Second,
+			ReconnectInterval:  5 * time.Second,
+			UnhealthyThreshold: conf.processorFallbackThreshold,
 		}
 
-		processorClient := processor.NewClient(processorConfig, logger.WithField("component", "processor-client"))
+		if runtime.FeatureEnabled(runtime.FeatureProcessorSharding) {
+			processorClient = processor.NewShardedClient(processorConfig, kubeClient, os.Getenv("POD_NAMESPACE"), logger.WithField("component", "processor-client"))
+		} else {
+			processorClient = processor.NewClient(processorConfig, logger.WithField("component", "processor-client"))
+		}
 
 		go func() {
 			if err := processorClient.Run(workerCtx); err != nil {
@@ -303,11 +371,54 @@ func main() {
 				logger.WithError(err).Error("Processor client failed, initiating graceful shutdown")
 			}
 		}()
+	}
 
-		h = newProcessorServiceHandler(processorClient, conf.MTLSDisabled, conf.TLSDisabled)
+	if processorClient != nil && !conf.processorFallbackEnabled {
+		h = newProcessorServiceHandler(workerCtx, processorClient, kubeClient, agonesClient, conf.MTLSDisabled, conf.TLSDisabled)
 	} else {
+		var tokenSigner *connectiontoken.Signer
+		if runtime.FeatureEnabled(runtime.FeatureConnectionTokens) && conf.connectionTokenSigningKey != "" {
+			tokenSigner, err = connectiontoken.LoadSigner(conf.connectionTokenSigningKey, conf.connectionTokenTTL)
+			if err != nil {
+				logger.WithError(err).Fatal("could not load connection token signing key")
+			}
+		}
 		grpcUnallocatedStatusCode := grpcCodeFromHTTPStatus(conf.httpUnallocatedStatusCode)
-		h = newServiceHandler(workerCtx, kubeClient, agonesClient, health, conf.MTLSDisabled, conf.TLSDisabled, conf.remoteAllocationTimeout, conf.totalRemoteAllocationTimeout, conf.allocationBatchWaitTime, grpcUnallocatedStatusCode)
+		h = newServiceHandler(workerCtx, kubeClient, agonesClient, health, conf.MTLSDisabled, conf.TLSDisabled, conf.remoteAllocationTimeout, conf.totalRemoteAllocationTimeout, conf.allocationBatchWaitTime, grpcUnallocatedStatusCode, tokenSigner)
+		if processorClient != nil {
+			// allocate through the processor, falling back to the local allocator while it is unhealthy
+			h.processorClient = processorClient
+			h.processorFallback = processor.NewFallback(processorClient, logger.WithField("component", "processor-fallback"))
+		}
+	}
+
+	if runtime.FeatureEnabled(runtime.FeatureProcessorAllocator) {
+		h.highPriorityClients = map[string]bool{}
+		for _, client := range conf.processorHighPriorityClients {
+			if client = strings.TrimSpace(client); client != "" {
+				h.highPriorityClients[client] = true
+			}
+		}
+	}
+
+	if runtime.FeatureEnabled(runtime.FeatureAllocatorQuotas) {
+		h.quotas = newQuotaLimiterFromConfig(workerCtx, agonesClient, conf.allocationQuotas)
+	}
+
+	if runtime.FeatureEnabled(runtime.FeatureAllocatorTokenAuth) {
+		tokenAuthConf, err := parseTokenAuthConfig(conf.tokenAuthConfig)
+		if err != nil {
+			logger.WithError(err).Fatal("could not load token authentication configuration")
+		}
+		h.tokenAuth, err = newTokenAuthenticator(tokenAuthConf)
+		if err != nil {
+			logger.WithError(err).Fatal("could not create token authenticator")
+		}
+		h.tokenAuth.Run(workerCtx)
+	}
+
+	if runtime.FeatureEnabled(runtime.FeatureCrossNamespaceAllocation) && (h.tokenAuth != nil || h.quotas != nil) {
+		h.namespaceLister = newNamespaceLister(workerCtx, kubeClient)
 	}
 
 	if !h.tlsDisabled {
@@ -416,7 +527,7 @@ func runHTTP(listenCtx context.Context, workerCtx context.Context, h *serviceHan
 	server := &http.Server{
 		Addr:      fmt.Sprintf(":%d", httpPort),
 		TLSConfig: cfg,
-		Handler:   handler,
+		Handler:   withClientIdentity(handler),
 	}
 
 	go func() {
@@ -471,13 +582,58 @@ func runGRPC(ctx context.Context, h *serviceHandler, grpcHealth *grpchealth.Serv
 	}()
 }
 
-func newProcessorServiceHandler(processorClient processor.Client, mTLSDisabled, tlsDisabled bool) *serviceHandler {
+// newNamespaceLister returns a synced lister of the namespaces in the cluster
+func newNamespaceLister(ctx context.Context, kubeClient kubernetes.Interface) corev1lister.NamespaceLister {
+	kubeInformerFactory := informers.NewSharedInformerFactory(kubeClient, 30*time.Second)
+	namespaces := kubeInformerFactory.Core().V1().Namespaces()
+	lister := namespaces.Lister()
+	kubeInformerFactory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), namespaces.Informer().HasSynced) {
+		logger.Fatal("failed to wait for the namespace cache to sync")
+	}
+	return lister
+}
+
+// newGameServerLister starts a GameServer informer, and returns its lister once it has synced
+func newGameServerLister(ctx context.Context, agonesClient versioned.Interface) listerv1.GameServerLister {
+	agonesInformerFactory := externalversions.NewSharedInformerFactory(agonesClient, 30*time.Second)
+	gameServers := agonesInformerFactory.Agones().V1().GameServers()
+	lister := gameServers.Lister()
+	agonesInformerFactory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), gameServers.Informer().HasSynced) {
+		logger.Fatal("failed to wait for the GameServer cache to sync")
+	}
+	return lister
+}
+
+// newEventRecorder returns a recorder of events on the GameServers handled by the allocator itself
+func newEventRecorder(kubeClient kubernetes.Interface) record.EventRecorder {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(logger.Debugf)
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+	return eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "GameServerAllocation-Allocator"})
+}
+
+func newProcessorServiceHandler(ctx context.Context, processorClient processor.Client, kubeClient kubernetes.Interface, agonesClient versioned.Interface, mTLSDisabled, tlsDisabled bool) *serviceHandler {
+	recorder := newEventRecorder(kubeClient)
 	h := serviceHandler{
+		// releases do not go through the processor, as they target a specific GameServer
+		releaseCallback: func(ctx context.Context, req gameserverallocations.ReleaseRequest) (*agonesv1.GameServer, error) {
+			return gameserverallocations.ReleaseGameServer(ctx, agonesClient.AgonesV1(), recorder, req)
+		},
 		mTLSDisabled:    mTLSDisabled,
 		tlsDisabled:     tlsDisabled,
 		processorClient: processorClient,
 	}
 
+	if runtime.FeatureEnabled(runtime.FeatureCapacityQuery) {
+		// there is no allocation cache without the processor, so capacity is counted from a GameServer informer cache
+		gameServerLister := newGameServerLister(ctx, agonesClient)
+		h.capacityCallback = func(_ context.Context, query *allocationv1.GameServerCapacityQuery) (k8sruntime.Object, error) {
+			return gameserverallocations.QueryGameServerCapacity(gameServerLister, query)
+		}
+	}
+
 	if !h.tlsDisabled {
 		tlsCert, err := readTLSCert()
 		if err != nil {
@@ -501,7 +657,7 @@ func newProcessorServiceHandler(processorClient processor.Client, mTLSDisabled,
 	return &h
 }
 
-func newServiceHandler(ctx context.Context, kubeClient kubernetes.Interface, agonesClient versioned.Interface, health healthcheck.Handler, mTLSDisabled bool, tlsDisabled bool, remoteAllocationTimeout time.Duration, totalRemoteAllocationTimeout time.Duration, allocationBatchWaitTime time.Duration, grpcUnallocatedStatusCode codes.Code) *serviceHandler {
+func newServiceHandler(ctx context.Context, kubeClient kubernetes.Interface, agonesClient versioned.Interface, health healthcheck.Handler, mTLSDisabled bool, tlsDisabled bool, remoteAllocationTimeout time.Duration, totalRemoteAllocationTimeout time.Duration, allocationBatchWaitTime time.Duration, grpcUnallocatedStatusCode codes.Code, tokenSigner *connectiontoken.Signer) *serviceHandler {
 	defaultResync := 30 * time.Second
 	agonesInformerFactory := externalversions.NewSharedInformerFactory(agonesClient, defaultResync)
 	kubeInformerFactory := informers.NewSharedInformerFactory(kubeClient, defaultResync)
@@ -510,17 +666,23 @@ func newServiceHandler(ctx context.Context, kubeClient kubernetes.Interface, ago
 	allocator := gameserverallocations.NewAllocator(
 		agonesInformerFactory.Multicluster().V1().GameServerAllocationPolicies(),
 		kubeInformerFactory.Core().V1().Secrets(),
+		kubeInformerFactory.Core().V1().Namespaces(),
 		agonesClient.AgonesV1(),
 		kubeClient,
 		gameserverallocations.NewAllocationCache(agonesInformerFactory.Agones().V1().GameServers(), gsCounter, health),
 		remoteAllocationTimeout,
 		totalRemoteAllocationTimeout,
-		allocationBatchWaitTime)
+		allocationBatchWaitTime,
+		tokenSigner)
 
 	h := serviceHandler{
 		allocationCallback: func(gsa *allocationv1.GameServerAllocation) (k8sruntime.Object, error) {
 			return allocator.Allocate(ctx, gsa)
 		},
+		releaseCallback: allocator.Release,
+		capacityCallback: func(_ context.Context, query *allocationv1.GameServerCapacityQuery) (k8sruntime.Object, error) {
+			return allocator.QueryCapacity(query)
+		},
 		mTLSDisabled:              mTLSDisabled,
 		tlsDisabled:               tlsDisabled,
 		grpcUnallocatedStatusCode: grpcUnallocatedStatusCode,
@@ -555,6 +717,31 @@ func newServiceHandler(ctx context.Context, kubeClient kubernetes.Interface, ago
 	return &h
 }
 
+// newQuotaLimiterFromConfig parses the quota configuration, and starts a GameServer informer
+// if any quota rule limits the number of Allocated GameServers.
+func newQuotaLimiterFromConfig(ctx context.Context, agonesClient versioned.Interface, data string) *quotaLimiter {
+	quotaConf, err := parseQuotaConfig(data)
+	if err != nil {
+		logger.WithError(err).Fatal("could not load allocation quotas")
+	}
+	logger.WithField("quotas", quotaConf).Info("Allocation quotas enabled")
+
+	if !quotaConf.needsGameServerIndexer() {
+		return newQuotaLimiter(quotaConf, nil)
+	}
+
+	agonesInformerFactory := externalversions.NewSharedInformerFactory(agonesClient, 30*time.Second)
+	gameServers := agonesInformerFactory.Agones().V1().GameServers().Informer()
+	if err := gameServers.AddIndexers(quotaGameServerIndexers()); err != nil {
+		logger.WithError(err).Fatal("could not index GameServers for allocation quotas")
+	}
+	agonesInformerFactory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), gameServers.HasSynced) {
+		logger.Fatal("could not sync GameServer cache for allocation quotas")
+	}
+	return newQuotaLimiter(quotaConf, gameServers.GetIndexer())
+}
+
 func readTLSCert() (*tls.Certificate, error) {
 	tlsCert, err := tls.LoadX509KeyPair(tlsDir+"tls.crt", tlsDir+"tls.key")
 	if err != nil {
@@ -714,6 +901,8 @@ func getCACertPool(path string) (*x509.CertPool, error) {
 
 type serviceHandler struct {
 	allocationCallback func(*allocationv1.GameServerAllocation) (k8sruntime.Object, error)
+	releaseCallback    func(context.Context, gameserverallocations.ReleaseRequest) (*agonesv1.GameServer, error)
+	capacityCallback   func(context.Context, *allocationv1.GameServerCapacityQuery) (k8sruntime.Object, error)
 
 	certMutex  sync.RWMutex
 	caCertPool *x509.CertPool
@@ -727,6 +916,16 @@ type serviceHandler struct {
 	grpcUnallocatedStatusCode codes.Code
 
 	processorClient processor.Client
+	// processorFallback is set when allocations fall back to the local allocator while the processor is unhealthy
+	processorFallback *processor.Fallback
+	// highPriorityClients are the client certificate identities that can send High priority requests to the processor
+	highPriorityClients map[string]bool
+
+	quotas    *quotaLimiter
+	tokenAuth *tokenAuthenticator
+	// namespaceLister is set to resolve the namespace selectors of cross namespace allocations,
+	// so that every namespace they search is authorized, and charged to its quota
+	namespaceLister corev1lister.NamespaceLister
 }
 
 // Allocate implements the Allocate gRPC method definition
@@ -736,9 +935,70 @@ func (h *serviceHandler) Allocate(ctx context.Context, in *pb.AllocationRequest)
 	gsa := converters.ConvertAllocationRequestToGSA(in)
 	gsa.ApplyDefaults()
 
+	var principal *tokenPrincipal
+	var grants []*tokenGrant
+	if h.tokenAuth != nil {
+		var err error
+		if principal, err = h.tokenAuth.authenticate(ctx); err != nil {
+			logger.WithError(err).Warn("allocation request authentication failed")
+			return nil, err
+		}
+	}
+
+	// the namespaces a request searches are checked against each namespace's grant and quota
+	if h.namespaceLister != nil {
+		if err := gameserverallocations.ResolveNamespaceSelector(h.namespaceLister, gsa); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if principal != nil {
+		for _, ns := range authorizedNamespaces(gsa) {
+			grant, err := principal.authorize(ns)
+			if err != nil {
+				logger.WithError(err).Warn("allocation request authorization failed")
+				return nil, err
+			}
+			grants = append(grants, grant)
+		}
+		if err := restrictGrantSelectors(gsa, grants); err != nil {
+			logger.WithError(err).Warn("allocation request authorization failed")
+			return nil, err
+		}
+	}
+
+	// only the allocator records which client allocated a GameServer, so that a request cannot
+	// charge its allocations to the quota of another client
+	delete(gsa.Spec.MetaPatch.Annotations, allocatorClientAnnotationKey)
+
+	if h.quotas != nil {
+		client := clientIdentity(ctx)
+		if client == "" && principal != nil {
+			client = principal.subject
+		}
+		// the request can allocate from any namespace it searches, so is charged to the quota of each of them
+		for _, ns := range authorizedNamespaces(gsa) {
+			if err := h.quotas.check(ctx, client, ns); err != nil {
+				logger.WithField("client", client).WithField("gsa", gsa).WithError(err).Warn("allocation quota exceeded")
+				return nil, err
+			}
+		}
+		if client != "" {
+			if gsa.Spec.MetaPatch.Annotations == nil {
+				gsa.Spec.MetaPatch.Annotations = map[string]string{}
+			}
+			gsa.Spec.MetaPatch.Annotations[allocatorClientAnnotationKey] = client
+		}
+	}
+
+	var req *pb.AllocationRequest
 	if runtime.FeatureEnabled(runtime.FeatureProcessorAllocator) {
-		req := converters.ConvertGSAToAllocationRequest(gsa)
+		req = converters.ConvertGSAToAllocationRequest(gsa)
+		// the priority lane only applies to the processor, so is not part of the GameServerAllocation
+		req.RequestPriority = h.requestPriority(ctx, in.GetRequestPriority(), grants)
+	}
 
+	if req != nil && !h.processorFallback.Degraded(req) {
 		resp, err := h.processorClient.Allocate(ctx, req)
 		if err != nil {
 			logger.WithField("gsa", gsa).WithError(err).Error("allocation failed")
@@ -773,6 +1033,125 @@ func (h *serviceHandler) Allocate(ctx context.Context, in *pb.AllocationRequest)
 	return response, err
 }
 
+// requestPriority returns the processor priority lane of an allocation request. The High lane is only
+// for clients in highPriorityClients, and bearer tokens whose grants all allow it, so that other
+// callers cannot skip the queue under load. Their High priority requests are downgraded to Normal.
+func (h *serviceHandler) requestPriority(ctx context.Context, priority pb.AllocationRequest_RequestPriority, grants []*tokenGrant) pb.AllocationRequest_RequestPriority {
+	if priority != pb.AllocationRequest_High {
+		return priority
+	}
+	client := clientIdentity(ctx)
+	if client != "" && h.highPriorityClients[client] {
+		return priority
+	}
+	if len(grants) > 0 && allowHighPriority(grants) {
+		return priority
+	}
+	logger.WithField("client", client).Debug("client is not allowed High priority allocation requests, downgrading to Normal")
+	return pb.AllocationRequest_Normal
+}
+
+// Release implements the Release gRPC method definition
+func (h *serviceHandler) Release(ctx context.Context, in *pb.ReleaseRequest) (*pb.ReleaseResponse, error) {
+	if !runtime.FeatureEnabled(runtime.FeatureAllocatorRelease) {
+		return nil, status.Errorf(codes.Unimplemented, "%s feature gate is not enabled", runtime.FeatureAllocatorRelease)
+	}
+	logger.WithField("request", in).Infof("release request received.")
+
+	if in.GetNamespace() == "" || in.GetGameServerName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "namespace and gameServerName are required")
+	}
+
+	req := gameserverallocations.ReleaseRequest{
+		Namespace:     in.GetNamespace(),
+		Name:          in.GetGameServerName(),
+		LastAllocated: in.GetLastAllocated(),
+	}
+	if h.tokenAuth != nil {
+		principal, err := h.tokenAuth.authenticate(ctx)
+		if err != nil {
+			logger.WithError(err).Warn("release request authentication failed")
+			return nil, err
+		}
+		grant, err := principal.authorize(in.GetNamespace())
+		if err != nil {
+			logger.WithError(err).Warn("release request authorization failed")
+			return nil, err
+		}
+		// a token can only release the GameServers it could have allocated
+		req.SelectorLabels = grant.SelectorLabels
+	}
+	if in.GetAllocation() != nil {
+		req.Allocation = converters.ConvertAllocationRequestToGSA(in.GetAllocation())
+	}
+
+	gs, err := h.releaseCallback(ctx, req)
+	if err != nil {
+		logger.WithField("request", in).WithError(err).Error("release failed")
+		switch {
+		case k8serrors.IsNotFound(err):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, gameserverallocations.ErrGameServerNotAllocated), errors.Is(err, gameserverallocations.ErrGameServerReallocated):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, gameserverallocations.ErrGameServerNotSelected):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &pb.ReleaseResponse{
+		GameServerName: gs.ObjectMeta.Name,
+		State:          string(gs.Status.State),
+	}
+	logger.WithField("response", response).Infof("release response is being sent")
+
+	return response, nil
+}
+
+// QueryCapacity implements the QueryCapacity gRPC method definition
+func (h *serviceHandler) QueryCapacity(ctx context.Context, in *pb.CapacityRequest) (*pb.CapacityResponse, error) {
+	if !runtime.FeatureEnabled(runtime.FeatureCapacityQuery) {
+		return nil, status.Errorf(codes.Unimplemented, "%s feature gate is not enabled", runtime.FeatureCapacityQuery)
+	}
+	logger.WithField("request", in).Debug("capacity request received.")
+
+	query := converters.ConvertCapacityRequestToCapacityQuery(in)
+	query.ApplyDefaults()
+
+	if h.tokenAuth != nil {
+		principal, err := h.tokenAuth.authenticate(ctx)
+		if err != nil {
+			logger.WithError(err).Warn("capacity request authentication failed")
+			return nil, err
+		}
+		grant, err := principal.authorize(query.ObjectMeta.Namespace)
+		if err != nil {
+			logger.WithError(err).Warn("capacity request authorization failed")
+			return nil, err
+		}
+		if err := grant.restrictSelectorList(query.Spec.Selectors); err != nil {
+			logger.WithError(err).Warn("capacity request authorization failed")
+			return nil, err
+		}
+	}
+
+	resultObj, err := h.capacityCallback(ctx, query)
+	if err != nil {
+		logger.WithField("query", query).WithError(err).Error("capacity query failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if s, ok := resultObj.(*metav1.Status); ok {
+		return nil, status.Error(codes.InvalidArgument, s.Message)
+	}
+
+	result, ok := resultObj.(*allocationv1.GameServerCapacityQuery)
+	if !ok {
+		logger.Errorf("internal server error - Bad GameServerCapacityQuery format %v", resultObj)
+		return nil, status.Errorf(codes.Internal, "internal server error- Bad GameServerCapacityQuery format %v", resultObj)
+	}
+	return converters.ConvertCapacityQueryToCapacityResponse(result), nil
+}
+
 // grpcCodeFromHTTPStatus converts an HTTP status code to the corresponding gRPC status code.
 func grpcCodeFromHTTPStatus(httpUnallocatedStatusCode int) codes.Code {
 	switch httpUnallocatedStatusCode {
@@ -18,19 +18,26 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/pem"
+	"fmt"
 	"net/http"
 	"os"
 	"testing"
 
 	pb "agones.dev/agones/pkg/allocation/go"
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"agones.dev/agones/pkg/gameserverallocations"
+	"agones.dev/agones/pkg/util/runtime"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	k8serror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func TestAllocateHandler(t *testing.T) {
@@ -83,6 +90,182 @@ func TestAllocateHandlerReturnsError(t *testing.T) {
 	}
 }
 
+func TestRequestPriority(t *testing.T) {
+	t.Parallel()
+
+	h := serviceHandler{highPriorityClients: map[string]bool{"matchmaker": true}}
+	matchmaker := context.WithValue(context.Background(), clientIdentityContextKey{}, "matchmaker")
+	other := context.WithValue(context.Background(), clientIdentityContextKey{}, "other")
+	high := &tokenGrant{HighPriority: true}
+	normal := &tokenGrant{}
+
+	fixtures := map[string]struct {
+		ctx      context.Context
+		priority pb.AllocationRequest_RequestPriority
+		grants   []*tokenGrant
+		want     pb.AllocationRequest_RequestPriority
+	}{
+		"low is kept":                    {ctx: other, priority: pb.AllocationRequest_Low, want: pb.AllocationRequest_Low},
+		"allowed client":                 {ctx: matchmaker, priority: pb.AllocationRequest_High, want: pb.AllocationRequest_High},
+		"other client is downgraded":     {ctx: other, priority: pb.AllocationRequest_High, want: pb.AllocationRequest_Normal},
+		"anonymous client is downgraded": {ctx: context.Background(), priority: pb.AllocationRequest_High, want: pb.AllocationRequest_Normal},
+		"allowed grant":                  {ctx: other, priority: pb.AllocationRequest_High, grants: []*tokenGrant{high}, want: pb.AllocationRequest_High},
+		"grant without high priority":    {ctx: other, priority: pb.AllocationRequest_High, grants: []*tokenGrant{high, normal}, want: pb.AllocationRequest_Normal},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, v.want, h.requestPriority(v.ctx, v.priority, v.grants))
+		})
+	}
+}
+
+func TestReleaseHandler(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	gsGroupResource := schema.GroupResource{Group: "agones.dev", Resource: "gameservers"}
+	fixtures := map[string]struct {
+		features string
+		request  *pb.ReleaseRequest
+		err      error
+		wantCode codes.Code
+	}{
+		"feature disabled": {
+			features: fmt.Sprintf("%s=false", runtime.FeatureAllocatorRelease),
+			request:  &pb.ReleaseRequest{Namespace: "default", GameServerName: "gs1"},
+			wantCode: codes.Unimplemented,
+		},
+		"released": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureAllocatorRelease),
+			request:  &pb.ReleaseRequest{Namespace: "default", GameServerName: "gs1"},
+			wantCode: codes.OK,
+		},
+		"missing name": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureAllocatorRelease),
+			request:  &pb.ReleaseRequest{Namespace: "default"},
+			wantCode: codes.InvalidArgument,
+		},
+		"not found": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureAllocatorRelease),
+			request:  &pb.ReleaseRequest{Namespace: "default", GameServerName: "gs1"},
+			err:      k8serror.NewNotFound(gsGroupResource, "gs1"),
+			wantCode: codes.NotFound,
+		},
+		"reallocated": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureAllocatorRelease),
+			request:  &pb.ReleaseRequest{Namespace: "default", GameServerName: "gs1", LastAllocated: "2026-01-01T00:00:00Z"},
+			err:      errors.Wrap(gameserverallocations.ErrGameServerReallocated, "gs1"),
+			wantCode: codes.FailedPrecondition,
+		},
+		"not selected": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureAllocatorRelease),
+			request:  &pb.ReleaseRequest{Namespace: "default", GameServerName: "gs1"},
+			err:      errors.Wrap(gameserverallocations.ErrGameServerNotSelected, "gs1"),
+			wantCode: codes.PermissionDenied,
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			require.NoError(t, runtime.ParseFeatures(v.features))
+
+			h := serviceHandler{
+				releaseCallback: func(_ context.Context, req gameserverallocations.ReleaseRequest) (*agonesv1.GameServer, error) {
+					assert.Equal(t, v.request.GetGameServerName(), req.Name)
+					assert.Equal(t, v.request.GetLastAllocated(), req.LastAllocated)
+					if v.err != nil {
+						return nil, v.err
+					}
+					return &agonesv1.GameServer{
+						ObjectMeta: metav1.ObjectMeta{Name: req.Name, Namespace: req.Namespace},
+						Status:     agonesv1.GameServerStatus{State: agonesv1.GameServerStateRequestReady},
+					}, nil
+				},
+			}
+
+			response, err := h.Release(context.Background(), v.request)
+			if v.wantCode == codes.OK {
+				require.NoError(t, err)
+				assert.Equal(t, "gs1", response.GameServerName)
+				assert.Equal(t, string(agonesv1.GameServerStateRequestReady), response.State)
+				return
+			}
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, v.wantCode, st.Code())
+		})
+	}
+}
+
+func TestQueryCapacityHandler(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	fixtures := map[string]struct {
+		features string
+		request  *pb.CapacityRequest
+		result   k8sruntime.Object
+		err      error
+		wantCode codes.Code
+	}{
+		"feature disabled": {
+			features: fmt.Sprintf("%s=false", runtime.FeatureCapacityQuery),
+			request:  &pb.CapacityRequest{Namespace: "default"},
+			wantCode: codes.Unimplemented,
+		},
+		"counted": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureCapacityQuery),
+			request:  &pb.CapacityRequest{Namespace: "default", GroupByNode: true},
+			wantCode: codes.OK,
+		},
+		"invalid": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureCapacityQuery),
+			request:  &pb.CapacityRequest{Namespace: "default"},
+			result:   &metav1.Status{Status: metav1.StatusFailure, Code: http.StatusUnprocessableEntity, Message: "invalid"},
+			wantCode: codes.InvalidArgument,
+		},
+		"error": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureCapacityQuery),
+			request:  &pb.CapacityRequest{Namespace: "default"},
+			err:      errors.New("could not list"),
+			wantCode: codes.Internal,
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			require.NoError(t, runtime.ParseFeatures(v.features))
+
+			h := serviceHandler{
+				capacityCallback: func(_ context.Context, query *allocationv1.GameServerCapacityQuery) (k8sruntime.Object, error) {
+					assert.Equal(t, v.request.GetNamespace(), query.ObjectMeta.Namespace)
+					assert.Len(t, query.Spec.Selectors, 1)
+					if v.err != nil || v.result != nil {
+						return v.result, v.err
+					}
+					query.Status.Selectors = []allocationv1.SelectorCapacity{{Count: 2, Groups: map[string]int64{"node1": 2}}}
+					return query, nil
+				},
+			}
+
+			response, err := h.QueryCapacity(context.Background(), v.request)
+			if v.wantCode == codes.OK {
+				require.NoError(t, err)
+				require.Len(t, response.Selectors, 1)
+				assert.Equal(t, int64(2), response.Selectors[0].Count)
+				assert.Equal(t, map[string]int64{"node1": 2}, response.Selectors[0].Groups)
+				return
+			}
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, v.wantCode, st.Code())
+		})
+	}
+}
+
 func TestGetTlsCert(t *testing.T) {
 	t.Parallel()
 	cert1, err := tls.X509KeyPair(serverCert1, serverKey1)
@@ -14,8 +14,31 @@
 package main
 
 import (
+	"context"
+
+	mt "agones.dev/agones/pkg/metrics"
 	"go.opencensus.io/plugin/ocgrpc"
+	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+var (
+	keyClient    = mt.MustTagKey("client")
+	keyNamespace = mt.MustTagKey("namespace")
+	keyReason    = mt.MustTagKey("reason")
+
+	allocatorQuotaExceededTotal = stats.Int64("allocator/quota_exceeded", "The number of allocation requests rejected by a quota", "1")
+
+	quotaViews = []*view.View{
+		{
+			Name:        "allocator_quota_exceeded_total",
+			Measure:     allocatorQuotaExceededTotal,
+			Description: "The count of allocation requests rejected because a client quota was exceeded",
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{keyClient, keyNamespace, keyReason},
+		},
+	}
 )
 
 func init() {
@@ -26,4 +49,16 @@ func registerMetricViews() {
 	if err := view.Register(ocgrpc.DefaultServerViews...); err != nil {
 		logger.WithError(err).Error("could not register view")
 	}
+	if err := view.Register(quotaViews...); err != nil {
+		logger.WithError(err).Error("could not register view")
+	}
+}
+
+// recordQuotaExceeded records an allocation request rejected by a quota.
+func recordQuotaExceeded(ctx context.Context, client, namespace, reason string) {
+	mt.RecordWithTags(ctx, []tag.Mutator{
+		tag.Upsert(keyClient, client),
+		tag.Upsert(keyNamespace, namespace),
+		tag.Upsert(keyReason, reason),
+	}, allocatorQuotaExceededTotal.M(1))
 }
@@ -0,0 +1,254 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+	"sync"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// allocatorClientAnnotationKey is the GameServer annotation recording the identity of the
+	// allocator client that allocated it, so Allocated GameServers can be counted against quotas.
+	allocatorClientAnnotationKey = agonesv1.GameServerAllocatorClientAnnotation
+
+	// quotaWildcard matches any client or namespace in a quotaRule
+	quotaWildcard = "*"
+
+	quotaReasonRate      = "rate"
+	quotaReasonAllocated = "allocated"
+
+	// allocatorClientIndex indexes Allocated GameServers by namespace and allocator client
+	allocatorClientIndex = "allocatorClient"
+
+	// maxQuotaLimiters bounds the number of client and namespace pairs that rate limits are tracked for.
+	// The least recently used limiters are dropped first, resetting their burst.
+	maxQuotaLimiters = 10000
+)
+
+// quotaConfig is the configuration for per-client allocation quotas.
+type quotaConfig struct {
+	// Rules are evaluated in order, and the first rule that matches both the client
+	// and the namespace of a request applies. Requests that match no rule are not limited.
+	Rules []quotaRule `json:"rules"`
+}
+
+// quotaRule limits the allocation requests for a client in a namespace.
+type quotaRule struct {
+	// Client is the client certificate identity this rule applies to. Empty or "*" matches any client.
+	Client string `json:"client,omitempty"`
+	// Namespace is the target namespace this rule applies to. Empty or "*" matches any namespace.
+	// A request that searches several namespaces is checked against the rule of each of them.
+	Namespace string `json:"namespace,omitempty"`
+	// RequestsPerSecond is the sustained allocation request rate. Zero means no rate limit.
+	RequestsPerSecond float64 `json:"requestsPerSecond,omitempty"`
+	// Burst is the number of requests that can be made above RequestsPerSecond at once.
+	// Defaults to the ceiling of RequestsPerSecond.
+	Burst int `json:"burst,omitempty"`
+	// MaxAllocated is the maximum number of concurrently Allocated GameServers. Zero means no limit.
+	// It does not apply to clients without an identity, as their GameServers cannot be told apart
+	// from those allocated by other means.
+	MaxAllocated int `json:"maxAllocated,omitempty"`
+}
+
+func (r quotaRule) matches(client, namespace string) bool {
+	return (r.Client == "" || r.Client == quotaWildcard || r.Client == client) &&
+		(r.Namespace == "" || r.Namespace == quotaWildcard || r.Namespace == namespace)
+}
+
+// parseQuotaConfig parses and validates a YAML or JSON quota configuration.
+func parseQuotaConfig(data string) (quotaConfig, error) {
+	var conf quotaConfig
+	if data == "" {
+		return conf, nil
+	}
+	if err := yaml.Unmarshal([]byte(data), &conf); err != nil {
+		return conf, errors.Wrap(err, "could not parse quota configuration")
+	}
+	for i, r := range conf.Rules {
+		if r.RequestsPerSecond < 0 || r.Burst < 0 || r.MaxAllocated < 0 {
+			return conf, errors.Errorf("quota rule %d: values must not be negative", i)
+		}
+	}
+	return conf, nil
+}
+
+// needsGameServerIndexer returns true if any rule limits the number of Allocated GameServers.
+func (c quotaConfig) needsGameServerIndexer() bool {
+	for _, r := range c.Rules {
+		if r.MaxAllocated > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+type quotaKey struct {
+	rule      int
+	client    string
+	namespace string
+}
+
+// quotaLimiter enforces quotaConfig rules for allocation requests.
+type quotaLimiter struct {
+	rules             []quotaRule
+	gameServerIndexer cache.Indexer
+
+	mu       sync.Mutex
+	limiters *lru.Cache
+}
+
+// newQuotaLimiter returns a quotaLimiter for the config. gameServerIndexer must have the
+// indexers of quotaGameServerIndexers, and can be nil if no rule sets MaxAllocated.
+func newQuotaLimiter(conf quotaConfig, gameServerIndexer cache.Indexer) *quotaLimiter {
+	limiters, err := lru.New(maxQuotaLimiters)
+	if err != nil {
+		// only returned for a non positive size
+		panic(err)
+	}
+	return &quotaLimiter{
+		rules:             conf.Rules,
+		gameServerIndexer: gameServerIndexer,
+		limiters:          limiters,
+	}
+}
+
+// quotaGameServerIndexers returns the GameServer informer indexers needed to count Allocated GameServers per client.
+func quotaGameServerIndexers() cache.Indexers {
+	return cache.Indexers{
+		allocatorClientIndex: func(obj interface{}) ([]string, error) {
+			gs, ok := obj.(*agonesv1.GameServer)
+			if !ok || gs.Status.State != agonesv1.GameServerStateAllocated {
+				return nil, nil
+			}
+			client := gs.ObjectMeta.Annotations[allocatorClientAnnotationKey]
+			if client == "" {
+				return nil, nil
+			}
+			return []string{allocatorClientIndexKey(client, gs.ObjectMeta.Namespace)}, nil
+		},
+	}
+}
+
+func allocatorClientIndexKey(client, namespace string) string {
+	return namespace + "/" + client
+}
+
+// check returns a ResourceExhausted error if the client has exceeded a quota for the namespace.
+// Rate limits are tracked separately for each client and namespace pair, even for wildcard rules.
+// The MaxAllocated limit is best effort, as it is based on the informer cache.
+func (q *quotaLimiter) check(ctx context.Context, client, namespace string) error {
+	for i, r := range q.rules {
+		if !r.matches(client, namespace) {
+			continue
+		}
+
+		if r.RequestsPerSecond > 0 && !q.limiter(quotaKey{rule: i, client: client, namespace: namespace}, r).Allow() {
+			recordQuotaExceeded(ctx, client, namespace, quotaReasonRate)
+			return status.Errorf(codes.ResourceExhausted, "allocation rate quota exceeded for client %q in namespace %q", client, namespace)
+		}
+
+		if r.MaxAllocated > 0 && client != "" && q.gameServerIndexer != nil {
+			allocated, err := q.countAllocated(client, namespace)
+			if err != nil {
+				return status.Errorf(codes.Internal, "could not count allocated GameServers: %s", err)
+			}
+			if allocated >= r.MaxAllocated {
+				recordQuotaExceeded(ctx, client, namespace, quotaReasonAllocated)
+				return status.Errorf(codes.ResourceExhausted, "allocated GameServer quota of %d exceeded for client %q in namespace %q", r.MaxAllocated, client, namespace)
+			}
+		}
+
+		return nil
+	}
+	return nil
+}
+
+func (q *quotaLimiter) limiter(key quotaKey, r quotaRule) *rate.Limiter {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if l, ok := q.limiters.Get(key); ok {
+		return l.(*rate.Limiter)
+	}
+
+	burst := r.Burst
+	if burst == 0 {
+		burst = int(r.RequestsPerSecond)
+		if float64(burst) < r.RequestsPerSecond {
+			burst++
+		}
+	}
+	l := rate.NewLimiter(rate.Limit(r.RequestsPerSecond), burst)
+	q.limiters.Add(key, l)
+	return l
+}
+
+// countAllocated returns the number of Allocated GameServers in the namespace that were allocated by the client.
+func (q *quotaLimiter) countAllocated(client, namespace string) (int, error) {
+	keys, err := q.gameServerIndexer.IndexKeys(allocatorClientIndex, allocatorClientIndexKey(client, namespace))
+	if err != nil {
+		return 0, err
+	}
+	return len(keys), nil
+}
+
+type clientIdentityContextKey struct{}
+
+// withClientIdentity stores the identity of the client certificate of REST requests in the
+// request context, as the in process gRPC gateway does not populate the gRPC peer.
+func withClientIdentity(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+			r = r.WithContext(context.WithValue(r.Context(), clientIdentityContextKey{}, certificateIdentity(r.TLS.PeerCertificates[0])))
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// clientIdentity returns the identity of the client certificate of the request, or
+// an empty string if the client did not present a certificate.
+func clientIdentity(ctx context.Context) string {
+	if id, ok := ctx.Value(clientIdentityContextKey{}).(string); ok {
+		return id
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.PeerCertificates) > 0 {
+			return certificateIdentity(tlsInfo.State.PeerCertificates[0])
+		}
+	}
+	return ""
+}
+
+// certificateIdentity returns the subject common name of the certificate, or the full subject if it has none.
+func certificateIdentity(cert *x509.Certificate) string {
+	if cert.Subject.CommonName != "" {
+		return cert.Subject.CommonName
+	}
+	return cert.Subject.String()
+}
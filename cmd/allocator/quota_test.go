@@ -0,0 +1,266 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "agones.dev/agones/pkg/allocation/go"
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	agtesting "agones.dev/agones/pkg/testing"
+	"agones.dev/agones/pkg/util/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestParseQuotaConfig(t *testing.T) {
+	t.Parallel()
+
+	conf, err := parseQuotaConfig("")
+	require.NoError(t, err)
+	assert.Empty(t, conf.Rules)
+	assert.False(t, conf.needsGameServerIndexer())
+
+	conf, err = parseQuotaConfig(`
+rules:
+- client: load-test
+  namespace: default
+  requestsPerSecond: 5
+  burst: 10
+- client: "*"
+  maxAllocated: 100
+`)
+	require.NoError(t, err)
+	assert.Equal(t, []quotaRule{
+		{Client: "load-test", Namespace: "default", RequestsPerSecond: 5, Burst: 10},
+		{Client: "*", MaxAllocated: 100},
+	}, conf.Rules)
+	assert.True(t, conf.needsGameServerIndexer())
+
+	conf, err = parseQuotaConfig(`{"rules":[{"client":"matchmaker","requestsPerSecond":0.5}]}`)
+	require.NoError(t, err)
+	assert.Equal(t, []quotaRule{{Client: "matchmaker", RequestsPerSecond: 0.5}}, conf.Rules)
+
+	_, err = parseQuotaConfig(`{"rules":[{"maxAllocated":-1}]}`)
+	assert.Error(t, err)
+
+	_, err = parseQuotaConfig(`rules: [`)
+	assert.Error(t, err)
+}
+
+func TestQuotaLimiterRate(t *testing.T) {
+	t.Parallel()
+
+	q := newQuotaLimiter(quotaConfig{Rules: []quotaRule{
+		{Client: "load-test", RequestsPerSecond: 0.001, Burst: 2},
+		{Client: "*", Namespace: "default", RequestsPerSecond: 0.001},
+	}}, nil)
+	ctx := context.Background()
+
+	// burst is tracked per client and namespace
+	assert.NoError(t, q.check(ctx, "load-test", "default"))
+	assert.NoError(t, q.check(ctx, "load-test", "default"))
+	assert.NoError(t, q.check(ctx, "load-test", "other"))
+	err := q.check(ctx, "load-test", "default")
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// wildcard rules still limit each client on its own
+	assert.NoError(t, q.check(ctx, "matchmaker", "default"))
+	assert.NoError(t, q.check(ctx, "team-b", "default"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(q.check(ctx, "matchmaker", "default")))
+
+	// no matching rule
+	assert.NoError(t, q.check(ctx, "matchmaker", "other"))
+	assert.NoError(t, q.check(ctx, "matchmaker", "other"))
+}
+
+func TestQuotaLimiterMaxAllocated(t *testing.T) {
+	t.Parallel()
+
+	m := agtesting.NewMocks()
+	gameServers := m.AgonesInformerFactory.Agones().V1().GameServers().Informer()
+	require.NoError(t, gameServers.AddIndexers(quotaGameServerIndexers()))
+	newGameServer := func(name, client string, state agonesv1.GameServerState) *agonesv1.GameServer {
+		return &agonesv1.GameServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "default",
+				Annotations: map[string]string{allocatorClientAnnotationKey: client},
+			},
+			Status: agonesv1.GameServerStatus{State: state},
+		}
+	}
+	for _, gs := range []*agonesv1.GameServer{
+		newGameServer("gs1", "load-test", agonesv1.GameServerStateAllocated),
+		newGameServer("gs2", "load-test", agonesv1.GameServerStateAllocated),
+		newGameServer("gs3", "load-test", agonesv1.GameServerStateReady),
+		newGameServer("gs4", "matchmaker", agonesv1.GameServerStateAllocated),
+		newGameServer("gs5", "", agonesv1.GameServerStateAllocated),
+		newGameServer("gs6", "", agonesv1.GameServerStateAllocated),
+	} {
+		require.NoError(t, gameServers.GetIndexer().Add(gs))
+	}
+
+	q := newQuotaLimiter(quotaConfig{Rules: []quotaRule{{MaxAllocated: 2}}}, gameServers.GetIndexer())
+	ctx := context.Background()
+
+	err := q.check(ctx, "load-test", "default")
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.NoError(t, q.check(ctx, "matchmaker", "default"))
+	assert.NoError(t, q.check(ctx, "load-test", "other"))
+
+	// clients without an identity are not limited, as their GameServers cannot be told apart
+	assert.NoError(t, q.check(ctx, "", "default"))
+
+	// GameServers that are no longer Allocated free up the quota
+	gs2, ok, err := gameServers.GetIndexer().GetByKey("default/gs2")
+	require.NoError(t, err)
+	require.True(t, ok)
+	gs2Copy := gs2.(*agonesv1.GameServer).DeepCopy()
+	gs2Copy.Status.State = agonesv1.GameServerStateShutdown
+	require.NoError(t, gameServers.GetIndexer().Update(gs2Copy))
+	assert.NoError(t, q.check(ctx, "load-test", "default"))
+}
+
+func TestQuotaLimiterBounded(t *testing.T) {
+	t.Parallel()
+
+	q := newQuotaLimiter(quotaConfig{Rules: []quotaRule{{RequestsPerSecond: 0.001}}}, nil)
+	ctx := context.Background()
+	for i := 0; i < maxQuotaLimiters+10; i++ {
+		assert.NoError(t, q.check(ctx, fmt.Sprintf("client-%d", i), "default"))
+	}
+	assert.Equal(t, maxQuotaLimiters, q.limiters.Len())
+}
+
+func TestAllocateHandlerQuotas(t *testing.T) {
+	t.Parallel()
+
+	var annotations map[string]string
+	h := serviceHandler{
+		allocationCallback: func(gsa *allocationv1.GameServerAllocation) (k8sruntime.Object, error) {
+			annotations = gsa.Spec.MetaPatch.Annotations
+			return &allocationv1.GameServerAllocation{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+				Status:     allocationv1.GameServerAllocationStatus{State: allocationv1.GameServerAllocationAllocated},
+			}, nil
+		},
+		quotas: newQuotaLimiter(quotaConfig{Rules: []quotaRule{{RequestsPerSecond: 0.001, Burst: 1}}}, nil),
+	}
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "load-test"}}},
+		}},
+	})
+
+	_, err := h.Allocate(ctx, &pb.AllocationRequest{Namespace: "default"})
+	require.NoError(t, err)
+	assert.Equal(t, "load-test", annotations[allocatorClientAnnotationKey])
+
+	_, err = h.Allocate(ctx, &pb.AllocationRequest{Namespace: "default"})
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestAllocateHandlerQuotasNamespaces(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureCrossNamespaceAllocation)))
+
+	h := serviceHandler{
+		allocationCallback: func(_ *allocationv1.GameServerAllocation) (k8sruntime.Object, error) {
+			return &allocationv1.GameServerAllocation{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "a"},
+				Status:     allocationv1.GameServerAllocationStatus{State: allocationv1.GameServerAllocationAllocated},
+			}, nil
+		},
+		quotas: newQuotaLimiter(quotaConfig{Rules: []quotaRule{{Namespace: "b", RequestsPerSecond: 0.001, Burst: 1}}}, nil),
+	}
+
+	_, err := h.Allocate(context.Background(), &pb.AllocationRequest{Namespace: "a"})
+	require.NoError(t, err)
+	_, err = h.Allocate(context.Background(), &pb.AllocationRequest{Namespace: "a"})
+	require.NoError(t, err)
+
+	// a request that searches another namespace is charged to its quota too
+	_, err = h.Allocate(context.Background(), &pb.AllocationRequest{Namespace: "a", Namespaces: []string{"b"}})
+	require.NoError(t, err)
+	_, err = h.Allocate(context.Background(), &pb.AllocationRequest{Namespace: "a", Namespaces: []string{"b"}})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestAllocateHandlerQuotasClientAnnotation(t *testing.T) {
+	t.Parallel()
+
+	var annotations map[string]string
+	h := serviceHandler{
+		allocationCallback: func(gsa *allocationv1.GameServerAllocation) (k8sruntime.Object, error) {
+			annotations = gsa.Spec.MetaPatch.Annotations
+			return &allocationv1.GameServerAllocation{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+				Status:     allocationv1.GameServerAllocationStatus{State: allocationv1.GameServerAllocationAllocated},
+			}, nil
+		},
+		quotas: newQuotaLimiter(quotaConfig{Rules: []quotaRule{{MaxAllocated: 1}}}, nil),
+	}
+
+	// a request cannot charge its allocations to the quota of another client
+	_, err := h.Allocate(context.Background(), &pb.AllocationRequest{
+		Namespace: "default",
+		Metadata:  &pb.MetaPatch{Annotations: map[string]string{allocatorClientAnnotationKey: "load-test", "map": "searide"}},
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, annotations, allocatorClientAnnotationKey)
+	assert.Equal(t, "searide", annotations["map"])
+}
+
+func TestClientIdentity(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "", clientIdentity(context.Background()))
+
+	cert := &x509.Certificate{Subject: pkix.Name{Organization: []string{"agones"}}}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}},
+	})
+	assert.Equal(t, "O=agones", clientIdentity(ctx))
+
+	var identity string
+	handler := withClientIdentity(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		identity = clientIdentity(r.Context())
+	}))
+	r := httptest.NewRequest(http.MethodPost, "/gameserverallocation", nil)
+	r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "matchmaker"}}}}
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	assert.Equal(t, "matchmaker", identity)
+}
@@ -0,0 +1,383 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// tokenWildcard matches any namespace or claim value in a tokenGrant
+	tokenWildcard = "*"
+
+	defaultJWKSRefreshInterval = 5 * time.Minute
+)
+
+// supportedTokenAlgorithms are the asymmetric JWS algorithms accepted for bearer tokens.
+var supportedTokenAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// tokenAuthConfig is the configuration for bearer token authentication.
+type tokenAuthConfig struct {
+	// Issuers are the trusted token issuers.
+	Issuers []tokenIssuer `json:"issuers"`
+}
+
+// tokenIssuer is a trusted token issuer, and the access granted to its tokens.
+type tokenIssuer struct {
+	// Issuer must match the "iss" claim of the token.
+	Issuer string `json:"issuer"`
+	// Audiences, if set, must contain at least one of the "aud" claim values of the token.
+	Audiences []string `json:"audiences,omitempty"`
+	// JWKSFile is the path to a JSON Web Key Set file with the issuer's signing keys.
+	JWKSFile string `json:"jwksFile,omitempty"`
+	// JWKSURL is the URL of a JSON Web Key Set with the issuer's signing keys. Ignored if JWKSFile is set.
+	JWKSURL string `json:"jwksURL,omitempty"`
+	// RefreshInterval is how often the key set is reloaded. Defaults to 5m.
+	RefreshInterval metav1.Duration `json:"refreshInterval,omitempty"`
+	// Grants map token claims to the namespaces and selectors they can allocate from.
+	// The first grant that matches the token claims and the namespace of a request applies.
+	Grants []tokenGrant `json:"grants"`
+}
+
+// tokenGrant gives tokens with a matching claim access to allocate in namespaces.
+type tokenGrant struct {
+	// Claim is the name of the token claim to match. Defaults to "sub".
+	Claim string `json:"claim,omitempty"`
+	// Values are the claim values that match this grant. The claim can be a string, or
+	// a list of strings that matches if any value matches. "*" matches any value.
+	Values []string `json:"values"`
+	// Namespaces the token can allocate from. "*" matches any namespace.
+	Namespaces []string `json:"namespaces"`
+	// SelectorLabels are added to every GameServerSelector of allocations made with this grant,
+	// restricting which GameServers can be allocated. Requests that select a different value for
+	// one of these labels are rejected, as are cross namespace requests whose namespaces are
+	// granted with different SelectorLabels.
+	SelectorLabels map[string]string `json:"selectorLabels,omitempty"`
+	// HighPriority allows allocations made with this grant to use the High processor priority lane.
+	HighPriority bool `json:"highPriority,omitempty"`
+}
+
+// tokenPrincipal is the verified identity of a bearer token.
+type tokenPrincipal struct {
+	issuer  *tokenIssuer
+	subject string
+	claims  map[string]interface{}
+}
+
+// parseTokenAuthConfig parses and validates a YAML or JSON token authentication configuration.
+func parseTokenAuthConfig(data string) (tokenAuthConfig, error) {
+	var conf tokenAuthConfig
+	if err := yaml.Unmarshal([]byte(data), &conf); err != nil {
+		return conf, errors.Wrap(err, "could not parse token authentication configuration")
+	}
+	if len(conf.Issuers) == 0 {
+		return conf, errors.New("token authentication requires at least one issuer")
+	}
+	for i, iss := range conf.Issuers {
+		if iss.Issuer == "" {
+			return conf, errors.Errorf("token issuer %d: issuer is required", i)
+		}
+		if iss.JWKSFile == "" && iss.JWKSURL == "" {
+			return conf, errors.Errorf("token issuer %q: one of jwksFile or jwksURL is required", iss.Issuer)
+		}
+	}
+	return conf, nil
+}
+
+// issuerKeys holds the current key set of an issuer.
+type issuerKeys struct {
+	issuer tokenIssuer
+
+	mu   sync.RWMutex
+	keys *jose.JSONWebKeySet
+}
+
+// tokenAuthenticator verifies bearer tokens and authorizes allocation requests.
+type tokenAuthenticator struct {
+	issuers    map[string]*issuerKeys
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// newTokenAuthenticator loads the key sets for each issuer in the configuration.
+func newTokenAuthenticator(conf tokenAuthConfig) (*tokenAuthenticator, error) {
+	a := &tokenAuthenticator{
+		issuers:    make(map[string]*issuerKeys, len(conf.Issuers)),
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		now:        time.Now,
+	}
+	for _, iss := range conf.Issuers {
+		ik := &issuerKeys{issuer: iss}
+		if err := a.loadKeys(ik); err != nil {
+			return nil, err
+		}
+		a.issuers[iss.Issuer] = ik
+	}
+	return a, nil
+}
+
+// Run reloads the issuer key sets on their refresh interval until the context is cancelled.
+func (a *tokenAuthenticator) Run(ctx context.Context) {
+	for _, ik := range a.issuers {
+		ik := ik
+		interval := ik.issuer.RefreshInterval.Duration
+		if interval <= 0 {
+			interval = defaultJWKSRefreshInterval
+		}
+		go wait.Until(func() {
+			if err := a.loadKeys(ik); err != nil {
+				logger.WithError(err).WithField("issuer", ik.issuer.Issuer).Error("could not reload JWKS; keeping old one")
+			}
+		}, interval, ctx.Done())
+	}
+}
+
+func (a *tokenAuthenticator) loadKeys(ik *issuerKeys) error {
+	var data []byte
+	var err error
+	if ik.issuer.JWKSFile != "" {
+		data, err = os.ReadFile(ik.issuer.JWKSFile)
+	} else {
+		data, err = a.fetchJWKS(ik.issuer.JWKSURL)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "could not load JWKS for issuer %q", ik.issuer.Issuer)
+	}
+
+	keys := &jose.JSONWebKeySet{}
+	if err := json.Unmarshal(data, keys); err != nil {
+		return errors.Wrapf(err, "could not parse JWKS for issuer %q", ik.issuer.Issuer)
+	}
+
+	ik.mu.Lock()
+	defer ik.mu.Unlock()
+	ik.keys = keys
+	return nil
+}
+
+func (a *tokenAuthenticator) fetchJWKS(url string) ([]byte, error) {
+	resp, err := a.httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() // nolint: errcheck
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status %d fetching %s", resp.StatusCode, url)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// authenticate verifies the bearer token in the request metadata, and returns its principal.
+// Returns an Unauthenticated error if there is no valid token.
+func (a *tokenAuthenticator) authenticate(ctx context.Context) (*tokenPrincipal, error) {
+	raw, err := bearerToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	tok, err := jwt.ParseSigned(raw, supportedTokenAlgorithms)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid bearer token: %s", err)
+	}
+
+	var unverified jwt.Claims
+	if err := tok.UnsafeClaimsWithoutVerification(&unverified); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid bearer token: %s", err)
+	}
+	ik, ok := a.issuers[unverified.Issuer]
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "untrusted token issuer %q", unverified.Issuer)
+	}
+
+	ik.mu.RLock()
+	keys := ik.keys
+	ik.mu.RUnlock()
+
+	var claims jwt.Claims
+	custom := map[string]interface{}{}
+	if err := tok.Claims(keys, &claims, &custom); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "could not verify bearer token: %s", err)
+	}
+	if claims.Expiry == nil {
+		return nil, status.Error(codes.Unauthenticated, "bearer token has no expiry")
+	}
+	if err := claims.Validate(jwt.Expected{
+		Issuer:      ik.issuer.Issuer,
+		AnyAudience: ik.issuer.Audiences,
+		Time:        a.now(),
+	}); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid bearer token claims: %s", err)
+	}
+
+	return &tokenPrincipal{issuer: &ik.issuer, subject: claims.Subject, claims: custom}, nil
+}
+
+// authorize returns the grant that allows the principal to allocate in the namespace.
+// Returns a PermissionDenied error if there is none.
+func (p *tokenPrincipal) authorize(namespace string) (*tokenGrant, error) {
+	for i := range p.issuer.Grants {
+		g := &p.issuer.Grants[i]
+		if g.matchesClaims(p.claims) && g.allowsNamespace(namespace) {
+			return g, nil
+		}
+	}
+	return nil, status.Errorf(codes.PermissionDenied, "token for %q is not allowed to allocate in namespace %q", p.subject, namespace)
+}
+
+// authorizedNamespaces returns the namespaces that a token must be allowed to allocate in for the
+// GameServerAllocation: the namespace of the request, and every other namespace it searches.
+func authorizedNamespaces(gsa *allocationv1.GameServerAllocation) []string {
+	namespaces := []string{gsa.ObjectMeta.Namespace}
+	for _, ns := range gsa.SearchNamespaces() {
+		if ns != gsa.ObjectMeta.Namespace {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces
+}
+
+// allowHighPriority returns true if every grant of a request allows High priority.
+func allowHighPriority(grants []*tokenGrant) bool {
+	for _, g := range grants {
+		if !g.HighPriority {
+			return false
+		}
+	}
+	return true
+}
+
+func (g *tokenGrant) matchesClaims(claims map[string]interface{}) bool {
+	name := g.Claim
+	if name == "" {
+		name = "sub"
+	}
+	var values []string
+	switch v := claims[name].(type) {
+	case string:
+		values = []string{v}
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+	default:
+		return false
+	}
+
+	for _, want := range g.Values {
+		for _, have := range values {
+			if want == tokenWildcard || want == have {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (g *tokenGrant) allowsNamespace(namespace string) bool {
+	for _, ns := range g.Namespaces {
+		if ns == tokenWildcard || ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// restrictGrantSelectors adds the SelectorLabels of the grants of every namespace that a GameServerAllocation
+// searches to its selectors. As the selectors apply to every namespace, returns a PermissionDenied error if
+// the grants restrict them differently, as well as if a selector already selects a different value.
+func restrictGrantSelectors(gsa *allocationv1.GameServerAllocation, grants []*tokenGrant) error {
+	if len(grants) == 0 {
+		return nil
+	}
+	for _, g := range grants[1:] {
+		if !maps.Equal(g.SelectorLabels, grants[0].SelectorLabels) {
+			return status.Error(codes.PermissionDenied, "token grants restrict the selectors of the namespaces of the allocation differently")
+		}
+	}
+	return grants[0].restrictSelectors(gsa)
+}
+
+// restrictSelectors adds the grant SelectorLabels to every selector of the GameServerAllocation.
+// Returns a PermissionDenied error if a selector already selects a different value for one of the labels.
+func (g *tokenGrant) restrictSelectors(gsa *allocationv1.GameServerAllocation) error {
+	if len(g.SelectorLabels) == 0 {
+		return nil
+	}
+
+	gsa.Converter()
+	return g.restrictSelectorList(gsa.Spec.Selectors)
+}
+
+// restrictSelectorList adds the grant SelectorLabels to every selector.
+// Returns a PermissionDenied error if a selector already selects a different value for one of the labels.
+func (g *tokenGrant) restrictSelectorList(selectors []allocationv1.GameServerSelector) error {
+	for i := range selectors {
+		s := &selectors[i]
+		if s.LabelSelector.MatchLabels == nil {
+			s.LabelSelector.MatchLabels = make(map[string]string, len(g.SelectorLabels))
+		}
+		for k, v := range g.SelectorLabels {
+			if existing, ok := s.LabelSelector.MatchLabels[k]; ok && existing != v {
+				return status.Errorf(codes.PermissionDenied, "token is not allowed to select %s=%s", k, existing)
+			}
+			s.LabelSelector.MatchLabels[k] = v
+		}
+	}
+	return nil
+}
+
+// bearerToken returns the bearer token from the authorization request metadata.
+// The gRPC gateway passes the REST Authorization header through as the same metadata key.
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", errors.New("missing authorization metadata")
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", errors.New("missing authorization metadata")
+	}
+	const prefix = "bearer "
+	if len(values[0]) <= len(prefix) || !strings.EqualFold(values[0][:len(prefix)], prefix) {
+		return "", fmt.Errorf("authorization metadata is not a bearer token")
+	}
+	return strings.TrimSpace(values[0][len(prefix):]), nil
+}
@@ -0,0 +1,448 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "agones.dev/agones/pkg/allocation/go"
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"agones.dev/agones/pkg/gameserverallocations"
+	"agones.dev/agones/pkg/util/runtime"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	corev1lister "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+const testIssuer = "https://mesh.example.com"
+
+// newTestTokenAuth returns a tokenAuthenticator that trusts a generated key loaded from a
+// JWKS file, and a function to sign tokens with that key.
+func newTestTokenAuth(t *testing.T, grants []tokenGrant) (*tokenAuthenticator, func(claims jwt.Claims, custom map[string]interface{}) string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	jwks := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: key.Public(), KeyID: "test", Algorithm: string(jose.ES256), Use: "sig"}}}
+	data, err := json.Marshal(jwks)
+	require.NoError(t, err)
+	file := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(file, data, 0o600))
+
+	conf, err := parseTokenAuthConfig(`
+issuers:
+- issuer: ` + testIssuer + `
+  audiences: ["agones-allocator"]
+  jwksFile: ` + file)
+	require.NoError(t, err)
+	conf.Issuers[0].Grants = grants
+
+	a, err := newTokenAuthenticator(conf)
+	require.NoError(t, err)
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: jose.JSONWebKey{Key: key, KeyID: "test"}}, nil)
+	require.NoError(t, err)
+
+	return a, func(claims jwt.Claims, custom map[string]interface{}) string {
+		token, err := jwt.Signed(signer).Claims(claims).Claims(custom).Serialize()
+		require.NoError(t, err)
+		return token
+	}
+}
+
+func bearerContext(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestParseTokenAuthConfig(t *testing.T) {
+	t.Parallel()
+
+	conf, err := parseTokenAuthConfig(`
+issuers:
+- issuer: https://mesh.example.com
+  jwksURL: https://mesh.example.com/.well-known/jwks.json
+  refreshInterval: 1m
+  grants:
+  - claim: groups
+    values: ["matchmakers"]
+    namespaces: ["default"]
+    selectorLabels:
+      team: a
+`)
+	require.NoError(t, err)
+	require.Len(t, conf.Issuers, 1)
+	assert.Equal(t, time.Minute, conf.Issuers[0].RefreshInterval.Duration)
+	assert.Equal(t, []tokenGrant{{Claim: "groups", Values: []string{"matchmakers"}, Namespaces: []string{"default"}, SelectorLabels: map[string]string{"team": "a"}}}, conf.Issuers[0].Grants)
+
+	_, err = parseTokenAuthConfig(``)
+	assert.Error(t, err)
+	_, err = parseTokenAuthConfig(`{"issuers":[{"issuer":"https://mesh.example.com"}]}`)
+	assert.Error(t, err)
+	_, err = parseTokenAuthConfig(`{"issuers":[{"jwksFile":"/jwks.json"}]}`)
+	assert.Error(t, err)
+}
+
+func TestTokenAuthenticatorAuthenticate(t *testing.T) {
+	t.Parallel()
+
+	a, sign := newTestTokenAuth(t, nil)
+	now := time.Now()
+	valid := jwt.Claims{
+		Issuer:   testIssuer,
+		Subject:  "matchmaker",
+		Audience: jwt.Audience{"agones-allocator"},
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+
+	fixtures := map[string]struct {
+		ctx     context.Context
+		subject string
+	}{
+		"valid": {
+			ctx:     bearerContext(sign(valid, nil)),
+			subject: "matchmaker",
+		},
+		"no metadata": {
+			ctx: context.Background(),
+		},
+		"not a bearer token": {
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic Zm9vOmJhcg==")),
+		},
+		"garbage token": {
+			ctx: bearerContext("not.a.token"),
+		},
+		"expired": {
+			ctx: bearerContext(sign(jwt.Claims{Issuer: testIssuer, Audience: valid.Audience, Expiry: jwt.NewNumericDate(now.Add(-time.Hour))}, nil)),
+		},
+		"no expiry": {
+			ctx: bearerContext(sign(jwt.Claims{Issuer: testIssuer, Audience: valid.Audience}, nil)),
+		},
+		"wrong audience": {
+			ctx: bearerContext(sign(jwt.Claims{Issuer: testIssuer, Audience: jwt.Audience{"other"}, Expiry: valid.Expiry}, nil)),
+		},
+		"untrusted issuer": {
+			ctx: bearerContext(sign(jwt.Claims{Issuer: "https://other.example.com", Audience: valid.Audience, Expiry: valid.Expiry}, nil)),
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			p, err := a.authenticate(v.ctx)
+			if v.subject == "" {
+				require.Error(t, err)
+				assert.Equal(t, codes.Unauthenticated, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, v.subject, p.subject)
+		})
+	}
+
+	// a token signed by a key that is not in the JWKS
+	_, otherSign := newTestTokenAuth(t, nil)
+	_, err := a.authenticate(bearerContext(otherSign(valid, nil)))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestTokenPrincipalAuthorize(t *testing.T) {
+	t.Parallel()
+
+	issuer := &tokenIssuer{Grants: []tokenGrant{
+		{Values: []string{"matchmaker"}, Namespaces: []string{"default"}},
+		{Claim: "groups", Values: []string{"load-testers"}, Namespaces: []string{"load-test"}, SelectorLabels: map[string]string{"pool": "test"}},
+		{Claim: "groups", Values: []string{"admins"}, Namespaces: []string{"*"}},
+	}}
+	newPrincipal := func(claims map[string]interface{}) *tokenPrincipal {
+		return &tokenPrincipal{issuer: issuer, claims: claims}
+	}
+
+	g, err := newPrincipal(map[string]interface{}{"sub": "matchmaker"}).authorize("default")
+	require.NoError(t, err)
+	assert.Empty(t, g.SelectorLabels)
+
+	_, err = newPrincipal(map[string]interface{}{"sub": "matchmaker"}).authorize("load-test")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	g, err = newPrincipal(map[string]interface{}{"sub": "bot", "groups": []interface{}{"qa", "load-testers"}}).authorize("load-test")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"pool": "test"}, g.SelectorLabels)
+
+	_, err = newPrincipal(map[string]interface{}{"groups": []interface{}{"admins"}}).authorize("anything")
+	assert.NoError(t, err)
+
+	_, err = newPrincipal(map[string]interface{}{"groups": 1}).authorize("default")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestTokenGrantRestrictSelectors(t *testing.T) {
+	t.Parallel()
+
+	g := &tokenGrant{SelectorLabels: map[string]string{"pool": "test"}}
+
+	gsa := &allocationv1.GameServerAllocation{Spec: allocationv1.GameServerAllocationSpec{
+		Selectors: []allocationv1.GameServerSelector{
+			{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"map": "searide"}}},
+			{},
+		},
+	}}
+	require.NoError(t, g.restrictSelectors(gsa))
+	assert.Equal(t, map[string]string{"map": "searide", "pool": "test"}, gsa.Spec.Selectors[0].MatchLabels)
+	assert.Equal(t, map[string]string{"pool": "test"}, gsa.Spec.Selectors[1].MatchLabels)
+
+	// deprecated required selector is converted, so it is also restricted
+	gsa = &allocationv1.GameServerAllocation{Spec: allocationv1.GameServerAllocationSpec{}}
+	require.NoError(t, g.restrictSelectors(gsa))
+	require.Len(t, gsa.Spec.Selectors, 1)
+	assert.Equal(t, map[string]string{"pool": "test"}, gsa.Spec.Selectors[0].MatchLabels)
+
+	gsa = &allocationv1.GameServerAllocation{Spec: allocationv1.GameServerAllocationSpec{
+		Selectors: []allocationv1.GameServerSelector{
+			{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"pool": "prod"}}},
+		},
+	}}
+	assert.Equal(t, codes.PermissionDenied, status.Code(g.restrictSelectors(gsa)))
+}
+
+func TestRestrictGrantSelectors(t *testing.T) {
+	t.Parallel()
+
+	newGSA := func() *allocationv1.GameServerAllocation {
+		return &allocationv1.GameServerAllocation{Spec: allocationv1.GameServerAllocationSpec{
+			Selectors: []allocationv1.GameServerSelector{{}},
+		}}
+	}
+	test := &tokenGrant{SelectorLabels: map[string]string{"pool": "test"}}
+	prod := &tokenGrant{SelectorLabels: map[string]string{"pool": "prod"}}
+	unrestricted := &tokenGrant{}
+
+	gsa := newGSA()
+	require.NoError(t, restrictGrantSelectors(gsa, []*tokenGrant{test, {SelectorLabels: map[string]string{"pool": "test"}}}))
+	assert.Equal(t, map[string]string{"pool": "test"}, gsa.Spec.Selectors[0].MatchLabels)
+
+	gsa = newGSA()
+	require.NoError(t, restrictGrantSelectors(gsa, []*tokenGrant{unrestricted, unrestricted}))
+	assert.Empty(t, gsa.Spec.Selectors[0].MatchLabels)
+
+	// the selectors apply to every namespace, so one namespace cannot be restricted differently to another
+	gsa = newGSA()
+	assert.Equal(t, codes.PermissionDenied, status.Code(restrictGrantSelectors(gsa, []*tokenGrant{test, prod})))
+	assert.Empty(t, gsa.Spec.Selectors[0].MatchLabels)
+	assert.Equal(t, codes.PermissionDenied, status.Code(restrictGrantSelectors(gsa, []*tokenGrant{unrestricted, test})))
+	assert.Empty(t, gsa.Spec.Selectors[0].MatchLabels)
+}
+
+func TestAllocateHandlerTokenAuth(t *testing.T) {
+	t.Parallel()
+
+	a, sign := newTestTokenAuth(t, []tokenGrant{
+		{Values: []string{"matchmaker"}, Namespaces: []string{"default"}, SelectorLabels: map[string]string{"pool": "mm"}},
+	})
+
+	var selectors []allocationv1.GameServerSelector
+	h := serviceHandler{
+		allocationCallback: func(gsa *allocationv1.GameServerAllocation) (k8sruntime.Object, error) {
+			selectors = gsa.Spec.Selectors
+			return &allocationv1.GameServerAllocation{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+				Status:     allocationv1.GameServerAllocationStatus{State: allocationv1.GameServerAllocationAllocated},
+			}, nil
+		},
+		tokenAuth: a,
+	}
+
+	token := sign(jwt.Claims{
+		Issuer:   testIssuer,
+		Subject:  "matchmaker",
+		Audience: jwt.Audience{"agones-allocator"},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}, nil)
+
+	_, err := h.Allocate(context.Background(), &pb.AllocationRequest{Namespace: "default"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = h.Allocate(bearerContext(token), &pb.AllocationRequest{Namespace: "other"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = h.Allocate(bearerContext(token), &pb.AllocationRequest{Namespace: "default"})
+	require.NoError(t, err)
+	require.Len(t, selectors, 1)
+	assert.Equal(t, "mm", selectors[0].MatchLabels["pool"])
+}
+
+func TestReleaseHandlerTokenAuth(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureAllocatorRelease)))
+
+	a, sign := newTestTokenAuth(t, []tokenGrant{
+		{Values: []string{"matchmaker"}, Namespaces: []string{"default"}, SelectorLabels: map[string]string{"team": "a"}},
+	})
+
+	var released *gameserverallocations.ReleaseRequest
+	h := serviceHandler{
+		releaseCallback: func(_ context.Context, req gameserverallocations.ReleaseRequest) (*agonesv1.GameServer, error) {
+			released = &req
+			return &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: req.Name, Namespace: req.Namespace}}, nil
+		},
+		tokenAuth: a,
+	}
+
+	token := sign(jwt.Claims{
+		Issuer:   testIssuer,
+		Subject:  "matchmaker",
+		Audience: jwt.Audience{"agones-allocator"},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}, nil)
+
+	_, err := h.Release(bearerContext(token), &pb.ReleaseRequest{Namespace: "other", GameServerName: "gs1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Nil(t, released)
+
+	// only the GameServers that the token can allocate can be released
+	_, err = h.Release(bearerContext(token), &pb.ReleaseRequest{Namespace: "default", GameServerName: "gs1"})
+	require.NoError(t, err)
+	require.NotNil(t, released)
+	assert.Equal(t, map[string]string{"team": "a"}, released.SelectorLabels)
+}
+
+func TestAllocateHandlerTokenAuthNamespaces(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureCrossNamespaceAllocation)))
+
+	a, sign := newTestTokenAuth(t, []tokenGrant{
+		{Values: []string{"matchmaker"}, Namespaces: []string{"default", "eu"}},
+	})
+
+	var namespaces []string
+	h := serviceHandler{
+		allocationCallback: func(gsa *allocationv1.GameServerAllocation) (k8sruntime.Object, error) {
+			namespaces = gsa.SearchNamespaces()
+			return &allocationv1.GameServerAllocation{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+				Status:     allocationv1.GameServerAllocationStatus{State: allocationv1.GameServerAllocationAllocated},
+			}, nil
+		},
+		tokenAuth: a,
+	}
+
+	token := sign(jwt.Claims{
+		Issuer:   testIssuer,
+		Subject:  "matchmaker",
+		Audience: jwt.Audience{"agones-allocator"},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}, nil)
+
+	// every namespace that is searched must be granted
+	_, err := h.Allocate(bearerContext(token), &pb.AllocationRequest{Namespace: "default", Namespaces: []string{"eu", "us"}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Nil(t, namespaces)
+
+	// as must the namespace of the request, even if it is not searched
+	_, err = h.Allocate(bearerContext(token), &pb.AllocationRequest{Namespace: "us", Namespaces: []string{"eu"}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Nil(t, namespaces)
+
+	_, err = h.Allocate(bearerContext(token), &pb.AllocationRequest{Namespace: "default", Namespaces: []string{"eu", "default"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eu", "default"}, namespaces)
+}
+
+func TestAllocateHandlerTokenAuthQuotasNamespaceSelector(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureCrossNamespaceAllocation)))
+
+	a, sign := newTestTokenAuth(t, []tokenGrant{
+		{Values: []string{"matchmaker"}, Namespaces: []string{"default", "eu", "us"}},
+		{Values: []string{"eu-matchmaker"}, Namespaces: []string{"default", "eu"}},
+	})
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	require.NoError(t, indexer.Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}))
+	for _, name := range []string{"eu", "us"} {
+		require.NoError(t, indexer.Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"region": "true"}}}))
+	}
+
+	var namespaces []string
+	h := serviceHandler{
+		allocationCallback: func(gsa *allocationv1.GameServerAllocation) (k8sruntime.Object, error) {
+			namespaces = gsa.SearchNamespaces()
+			return &allocationv1.GameServerAllocation{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+				Status:     allocationv1.GameServerAllocationStatus{State: allocationv1.GameServerAllocationAllocated},
+			}, nil
+		},
+		tokenAuth:       a,
+		quotas:          newQuotaLimiter(quotaConfig{Rules: []quotaRule{{Namespace: "us", RequestsPerSecond: 0.001, Burst: 1}}}, nil),
+		namespaceLister: corev1lister.NewNamespaceLister(indexer),
+	}
+
+	token := func(subject string) string {
+		return sign(jwt.Claims{
+			Issuer:   testIssuer,
+			Subject:  subject,
+			Audience: jwt.Audience{"agones-allocator"},
+			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		}, nil)
+	}
+	req := &pb.AllocationRequest{Namespace: "default", NamespaceSelector: &pb.LabelSelector{MatchLabels: map[string]string{"region": "true"}}}
+
+	// every namespace that the selector selects must be granted
+	_, err := h.Allocate(bearerContext(token("eu-matchmaker")), req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Nil(t, namespaces)
+
+	_, err = h.Allocate(bearerContext(token("matchmaker")), req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eu", "us"}, namespaces)
+
+	// and is charged to its quota
+	_, err = h.Allocate(bearerContext(token("matchmaker")), req)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestAuthorizedNamespaces(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureCrossNamespaceAllocation)))
+
+	gsa := &allocationv1.GameServerAllocation{ObjectMeta: metav1.ObjectMeta{Namespace: "default"}}
+	assert.Equal(t, []string{"default"}, authorizedNamespaces(gsa))
+
+	gsa.Spec.Namespaces = []string{"eu", "default", "us"}
+	assert.Equal(t, []string{"default", "eu", "us"}, authorizedNamespaces(gsa))
+}
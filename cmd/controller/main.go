@@ -91,6 +91,7 @@ const (
 	maxDeletionParallelismFlag         = "max-deletion-parallelism"
 	maxGameServerDeletionsPerBatchFlag = "max-game-server-deletions-per-batch"
 	maxPodPendingCountFlag             = "max-pod-pending-count"
+	connectionTokenKeysFlag            = "connection-token-keys"
 )
 
 var (
@@ -207,7 +208,7 @@ func main() {
 		ctlConf.PortRanges, ctlConf.SidecarImage, ctlConf.AlwaysPullSidecar,
 		ctlConf.SidecarCPURequest, ctlConf.SidecarCPULimit,
 		ctlConf.SidecarMemoryRequest, ctlConf.SidecarMemoryLimit, ctlConf.SidecarRunAsUser, ctlConf.SidecarRequestsRateLimit, ctlConf.SdkServiceAccount,
-		kubeClient, kubeInformerFactory, extClient, agonesClient, agonesInformerFactory)
+		ctlConf.ConnectionTokenKeys, kubeClient, kubeInformerFactory, extClient, agonesClient, agonesInformerFactory)
 	gsSetController := gameserversets.NewController(health, gsCounter,
 		kubeClient, extClient, agonesClient, agonesInformerFactory, ctlConf.MaxCreationParallelism, ctlConf.MaxDeletionParallelism, ctlConf.MaxGameServerCreationsPerBatch, ctlConf.MaxGameServerDeletionsPerBatch, ctlConf.MaxPodPendingCount)
 	fleetController := fleets.NewController(health, kubeClient, extClient, agonesClient, agonesInformerFactory)
@@ -278,6 +279,7 @@ func parseEnvFlags() config {
 	viper.SetDefault(maxDeletionParallelismFlag, 64)
 	viper.SetDefault(maxGameServerDeletionsPerBatchFlag, 64)
 	viper.SetDefault(maxPodPendingCountFlag, 5000)
+	viper.SetDefault(connectionTokenKeysFlag, "")
 
 	pflag.String(sidecarImageFlag, viper.GetString(sidecarImageFlag), "Flag to overwrite the GameServer sidecar image that is used. Can also use SIDECAR env variable")
 	pflag.String(sidecarCPULimitFlag, viper.GetString(sidecarCPULimitFlag), "Flag to overwrite the GameServer sidecar container's cpu limit. Can also use SIDECAR_CPU_LIMIT env variable")
@@ -312,6 +314,7 @@ func parseEnvFlags() config {
 	pflag.Duration(allocationBatchWaitTime, viper.GetDuration(allocationBatchWaitTime), "Flag to configure the waiting period between allocations batches")
 	pflag.String(podNamespace, viper.GetString(podNamespace), "namespace of current pod")
 	pflag.Bool(leaderElectionFlag, viper.GetBool(leaderElectionFlag), "Flag to enable/disable leader election for controller pod")
+	pflag.String(connectionTokenKeysFlag, viper.GetString(connectionTokenKeysFlag), "Name of the ConfigMap in each GameServer namespace with the JSON Web Key Set that the SDK server verifies allocation connection tokens with. Only used when the ConnectionTokens feature gate is enabled. Can also use CONNECTION_TOKEN_KEYS env variable.")
 	cloudproduct.BindFlags()
 	runtime.FeaturesBindFlags()
 	pflag.Parse()
@@ -326,6 +329,7 @@ func parseEnvFlags() config {
 	runtime.Must(viper.BindEnv(sidecarRequestsRateLimitFlag))
 	runtime.Must(viper.BindEnv(pullSidecarFlag))
 	runtime.Must(viper.BindEnv(sdkServerAccountFlag))
+	runtime.Must(viper.BindEnv(connectionTokenKeysFlag))
 	runtime.Must(viper.BindEnv(minPortFlag))
 	runtime.Must(viper.BindEnv(maxPortFlag))
 	runtime.Must(viper.BindEnv(additionalPortRangesFlag))
@@ -400,6 +404,7 @@ func parseEnvFlags() config {
 		SidecarRunAsUser:               int(viper.GetInt32(sidecarRunAsUserFlag)),
 		SidecarRequestsRateLimit:       requestsRateLimit,
 		SdkServiceAccount:              viper.GetString(sdkServerAccountFlag),
+		ConnectionTokenKeys:            viper.GetString(connectionTokenKeysFlag),
 		AlwaysPullSidecar:              viper.GetBool(pullSidecarFlag),
 		KeyFile:                        viper.GetString(keyFileFlag),
 		CertFile:                       viper.GetString(certFileFlag),
@@ -459,6 +464,7 @@ type config struct {
 	SidecarRunAsUser               int
 	SidecarRequestsRateLimit       time.Duration
 	SdkServiceAccount              string
+	ConnectionTokenKeys            string
 	AlwaysPullSidecar              bool
 	PrometheusMetrics              bool
 	Stackdriver                    bool
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"agones.dev/agones/pkg"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
 	"agones.dev/agones/pkg/client/clientset/versioned"
 	"agones.dev/agones/pkg/client/informers/externalversions"
 	"agones.dev/agones/pkg/cloudproduct"
@@ -36,6 +37,7 @@ import (
 	"agones.dev/agones/pkg/metrics"
 	"agones.dev/agones/pkg/processor"
 	"agones.dev/agones/pkg/util/apiserver"
+	"agones.dev/agones/pkg/util/connectiontoken"
 	"agones.dev/agones/pkg/util/https"
 	"agones.dev/agones/pkg/util/httpserver"
 	"agones.dev/agones/pkg/util/runtime"
@@ -73,6 +75,11 @@ const (
 	processorGRPCAddress         = "processor-grpc-address"
 	processorGRPCPort            = "processor-grpc-port"
 	processorMaxBatchSize        = "processor-max-batch-size"
+	processorFallbackEnabled     = "processor-fallback-enabled"
+	processorFallbackThreshold   = "processor-fallback-threshold"
+	connectionTokenSigningKey    = "connection-token-signing-key"
+	connectionTokenTTL           = "connection-token-ttl"
+	wasmScoringAllowedURLs       = "wasm-scoring-allowed-urls"
 )
 
 var (
@@ -119,6 +126,10 @@ func main() {
 	logger.WithField("version", pkg.Version).WithField("featureGates", runtime.EncodeFeatures()).
 		WithField("ctlConf", ctlConf).Info("starting extensions operator...")
 
+	if runtime.FeatureEnabled(runtime.FeatureWasmAllocationScoring) {
+		allocationv1.SetAllowedWasmScoringURLs(ctlConf.wasmScoringAllowedURLs)
+	}
+
 	// if the kubeconfig fails InClusterBuildConfig will try in cluster config
 	clientConf, err := runtime.InClusterBuildConfig(logger, ctlConf.KubeConfig)
 	if err != nil {
@@ -197,15 +208,21 @@ func main() {
 	server.Handle("/", health)
 
 	var gasExtensions *gameserverallocations.Extensions
+	var processorClient processor.Client
 	if runtime.FeatureEnabled(runtime.FeatureProcessorAllocator) {
 		processorConfig := processor.Config{
-			ClientID:          os.Getenv("POD_NAME"),
-			ProcessorAddress:  fmt.Sprintf("%s:%d", ctlConf.processorGRPCAddress, ctlConf.processorGRPCPort),
-			MaxBatchSize:      ctlConf.processorMaxBatchSize,
-			AllocationTimeout: 30 * time.Second,
-			ReconnectInterval: 5 * time.Second,
+			ClientID:           os.Getenv("POD_NAME"),
+			ProcessorAddress:   fmt.Sprintf("%s:%d", ctlConf.processorGRPCAddress, ctlConf.processorGRPCPort),
+			MaxBatchSize:       ctlConf.processorMaxBatchSize,
+			AllocationTimeout:  30 * time.Second,
+			ReconnectInterval:  5 * time.Second,
+			UnhealthyThreshold: ctlConf.processorFallbackThreshold,
+		}
+		if runtime.FeatureEnabled(runtime.FeatureProcessorSharding) {
+			processorClient = processor.NewShardedClient(processorConfig, kubeClient, os.Getenv("POD_NAMESPACE"), logger.WithField("component", "processor-client"))
+		} else {
+			processorClient = processor.NewClient(processorConfig, logger.WithField("component", "processor-client"))
 		}
-		processorClient := processor.NewClient(processorConfig, logger.WithField("component", "processor-client"))
 
 		go func() {
 			if err := processorClient.Run(ctx); err != nil {
@@ -216,13 +233,29 @@ func main() {
 				logger.WithError(err).Error("Processor client failed, initiating graceful shutdown")
 			}
 		}()
+	}
 
-		gasExtensions = gameserverallocations.NewProcessorExtensions(api, kubeClient, processorClient)
+	if processorClient != nil && !ctlConf.processorFallbackEnabled {
+		gasExtensions = gameserverallocations.NewProcessorExtensions(api, kubeClient, kubeInformerFactory, agonesInformerFactory, processorClient)
+		kubeInformerFactory.Start(ctx.Done())
+		agonesInformerFactory.Start(ctx.Done())
 	} else {
 		gsCounter := gameservers.NewPerNodeCounter(kubeInformerFactory, agonesInformerFactory)
 
+		var tokenSigner *connectiontoken.Signer
+		if runtime.FeatureEnabled(runtime.FeatureConnectionTokens) && ctlConf.connectionTokenSigningKey != "" {
+			tokenSigner, err = connectiontoken.LoadSigner(ctlConf.connectionTokenSigningKey, ctlConf.connectionTokenTTL)
+			if err != nil {
+				logger.WithError(err).Fatal("Could not load connection token signing key")
+			}
+		}
+
 		gasExtensions = gameserverallocations.NewExtensions(api, health, gsCounter, kubeClient, kubeInformerFactory,
-			agonesClient, agonesInformerFactory, 10*time.Second, 30*time.Second, ctlConf.AllocationBatchWaitTime)
+			agonesClient, agonesInformerFactory, 10*time.Second, 30*time.Second, ctlConf.AllocationBatchWaitTime, tokenSigner)
+		if processorClient != nil {
+			// allocate through the processor, falling back to the local allocator while it is unhealthy
+			gasExtensions.SetProcessorFallback(processorClient)
+		}
 
 		kubeInformerFactory.Start(ctx.Done())
 		agonesInformerFactory.Start(ctx.Done())
@@ -274,6 +307,11 @@ func parseEnvFlags() config {
 	viper.SetDefault(processorGRPCAddress, "agones-processor.agones-system.svc.cluster.local")
 	viper.SetDefault(processorGRPCPort, 9090)
 	viper.SetDefault(processorMaxBatchSize, 100)
+	viper.SetDefault(processorFallbackEnabled, false)
+	viper.SetDefault(processorFallbackThreshold, 10*time.Second)
+	viper.SetDefault(connectionTokenSigningKey, "")
+	viper.SetDefault(connectionTokenTTL, connectiontoken.DefaultTTL)
+	viper.SetDefault(wasmScoringAllowedURLs, "")
 
 	pflag.String(keyFileFlag, viper.GetString(keyFileFlag), "Optional. Path to the key file")
 	pflag.String(certFileFlag, viper.GetString(certFileFlag), "Optional. Path to the crt file")
@@ -298,6 +336,11 @@ func parseEnvFlags() config {
 	pflag.String(processorGRPCAddress, viper.GetString(processorGRPCAddress), "The gRPC address of the Agones Processor service")
 	pflag.Int32(processorGRPCPort, viper.GetInt32(processorGRPCPort), "The gRPC port of the Agones Processor service")
 	pflag.Int32(processorMaxBatchSize, viper.GetInt32(processorMaxBatchSize), "The maximum batch size to send to the Agones Processor service")
+	pflag.Bool(processorFallbackEnabled, viper.GetBool(processorFallbackEnabled), "Allocate locally while the Agones Processor service is unhealthy. Can also use PROCESSOR_FALLBACK_ENABLED env variable.")
+	pflag.Duration(processorFallbackThreshold, viper.GetDuration(processorFallbackThreshold), "How long the Agones Processor service can be unreachable before falling back to local allocation. Can also use PROCESSOR_FALLBACK_THRESHOLD env variable.")
+	pflag.String(connectionTokenSigningKey, viper.GetString(connectionTokenSigningKey), "Path to the PEM encoded private key that signs allocation connection tokens. Only used when the ConnectionTokens feature gate is enabled. Can also use CONNECTION_TOKEN_SIGNING_KEY env variable.")
+	pflag.Duration(connectionTokenTTL, viper.GetDuration(connectionTokenTTL), "How long allocation connection tokens are valid for. Can also use CONNECTION_TOKEN_TTL env variable.")
+	pflag.String(wasmScoringAllowedURLs, viper.GetString(wasmScoringAllowedURLs), "Comma separated URLs of the Wasm scoring modules that allocations can use. Only used when the WasmAllocationScoring feature gate is enabled. Can also use WASM_SCORING_ALLOWED_URLS env variable.")
 
 	cloudproduct.BindFlags()
 	runtime.FeaturesBindFlags()
@@ -323,6 +366,11 @@ func parseEnvFlags() config {
 	runtime.Must(viper.BindEnv(httpPort))
 	runtime.Must(viper.BindEnv(webhookPort))
 	runtime.Must(viper.BindEnv(allocationBatchWaitTime))
+	runtime.Must(viper.BindEnv(connectionTokenSigningKey))
+	runtime.Must(viper.BindEnv(connectionTokenTTL))
+	runtime.Must(viper.BindEnv(wasmScoringAllowedURLs))
+	runtime.Must(viper.BindEnv(processorFallbackEnabled))
+	runtime.Must(viper.BindEnv(processorFallbackThreshold))
 	runtime.Must(viper.BindPFlags(pflag.CommandLine))
 	runtime.Must(viper.BindEnv(readinessShutdownDuration))
 	runtime.Must(cloudproduct.BindEnv())
@@ -350,9 +398,16 @@ func parseEnvFlags() config {
 		AllocationBatchWaitTime:   viper.GetDuration(allocationBatchWaitTime),
 		ReadinessShutdownDuration: viper.GetDuration(readinessShutdownDuration),
 
-		processorGRPCAddress:  viper.GetString(processorGRPCAddress),
-		processorGRPCPort:     int(viper.GetInt32(processorGRPCPort)),
-		processorMaxBatchSize: int(viper.GetInt32(processorMaxBatchSize)),
+		processorGRPCAddress:       viper.GetString(processorGRPCAddress),
+		processorGRPCPort:          int(viper.GetInt32(processorGRPCPort)),
+		processorMaxBatchSize:      int(viper.GetInt32(processorMaxBatchSize)),
+		processorFallbackEnabled:   viper.GetBool(processorFallbackEnabled),
+		processorFallbackThreshold: viper.GetDuration(processorFallbackThreshold),
+
+		connectionTokenSigningKey: viper.GetString(connectionTokenSigningKey),
+		connectionTokenTTL:        viper.GetDuration(connectionTokenTTL),
+
+		wasmScoringAllowedURLs: strings.Split(viper.GetString(wasmScoringAllowedURLs), ","),
 	}
 }
 
@@ -378,9 +433,16 @@ type config struct {
 	AllocationBatchWaitTime   time.Duration
 	ReadinessShutdownDuration time.Duration
 
-	processorGRPCAddress  string
-	processorGRPCPort     int
-	processorMaxBatchSize int
+	processorGRPCAddress       string
+	processorGRPCPort          int
+	processorMaxBatchSize      int
+	processorFallbackEnabled   bool
+	processorFallbackThreshold time.Duration
+
+	connectionTokenSigningKey string
+	connectionTokenTTL        time.Duration
+
+	wasmScoringAllowedURLs []string
 }
 
 type runner interface {
@@ -17,11 +17,14 @@ package main
 
 import (
 	"context"
+	"net"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"agones.dev/agones/pkg"
+	"agones.dev/agones/pkg/processor"
 	"agones.dev/agones/pkg/util/httpserver"
 	"agones.dev/agones/pkg/util/runtime"
 	"agones.dev/agones/pkg/util/signals"
@@ -45,6 +48,13 @@ const (
 	leaseDurationFlag  = "lease-duration"
 	renewDeadlineFlag  = "renew-deadline"
 	retryPeriodFlag    = "retry-period"
+	podNameFlag        = "pod-name"
+	podIPFlag          = "pod-ip"
+	grpcPortFlag       = "grpc-port"
+
+	// shutdownTimeout bounds how long the processor waits to clean up, such as deleting its
+	// shard membership Lease, before it exits on SIGTERM
+	shutdownTimeout = 10 * time.Second
 )
 
 var (
@@ -58,6 +68,9 @@ type processorConfig struct {
 	LeaseDuration  time.Duration
 	RenewDeadline  time.Duration
 	RetryPeriod    time.Duration
+	PodName        string
+	PodIP          string
+	GRPCPort       int
 }
 
 func parseEnvFlags() processorConfig {
@@ -67,6 +80,9 @@ func parseEnvFlags() processorConfig {
 	viper.SetDefault(leaseDurationFlag, 15*time.Second)
 	viper.SetDefault(renewDeadlineFlag, 10*time.Second)
 	viper.SetDefault(retryPeriodFlag, 2*time.Second)
+	viper.SetDefault(podNameFlag, "")
+	viper.SetDefault(podIPFlag, "")
+	viper.SetDefault(grpcPortFlag, 9090)
 
 	pflag.String(logLevelFlag, viper.GetString(logLevelFlag), "Log level")
 	pflag.Bool(leaderElectionFlag, viper.GetBool(leaderElectionFlag), "Enable leader election")
@@ -74,11 +90,17 @@ func parseEnvFlags() processorConfig {
 	pflag.Duration(leaseDurationFlag, viper.GetDuration(leaseDurationFlag), "Leader election lease duration")
 	pflag.Duration(renewDeadlineFlag, viper.GetDuration(renewDeadlineFlag), "Leader election renew deadline")
 	pflag.Duration(retryPeriodFlag, viper.GetDuration(retryPeriodFlag), "Leader election retry period")
+	pflag.String(podNameFlag, viper.GetString(podNameFlag), "Pod name, used as the shard identity when the ProcessorSharding feature is enabled")
+	pflag.String(podIPFlag, viper.GetString(podIPFlag), "Pod IP, advertised to allocation clients when the ProcessorSharding feature is enabled")
+	pflag.Int32(grpcPortFlag, viper.GetInt32(grpcPortFlag), "Port the processor gRPC service listens on")
+	runtime.FeaturesBindFlags()
 	pflag.Parse()
 
 	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
 	viper.AutomaticEnv()
 	_ = viper.BindPFlags(pflag.CommandLine)
+	runtime.Must(runtime.FeaturesBindEnv())
+	runtime.Must(runtime.ParseFeaturesFromEnv())
 
 	return processorConfig{
 		LogLevel:       viper.GetString(logLevelFlag),
@@ -87,6 +109,9 @@ func parseEnvFlags() processorConfig {
 		LeaseDuration:  viper.GetDuration(leaseDurationFlag),
 		RenewDeadline:  viper.GetDuration(renewDeadlineFlag),
 		RetryPeriod:    viper.GetDuration(retryPeriodFlag),
+		PodName:        viper.GetString(podNameFlag),
+		PodIP:          viper.GetString(podIPFlag),
+		GRPCPort:       int(viper.GetInt32(grpcPortFlag)),
 	}
 }
 
@@ -128,32 +153,85 @@ func main() {
 		_ = healthserver.Run(context.Background(), 0)
 	}()
 
+	// stopped is closed once the processor has cleaned up after ctx is cancelled
+	stopped := make(chan struct{})
 	signals.NewSigTermHandler(func() {
 		logger.Info("Pod shutdown has been requested, failing readiness check")
 		cancelCtx()
+		select {
+		case <-stopped:
+		case <-time.After(shutdownTimeout):
+			logger.Warn("Timed out waiting for the processor to shut down")
+		}
 		os.Exit(0)
 	})
 
+	if runtime.FeatureEnabled(runtime.FeatureProcessorSharding) {
+		runAsShard(ctx, logger, conf, kubeClient)
+		close(stopped)
+		logger.Info("Processor exited gracefully.")
+		return
+	}
+
 	whenLeader(ctx, cancelCtx, logger, conf, kubeClient, func(ctx context.Context) {
 		logger.Info("Starting processor work as leader")
+		runProcessor(ctx, logger, func(context.Context) {
+			logger.Info("Processor is active as leader")
+		})
+	})
+
+	logger.Info("Processor exited gracefully.")
+}
+
+// runAsShard runs the processor as one of several active shards. Each shard announces itself
+// through a membership Lease, and allocation clients route requests to the shard owning their partition.
+// Once ctx is cancelled, returns after the Lease is deleted, so clients rebalance away from the shard straight away.
+func runAsShard(ctx context.Context, logger *logrus.Entry, conf processorConfig, kubeClient kubernetes.Interface) {
+	member := processor.Member{
+		ID:      conf.PodName,
+		Address: net.JoinHostPort(conf.PodIP, strconv.Itoa(conf.GRPCPort)),
+	}
+	if member.ID == "" {
+		member.ID = uuid.New().String()
+	}
+	logger = logger.WithField("shard", member.ID)
+
+	membership := processor.NewMembership(kubeClient, conf.PodNamespace, member, conf.LeaseDuration, conf.RetryPeriod, logger)
+	membershipStopped := make(chan struct{})
+	go func() {
+		defer close(membershipStopped)
+		_ = membership.Run(ctx)
+	}()
+	defer func() { <-membershipStopped }()
 
-		// Simulate processor work (to ensure the leader is working)
-		// TODO: implement processor work
-		ticker := time.NewTicker(5 * time.Second)
-		defer ticker.Stop()
-
-		for {
-			select {
-			case <-ctx.Done():
-				logger.Info("Processor work completed")
-				return
-			case <-ticker.C:
-				logger.Info("Processor is active as leader")
-			}
+	logger.WithField("address", member.Address).Info("Starting processor work as shard")
+	runProcessor(ctx, logger, func(ctx context.Context) {
+		members, err := processor.ListMembers(ctx, kubeClient, conf.PodNamespace, time.Now())
+		if err != nil {
+			logger.WithError(err).Warn("Could not list processor shards")
+			return
 		}
+		logger.WithField("shards", len(members)).Info("Processor is active as shard")
 	})
+}
 
-	logger.Info("Processor exited gracefully.")
+// runProcessor runs the processor work until ctx is done, whether as the leader or as a shard.
+// heartbeat is called periodically to report that the processor is active.
+func runProcessor(ctx context.Context, logger *logrus.Entry, heartbeat func(ctx context.Context)) {
+	// Simulate processor work (to ensure the processor is working)
+	// TODO: implement processor work
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Processor work completed")
+			return
+		case <-ticker.C:
+			heartbeat(ctx)
+		}
+	}
 }
 
 func whenLeader(ctx context.Context, cancel context.CancelFunc, logger *logrus.Entry,
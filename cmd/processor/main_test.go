@@ -0,0 +1,64 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRunAsShardDeletesLeaseOnShutdown(t *testing.T) {
+	t.Parallel()
+
+	kubeClient := fake.NewSimpleClientset()
+	conf := processorConfig{
+		PodNamespace:  "agones-system",
+		PodName:       "processor-1",
+		PodIP:         "10.0.0.1",
+		GRPCPort:      9090,
+		LeaseDuration: 15 * time.Second,
+		RetryPeriod:   time.Second,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		runAsShard(ctx, logger, conf, kubeClient)
+	}()
+
+	leases := kubeClient.CoordinationV1().Leases(conf.PodNamespace)
+	require.Eventually(t, func() bool {
+		_, err := leases.Get(context.Background(), "agones-processor-shard-processor-1", metav1.GetOptions{})
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the shard only stops once it has left, so other shards rebalance straight away
+	cancel()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "shard did not stop")
+	}
+	_, err := leases.Get(context.Background(), "agones-processor-shard-processor-1", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err), "lease should be deleted: %v", err)
+}
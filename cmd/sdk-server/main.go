@@ -52,22 +52,23 @@ const (
 	defaultHealthPort = 8080
 
 	// Flags (that can also be env vars)
-	gameServerNameFlag      = "gameserver-name"
-	podNamespaceFlag        = "pod-namespace"
-	localFlag               = "local"
-	fileFlag                = "file"
-	testFlag                = "test"
-	testSdkNameFlag         = "sdk-name"
-	kubeconfigFlag          = "kubeconfig"
-	gracefulTerminationFlag = "graceful-termination"
-	addressFlag             = "address"
-	delayFlag               = "delay"
-	timeoutFlag             = "timeout"
-	grpcPortFlag            = "grpc-port"
-	httpPortFlag            = "http-port"
-	healthPortFlag          = "health-port"
-	logLevelFlag            = "log-level"
-	requestRateLimitFlag    = "request-rate-limit"
+	gameServerNameFlag          = "gameserver-name"
+	podNamespaceFlag            = "pod-namespace"
+	localFlag                   = "local"
+	fileFlag                    = "file"
+	testFlag                    = "test"
+	testSdkNameFlag             = "sdk-name"
+	kubeconfigFlag              = "kubeconfig"
+	gracefulTerminationFlag     = "graceful-termination"
+	addressFlag                 = "address"
+	delayFlag                   = "delay"
+	timeoutFlag                 = "timeout"
+	grpcPortFlag                = "grpc-port"
+	httpPortFlag                = "http-port"
+	healthPortFlag              = "health-port"
+	logLevelFlag                = "log-level"
+	requestRateLimitFlag        = "request-rate-limit"
+	connectionTokenKeysFileFlag = "connection-token-keys-file"
 )
 
 var (
@@ -150,7 +151,7 @@ func main() {
 
 		var s *sdkserver.SDKServer
 		s, err = sdkserver.NewSDKServer(ctlConf.GameServerName, ctlConf.PodNamespace,
-			kubeClient, agonesClient, logLevel, ctlConf.HealthPort, ctlConf.RequestsRateLimit)
+			kubeClient, agonesClient, logLevel, ctlConf.HealthPort, ctlConf.RequestsRateLimit, ctlConf.ConnectionTokenKeysFile)
 		if err != nil {
 			logger.WithError(err).Fatalf("Could not start sidecar")
 		}
@@ -296,6 +297,7 @@ func parseEnvFlags() config {
 	viper.SetDefault(healthPortFlag, defaultHealthPort)
 	viper.SetDefault(logLevelFlag, "Info")
 	viper.SetDefault(requestRateLimitFlag, "500ms")
+	viper.SetDefault(connectionTokenKeysFileFlag, "")
 	pflag.String(gameServerNameFlag, viper.GetString(gameServerNameFlag),
 		"Optional flag to set GameServer name. Overrides value given from `GAMESERVER_NAME` environment variable.")
 	pflag.String(podNamespaceFlag, viper.GetString(gameServerNameFlag),
@@ -316,6 +318,7 @@ func parseEnvFlags() config {
 	pflag.Bool(gracefulTerminationFlag, viper.GetBool(gracefulTerminationFlag),
 		"When false, immediately quits when receiving interrupt instead of waiting for GameServer state to progress to \"Shutdown\".")
 	pflag.String(requestRateLimitFlag, viper.GetString(requestRateLimitFlag), "Time to delay between requests to the API server. Defaults to 500ms.")
+	pflag.String(connectionTokenKeysFileFlag, viper.GetString(connectionTokenKeysFileFlag), "Path to the JSON Web Key Set that verifies allocation connection tokens, which is reloaded when it changes. Only used when the ConnectionTokens feature gate is enabled. Can also use CONNECTION_TOKEN_KEYS_FILE env variable.")
 	runtime.FeaturesBindFlags()
 	pflag.Parse()
 
@@ -336,47 +339,50 @@ func parseEnvFlags() config {
 	runtime.Must(viper.BindPFlags(pflag.CommandLine))
 	runtime.Must(viper.BindEnv(logLevelFlag))
 	runtime.Must(viper.BindEnv(requestRateLimitFlag))
+	runtime.Must(viper.BindEnv(connectionTokenKeysFileFlag))
 	runtime.Must(runtime.FeaturesBindEnv())
 	runtime.Must(runtime.ParseFeaturesFromEnv())
 
 	return config{
-		GameServerName:      viper.GetString(gameServerNameFlag),
-		PodNamespace:        viper.GetString(podNamespaceFlag),
-		IsLocal:             viper.GetBool(localFlag),
-		Address:             viper.GetString(addressFlag),
-		LocalFile:           viper.GetString(fileFlag),
-		Delay:               viper.GetInt(delayFlag),
-		Timeout:             viper.GetInt(timeoutFlag),
-		Test:                viper.GetString(testFlag),
-		TestSdkName:         viper.GetString(testSdkNameFlag),
-		KubeConfig:          viper.GetString(kubeconfigFlag),
-		GracefulTermination: viper.GetBool(gracefulTerminationFlag),
-		GRPCPort:            viper.GetInt(grpcPortFlag),
-		HTTPPort:            viper.GetInt(httpPortFlag),
-		HealthPort:          viper.GetInt(healthPortFlag),
-		LogLevel:            viper.GetString(logLevelFlag),
-		RequestsRateLimit:   viper.GetDuration(requestRateLimitFlag),
+		GameServerName:          viper.GetString(gameServerNameFlag),
+		PodNamespace:            viper.GetString(podNamespaceFlag),
+		IsLocal:                 viper.GetBool(localFlag),
+		Address:                 viper.GetString(addressFlag),
+		LocalFile:               viper.GetString(fileFlag),
+		Delay:                   viper.GetInt(delayFlag),
+		Timeout:                 viper.GetInt(timeoutFlag),
+		Test:                    viper.GetString(testFlag),
+		TestSdkName:             viper.GetString(testSdkNameFlag),
+		KubeConfig:              viper.GetString(kubeconfigFlag),
+		GracefulTermination:     viper.GetBool(gracefulTerminationFlag),
+		GRPCPort:                viper.GetInt(grpcPortFlag),
+		HTTPPort:                viper.GetInt(httpPortFlag),
+		HealthPort:              viper.GetInt(healthPortFlag),
+		LogLevel:                viper.GetString(logLevelFlag),
+		RequestsRateLimit:       viper.GetDuration(requestRateLimitFlag),
+		ConnectionTokenKeysFile: viper.GetString(connectionTokenKeysFileFlag),
 	}
 }
 
 // config is all the configuration for this program
 type config struct {
-	GameServerName      string
-	PodNamespace        string
-	Address             string
-	IsLocal             bool
-	LocalFile           string
-	Delay               int
-	Timeout             int
-	Test                string
-	TestSdkName         string
-	KubeConfig          string
-	GracefulTermination bool
-	GRPCPort            int
-	HTTPPort            int
-	HealthPort          int
-	LogLevel            string
-	RequestsRateLimit   time.Duration
+	GameServerName          string
+	PodNamespace            string
+	Address                 string
+	IsLocal                 bool
+	LocalFile               string
+	Delay                   int
+	Timeout                 int
+	Test                    string
+	TestSdkName             string
+	KubeConfig              string
+	GracefulTermination     bool
+	GRPCPort                int
+	HTTPPort                int
+	HealthPort              int
+	LogLevel                string
+	RequestsRateLimit       time.Duration
+	ConnectionTokenKeysFile string
 }
 
 // healthCheckWrapper ensures that an http 400 response is returned
@@ -0,0 +1,91 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+)
+
+const (
+	// DEFAULT_MAP_LIST is the name of the GameServer List of maps that a GameServer has loaded
+	DEFAULT_MAP_LIST = "maps"
+	// MAP_MATCH_SCORE is added to the score of GameServers that have the requested map loaded,
+	// so they are always preferred over GameServers that do not.
+	MAP_MATCH_SCORE = int64(1) << 40
+)
+
+// Score prefers GameServers whose map List contains the map in the "map" parameter of the allocation,
+// and then the newest GameServers, so players get the latest build of the game server.
+//
+//go:wasmexport score
+func Score() int32 {
+	return review(func(req *GameServerScoringRequest, gs GameServerScoringCandidate) int64 {
+		// allow for an overwrite of the name of the map List
+		list, ok := getConfig("map_list")
+		if !ok {
+			list = DEFAULT_MAP_LIST
+		}
+
+		score := -gs.AgeSeconds
+		if m := req.Parameters["map"]; m != "" && slices.Contains(gs.Lists[list].Values, m) {
+			score += MAP_MATCH_SCORE
+		}
+		return score
+	})
+}
+
+// Newest is a second export that only prefers the newest GameServers, demonstrating multiple export functions.
+//
+//go:wasmexport newest
+func Newest() int32 {
+	return review(func(_ *GameServerScoringRequest, gs GameServerScoringCandidate) int64 {
+		return -gs.AgeSeconds
+	})
+}
+
+// review reads the GameServerScoringReview input, scores each GameServer with f, and outputs the review
+// with the scores.
+func review(f func(req *GameServerScoringRequest, gs GameServerScoringCandidate) int64) int32 {
+	var review GameServerScoringReview
+	err := inputJSON(&review)
+	if err != nil {
+		setErrorString(fmt.Sprintf("Failed to decode GameServerScoringReview: %v", err))
+		return 1
+	}
+
+	if review.Request == nil {
+		setErrorString("GameServerScoringReview Request is nil")
+		return 1
+	}
+
+	review.Response = &GameServerScoringResponse{
+		UID:    review.Request.UID,
+		Scores: make([]int64, len(review.Request.GameServers)),
+	}
+	for i, gs := range review.Request.GameServers {
+		review.Response.Scores[i] = f(review.Request, gs)
+	}
+
+	err = outputJSON(&review)
+	if err != nil {
+		setErrorString(fmt.Sprintf("Failed to encode GameServerScoringReview: %v", err))
+		return 1
+	}
+
+	return 0
+}
+
+func main() {}
@@ -0,0 +1,65 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+/*
+A copy of the GameServerScoringReview to avoid pulling in the entire Agones codebase into this example
+(which won't compile to Wasm anyway).
+*/
+
+// CounterStatus stores the current counter values and maximum capacity
+type CounterStatus struct {
+	Count    int64 `json:"count"`
+	Capacity int64 `json:"capacity"`
+}
+
+// ListStatus stores the current list values and maximum capacity
+type ListStatus struct {
+	Capacity int64    `json:"capacity"`
+	Values   []string `json:"values"`
+}
+
+// GameServerScoringCandidate is a GameServer that can be allocated
+type GameServerScoringCandidate struct {
+	Name              string                   `json:"name"`
+	Labels            map[string]string        `json:"labels,omitempty"`
+	Annotations       map[string]string        `json:"annotations,omitempty"`
+	NodeName          string                   `json:"nodeName,omitempty"`
+	CreationTimestamp string                   `json:"creationTimestamp"`
+	AgeSeconds        int64                    `json:"ageSeconds"`
+	Counters          map[string]CounterStatus `json:"counters,omitempty"`
+	Lists             map[string]ListStatus    `json:"lists,omitempty"`
+}
+
+// GameServerScoringRequest defines the request to a Wasm scoring function
+type GameServerScoringRequest struct {
+	UID         string                       `json:"uid"`
+	Namespace   string                       `json:"namespace"`
+	Parameters  map[string]string            `json:"parameters,omitempty"`
+	GameServers []GameServerScoringCandidate `json:"gameServers"`
+}
+
+// GameServerScoringResponse defines the response of a Wasm scoring function
+type GameServerScoringResponse struct {
+	UID    string  `json:"uid"`
+	Scores []int64 `json:"scores"`
+}
+
+// GameServerScoringReview is passed to the Wasm scoring function with a populated Request value,
+// and then returned with a populated Response.
+type GameServerScoringReview struct {
+	Request  *GameServerScoringRequest  `json:"request"`
+	Response *GameServerScoringResponse `json:"response"`
+}
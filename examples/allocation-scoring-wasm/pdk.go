@@ -0,0 +1,96 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+/*
+A minimal subset of the Extism host functions, so this example has no dependencies.
+Larger plugins may want to use https://github.com/extism/go-pdk instead.
+*/
+
+import "encoding/json"
+
+//go:wasmimport extism:host/env input_length
+func extismInputLength() uint64
+
+//go:wasmimport extism:host/env input_load_u8
+func extismInputLoadU8(offset uint64) uint32
+
+//go:wasmimport extism:host/env alloc
+func extismAlloc(length uint64) uint64
+
+//go:wasmimport extism:host/env length
+func extismLength(offset uint64) uint64
+
+//go:wasmimport extism:host/env load_u8
+func extismLoadU8(offset uint64) uint32
+
+//go:wasmimport extism:host/env store_u8
+func extismStoreU8(offset uint64, v uint32)
+
+//go:wasmimport extism:host/env output_set
+func extismOutputSet(offset uint64, length uint64)
+
+//go:wasmimport extism:host/env error_set
+func extismErrorSet(offset uint64)
+
+//go:wasmimport extism:host/env config_get
+func extismConfigGet(offset uint64) uint64
+
+// inputJSON decodes the plugin input into v.
+func inputJSON(v any) error {
+	b := make([]byte, extismInputLength())
+	for i := range b {
+		b[i] = byte(extismInputLoadU8(uint64(i)))
+	}
+	return json.Unmarshal(b, v)
+}
+
+// outputJSON encodes v as the plugin output.
+func outputJSON(v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	offset := store(b)
+	extismOutputSet(offset, uint64(len(b)))
+	return nil
+}
+
+// setErrorString sets the error returned by the plugin call.
+func setErrorString(s string) {
+	extismErrorSet(store([]byte(s)))
+}
+
+// getConfig returns the value of a config entry of the plugin manifest.
+func getConfig(key string) (string, bool) {
+	offset := extismConfigGet(store([]byte(key)))
+	if offset == 0 {
+		return "", false
+	}
+	b := make([]byte, extismLength(offset))
+	for i := range b {
+		b[i] = byte(extismLoadU8(offset + uint64(i)))
+	}
+	return string(b), true
+}
+
+// store copies b into Extism memory, and returns its offset.
+func store(b []byte) uint64 {
+	offset := extismAlloc(uint64(len(b)))
+	for i, c := range b {
+		extismStoreU8(offset+uint64(i), uint32(c))
+	}
+	return offset
+}
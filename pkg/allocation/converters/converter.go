@@ -87,6 +87,37 @@ func ConvertAllocationRequestToGSA(in *pb.AllocationRequest) *allocationv1.GameS
 		}
 	}
 
+	if runtime.FeatureEnabled(runtime.FeatureWasmAllocationScoring) && in.GetScoring() != nil {
+		gsa.Spec.Scoring = &allocationv1.WasmScoring{
+			URL:        in.GetScoring().GetUrl(),
+			Hash:       in.GetScoring().GetHash(),
+			Function:   in.GetScoring().GetFunction(),
+			Config:     in.GetScoring().GetConfig(),
+			Parameters: in.GetScoring().GetParameters(),
+		}
+	}
+
+	if runtime.FeatureEnabled(runtime.FeatureConnectionTokens) && in.GetConnectionToken() != nil {
+		gsa.Spec.ConnectionToken = &allocationv1.ConnectionTokenRequest{
+			PlayerIDs: in.GetConnectionToken().GetPlayerIDs(),
+			Claims:    in.GetConnectionToken().GetClaims(),
+		}
+	}
+
+	if runtime.FeatureEnabled(runtime.FeatureMetaPatchTemplates) {
+		gsa.Spec.RequestID = in.GetRequestID()
+		gsa.Spec.TemplateParameters = in.GetTemplateParameters()
+	}
+
+	if runtime.FeatureEnabled(runtime.FeatureAllocationPayload) {
+		gsa.Spec.Payload = in.GetPayload()
+	}
+
+	if runtime.FeatureEnabled(runtime.FeatureCrossNamespaceAllocation) {
+		gsa.Spec.Namespaces = in.GetNamespaces()
+		gsa.Spec.NamespaceSelector = convertLabelSelectorToInternalLabelSelector(in.GetNamespaceSelector())
+	}
+
 	return gsa
 }
 
@@ -142,6 +173,37 @@ func ConvertGSAToAllocationRequest(in *allocationv1.GameServerAllocation) *pb.Al
 		}
 	}
 
+	if runtime.FeatureEnabled(runtime.FeatureWasmAllocationScoring) && in.Spec.Scoring != nil {
+		out.Scoring = &pb.WasmScoring{
+			Url:        in.Spec.Scoring.URL,
+			Hash:       in.Spec.Scoring.Hash,
+			Function:   in.Spec.Scoring.Function,
+			Config:     in.Spec.Scoring.Config,
+			Parameters: in.Spec.Scoring.Parameters,
+		}
+	}
+
+	if runtime.FeatureEnabled(runtime.FeatureConnectionTokens) && in.Spec.ConnectionToken != nil {
+		out.ConnectionToken = &pb.ConnectionTokenRequest{
+			PlayerIDs: in.Spec.ConnectionToken.PlayerIDs,
+			Claims:    in.Spec.ConnectionToken.Claims,
+		}
+	}
+
+	if runtime.FeatureEnabled(runtime.FeatureMetaPatchTemplates) {
+		out.RequestID = in.Spec.RequestID
+		out.TemplateParameters = in.Spec.TemplateParameters
+	}
+
+	if runtime.FeatureEnabled(runtime.FeatureAllocationPayload) {
+		out.Payload = in.Spec.Payload
+	}
+
+	if runtime.FeatureEnabled(runtime.FeatureCrossNamespaceAllocation) {
+		out.Namespaces = in.Spec.Namespaces
+		out.NamespaceSelector = convertInternalLabelSelectorToLabelSelector(in.Spec.NamespaceSelector)
+	}
+
 	return out
 }
 
@@ -330,6 +392,14 @@ func ConvertGSAToAllocationResponse(in *allocationv1.GameServerAllocation, grpcU
 		}
 	}
 
+	if runtime.FeatureEnabled(runtime.FeatureConnectionTokens) {
+		res.ConnectionToken = in.Status.ConnectionToken
+	}
+
+	if runtime.FeatureEnabled(runtime.FeatureCrossNamespaceAllocation) {
+		res.Namespace = in.Status.Namespace
+	}
+
 	return res, nil
 }
 
@@ -383,11 +453,51 @@ func ConvertAllocationResponseToGSA(in *pb.AllocationResponse, rs string) *alloc
 			out.Status.Lists = convertAllocationListsToGSALists(in.Lists)
 		}
 	}
+	if runtime.FeatureEnabled(runtime.FeatureConnectionTokens) {
+		out.Status.ConnectionToken = in.GetConnectionToken()
+	}
+	if runtime.FeatureEnabled(runtime.FeatureCrossNamespaceAllocation) {
+		out.Status.Namespace = in.GetNamespace()
+	}
 	out.SetGroupVersionKind(allocationv1.SchemeGroupVersion.WithKind("GameServerAllocation"))
 
 	return out
 }
 
+// ConvertCapacityRequestToCapacityQuery converts a CapacityRequest to a GameServerCapacityQuery
+func ConvertCapacityRequestToCapacityQuery(in *pb.CapacityRequest) *allocationv1.GameServerCapacityQuery {
+	if in == nil {
+		return nil
+	}
+
+	return &allocationv1.GameServerCapacityQuery{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: in.GetNamespace(),
+		},
+		Spec: allocationv1.GameServerCapacityQuerySpec{
+			Selectors:    convertGameServerSelectorsToInternalGameServerSelectors(in.GetGameServerSelectors()),
+			GroupByLabel: in.GetGroupByLabel(),
+			GroupByNode:  in.GetGroupByNode(),
+		},
+	}
+}
+
+// ConvertCapacityQueryToCapacityResponse converts the status of a GameServerCapacityQuery to a CapacityResponse
+func ConvertCapacityQueryToCapacityResponse(in *allocationv1.GameServerCapacityQuery) *pb.CapacityResponse {
+	if in == nil {
+		return nil
+	}
+
+	out := &pb.CapacityResponse{}
+	for _, sc := range in.Status.Selectors {
+		out.Selectors = append(out.Selectors, &pb.CapacityResponse_SelectorCapacity{
+			Count:  sc.Count,
+			Groups: sc.Groups,
+		})
+	}
+	return out
+}
+
 // convertGSAAddressesToAllocationAddresses converts corev1.NodeAddress to AllocationResponse_GameServerStatusAddress
 func convertGSAAddressesToAllocationAddresses(in []corev1.NodeAddress) []*pb.AllocationResponse_GameServerStatusAddress {
 	var addresses []*pb.AllocationResponse_GameServerStatusAddress
@@ -504,6 +614,12 @@ func convertAllocationPrioritiesToGSAPriorities(in []*pb.Priority) []agonesv1.Pr
 		switch p.Type {
 		case pb.Priority_List:
 			t = agonesv1.GameServerPriorityList
+		case pb.Priority_CreationTime:
+			t = agonesv1.GameServerPriorityCreationTime
+		case pb.Priority_ReadyTime:
+			t = agonesv1.GameServerPriorityReadyTime
+		case pb.Priority_Revision:
+			t = agonesv1.GameServerPriorityRevision
 		default: // case pb.Priority_Counter and case nil
 			t = agonesv1.GameServerPriorityCounter
 		}
@@ -533,6 +649,12 @@ func convertGSAPrioritiesToAllocationPriorities(in []agonesv1.Priority) []*pb.Pr
 		switch p.Type {
 		case agonesv1.GameServerPriorityList:
 			pt = pb.Priority_List
+		case agonesv1.GameServerPriorityCreationTime:
+			pt = pb.Priority_CreationTime
+		case agonesv1.GameServerPriorityReadyTime:
+			pt = pb.Priority_ReadyTime
+		case agonesv1.GameServerPriorityRevision:
+			pt = pb.Priority_Revision
 		default: // case agonesv1.GameServerPriorityCounter and case nil
 			pt = pb.Priority_Counter
 		}
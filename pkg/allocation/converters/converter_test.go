@@ -363,6 +363,180 @@ func TestConvertAllocationRequestToGameServerAllocation(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "scoring (WasmAllocationScoring)",
+			features: fmt.Sprintf("%s=true", runtime.FeatureWasmAllocationScoring),
+			in: &pb.AllocationRequest{
+				Namespace: "ns",
+				Scoring: &pb.WasmScoring{
+					Url:        "http://scoring.example.com/score.wasm",
+					Hash:       "abc",
+					Function:   "score",
+					Config:     map[string]string{"level": "debug"},
+					Parameters: map[string]string{"map": "searide"},
+				},
+			},
+			want: &allocationv1.GameServerAllocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+				},
+				Spec: allocationv1.GameServerAllocationSpec{
+					Scheduling: apis.Packed,
+					Scoring: &allocationv1.WasmScoring{
+						URL:        "http://scoring.example.com/score.wasm",
+						Hash:       "abc",
+						Function:   "score",
+						Config:     map[string]string{"level": "debug"},
+						Parameters: map[string]string{"map": "searide"},
+					},
+				},
+			},
+		},
+		{
+			name:     "connection token (ConnectionTokens)",
+			features: fmt.Sprintf("%s=true", runtime.FeatureConnectionTokens),
+			in: &pb.AllocationRequest{
+				Namespace: "ns",
+				ConnectionToken: &pb.ConnectionTokenRequest{
+					PlayerIDs: []string{"player1", "player2"},
+					Claims:    map[string]string{"match": "m1"},
+				},
+			},
+			want: &allocationv1.GameServerAllocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+				},
+				Spec: allocationv1.GameServerAllocationSpec{
+					Scheduling: apis.Packed,
+					ConnectionToken: &allocationv1.ConnectionTokenRequest{
+						PlayerIDs: []string{"player1", "player2"},
+						Claims:    map[string]string{"match": "m1"},
+					},
+				},
+			},
+		},
+		{
+			name:     "request id and template parameters (MetaPatchTemplates)",
+			features: fmt.Sprintf("%s=true", runtime.FeatureMetaPatchTemplates),
+			in: &pb.AllocationRequest{
+				Namespace:          "ns",
+				Metadata:           &pb.MetaPatch{Labels: map[string]string{"session": "{{ .Parameters.session }}"}},
+				RequestID:          "abc",
+				TemplateParameters: map[string]string{"session": "1234"},
+			},
+			want: &allocationv1.GameServerAllocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+				},
+				Spec: allocationv1.GameServerAllocationSpec{
+					Scheduling:         apis.Packed,
+					MetaPatch:          allocationv1.MetaPatch{Labels: map[string]string{"session": "{{ .Parameters.session }}"}},
+					RequestID:          "abc",
+					TemplateParameters: map[string]string{"session": "1234"},
+				},
+			},
+		},
+		{
+			name:     "payload (AllocationPayload)",
+			features: fmt.Sprintf("%s=true", runtime.FeatureAllocationPayload),
+			in: &pb.AllocationRequest{
+				Namespace: "ns",
+				Payload:   []byte(`{"teams":2}`),
+			},
+			want: &allocationv1.GameServerAllocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+				},
+				Spec: allocationv1.GameServerAllocationSpec{
+					Scheduling: apis.Packed,
+					Payload:    []byte(`{"teams":2}`),
+				},
+			},
+		},
+		{
+			name:     "namespaces (CrossNamespaceAllocation)",
+			features: fmt.Sprintf("%s=true", runtime.FeatureCrossNamespaceAllocation),
+			in: &pb.AllocationRequest{
+				Namespace:         "ns",
+				Namespaces:        []string{"us", "eu"},
+				NamespaceSelector: &pb.LabelSelector{MatchLabels: map[string]string{"team": "blue"}},
+			},
+			want: &allocationv1.GameServerAllocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+				},
+				Spec: allocationv1.GameServerAllocationSpec{
+					Scheduling:        apis.Packed,
+					Namespaces:        []string{"us", "eu"},
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "blue"}},
+				},
+			},
+		},
+		{
+			name:     "namespaces, feature disabled",
+			features: fmt.Sprintf("%s=false", runtime.FeatureCrossNamespaceAllocation),
+			in: &pb.AllocationRequest{
+				Namespace:         "ns",
+				Namespaces:        []string{"us", "eu"},
+				NamespaceSelector: &pb.LabelSelector{MatchLabels: map[string]string{"team": "blue"}},
+			},
+			want: &allocationv1.GameServerAllocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+				},
+				Spec: allocationv1.GameServerAllocationSpec{
+					Scheduling: apis.Packed,
+				},
+			},
+		},
+		{
+			name:     "payload, feature disabled",
+			features: fmt.Sprintf("%s=false", runtime.FeatureAllocationPayload),
+			in: &pb.AllocationRequest{
+				Namespace: "ns",
+				Payload:   []byte(`{"teams":2}`),
+			},
+			want: &allocationv1.GameServerAllocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+				},
+				Spec: allocationv1.GameServerAllocationSpec{
+					Scheduling: apis.Packed,
+				},
+			},
+		},
+		{
+			name:     "request id, feature disabled",
+			features: fmt.Sprintf("%s=false", runtime.FeatureMetaPatchTemplates),
+			in: &pb.AllocationRequest{
+				Namespace: "ns",
+				RequestID: "abc",
+			},
+			want: &allocationv1.GameServerAllocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+				},
+				Spec: allocationv1.GameServerAllocationSpec{
+					Scheduling: apis.Packed,
+				},
+			},
+		},
+		{
+			name:     "scoring, feature disabled",
+			features: fmt.Sprintf("%s=false", runtime.FeatureWasmAllocationScoring),
+			in: &pb.AllocationRequest{
+				Namespace: "ns",
+				Scoring:   &pb.WasmScoring{Url: "http://scoring.example.com/score.wasm"},
+			},
+			want: &allocationv1.GameServerAllocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+				},
+				Spec: allocationv1.GameServerAllocationSpec{
+					Scheduling: apis.Packed,
+				},
+			},
+		},
 		{
 			name:     "empty fields to GSA",
 			features: fmt.Sprintf("%s=false&%s=false", runtime.FeaturePlayerAllocationFilter, runtime.FeatureCountsAndLists),
@@ -683,6 +857,91 @@ func TestConvertGSAToAllocationRequest(t *testing.T) {
 				Metadata:            &pb.MetaPatch{},
 				MetaPatch:           &pb.MetaPatch{},
 			},
+		}, {
+			name:     "GSA with scoring (WasmAllocationScoring)",
+			features: fmt.Sprintf("%s=true", runtime.FeatureWasmAllocationScoring),
+			in: &allocationv1.GameServerAllocation{
+				Spec: allocationv1.GameServerAllocationSpec{
+					Scheduling: apis.Packed,
+					Scoring: &allocationv1.WasmScoring{
+						URL:        "http://scoring.example.com/score.wasm",
+						Function:   "score",
+						Parameters: map[string]string{"map": "searide"},
+					},
+				},
+			},
+			want: &pb.AllocationRequest{
+				MultiClusterSetting: &pb.MultiClusterSetting{},
+				Metadata:            &pb.MetaPatch{},
+				MetaPatch:           &pb.MetaPatch{},
+				Scoring: &pb.WasmScoring{
+					Url:        "http://scoring.example.com/score.wasm",
+					Function:   "score",
+					Parameters: map[string]string{"map": "searide"},
+				},
+			},
+		}, {
+			name:     "GSA with connection token (ConnectionTokens)",
+			features: fmt.Sprintf("%s=true", runtime.FeatureConnectionTokens),
+			in: &allocationv1.GameServerAllocation{
+				Spec: allocationv1.GameServerAllocationSpec{
+					Scheduling:      apis.Packed,
+					ConnectionToken: &allocationv1.ConnectionTokenRequest{PlayerIDs: []string{"player1"}},
+				},
+			},
+			want: &pb.AllocationRequest{
+				MultiClusterSetting: &pb.MultiClusterSetting{},
+				Metadata:            &pb.MetaPatch{},
+				MetaPatch:           &pb.MetaPatch{},
+				ConnectionToken:     &pb.ConnectionTokenRequest{PlayerIDs: []string{"player1"}},
+			},
+		}, {
+			name:     "GSA with request id (MetaPatchTemplates)",
+			features: fmt.Sprintf("%s=true", runtime.FeatureMetaPatchTemplates),
+			in: &allocationv1.GameServerAllocation{
+				Spec: allocationv1.GameServerAllocationSpec{
+					Scheduling:         apis.Packed,
+					RequestID:          "abc",
+					TemplateParameters: map[string]string{"session": "1234"},
+				},
+			},
+			want: &pb.AllocationRequest{
+				MultiClusterSetting: &pb.MultiClusterSetting{},
+				Metadata:            &pb.MetaPatch{},
+				MetaPatch:           &pb.MetaPatch{},
+				RequestID:           "abc",
+				TemplateParameters:  map[string]string{"session": "1234"},
+			},
+		}, {
+			name:     "GSA with payload (AllocationPayload)",
+			features: fmt.Sprintf("%s=true", runtime.FeatureAllocationPayload),
+			in: &allocationv1.GameServerAllocation{
+				Spec: allocationv1.GameServerAllocationSpec{
+					Scheduling: apis.Packed,
+					Payload:    []byte(`{"teams":2}`),
+				},
+			},
+			want: &pb.AllocationRequest{
+				MultiClusterSetting: &pb.MultiClusterSetting{},
+				Metadata:            &pb.MetaPatch{},
+				MetaPatch:           &pb.MetaPatch{},
+				Payload:             []byte(`{"teams":2}`),
+			},
+		}, {
+			name:     "GSA with namespaces (CrossNamespaceAllocation)",
+			features: fmt.Sprintf("%s=true", runtime.FeatureCrossNamespaceAllocation),
+			in: &allocationv1.GameServerAllocation{
+				Spec: allocationv1.GameServerAllocationSpec{
+					Scheduling: apis.Packed,
+					Namespaces: []string{"us", "eu"},
+				},
+			},
+			want: &pb.AllocationRequest{
+				MultiClusterSetting: &pb.MultiClusterSetting{},
+				Metadata:            &pb.MetaPatch{},
+				MetaPatch:           &pb.MetaPatch{},
+				Namespaces:          []string{"us", "eu"},
+			},
 		}, {
 			name:     "partial GSA with CountsAndLists",
 			features: fmt.Sprintf("%s=true", runtime.FeatureCountsAndLists),
@@ -1091,6 +1350,52 @@ func TestConvertGSAToAllocationResponse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "connection token is set (ConnectionTokens)",
+			features: fmt.Sprintf("%s=true", runtime.FeatureConnectionTokens),
+			in: &allocationv1.GameServerAllocation{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "GameServerAllocation",
+					APIVersion: "allocation.agones.dev/v1",
+				},
+				Status: allocationv1.GameServerAllocationStatus{
+					State:           allocationv1.GameServerAllocationAllocated,
+					GameServerName:  "GSN",
+					Address:         "address",
+					Source:          "local",
+					ConnectionToken: "header.payload.signature",
+				},
+			},
+			want: &pb.AllocationResponse{
+				GameServerName:  "GSN",
+				Address:         "address",
+				Source:          "local",
+				ConnectionToken: "header.payload.signature",
+			},
+		},
+		{
+			name:     "namespace is set (CrossNamespaceAllocation)",
+			features: fmt.Sprintf("%s=true", runtime.FeatureCrossNamespaceAllocation),
+			in: &allocationv1.GameServerAllocation{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "GameServerAllocation",
+					APIVersion: "allocation.agones.dev/v1",
+				},
+				Status: allocationv1.GameServerAllocationStatus{
+					State:          allocationv1.GameServerAllocationAllocated,
+					GameServerName: "GSN",
+					Address:        "address",
+					Source:         "local",
+					Namespace:      "eu",
+				},
+			},
+			want: &pb.AllocationResponse{
+				GameServerName: "GSN",
+				Address:        "address",
+				Source:         "local",
+				Namespace:      "eu",
+			},
+		},
 		{
 			name:     "all fields are set (CountsAndLists)",
 			features: fmt.Sprintf("%s=true", runtime.FeatureCountsAndLists),
@@ -1570,3 +1875,81 @@ func TestConvertAllocationResponseToGSA(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertCapacityRequestToCapacityQuery(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, ConvertCapacityRequestToCapacityQuery(nil))
+
+	in := &pb.CapacityRequest{
+		Namespace: "default",
+		GameServerSelectors: []*pb.GameServerSelector{
+			{MatchLabels: map[string]string{"agones.dev/fleet": "blue"}},
+			{MatchLabels: map[string]string{"agones.dev/fleet": "green"}, GameServerState: pb.GameServerSelector_ALLOCATED},
+		},
+		GroupByLabel: "region",
+	}
+	allocated := agonesv1.GameServerStateAllocated
+	ready := agonesv1.GameServerStateReady
+	want := &allocationv1.GameServerCapacityQuery{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+		Spec: allocationv1.GameServerCapacityQuerySpec{
+			Selectors: []allocationv1.GameServerSelector{
+				{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"agones.dev/fleet": "blue"}}, GameServerState: &ready},
+				{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"agones.dev/fleet": "green"}}, GameServerState: &allocated},
+			},
+			GroupByLabel: "region",
+		},
+	}
+
+	out := ConvertCapacityRequestToCapacityQuery(in)
+	assert.Equal(t, want.ObjectMeta, out.ObjectMeta)
+	assert.Equal(t, want.Spec.GroupByLabel, out.Spec.GroupByLabel)
+	assert.False(t, out.Spec.GroupByNode)
+	require.Len(t, out.Spec.Selectors, 2)
+	for i := range want.Spec.Selectors {
+		assert.Equal(t, want.Spec.Selectors[i].LabelSelector, out.Spec.Selectors[i].LabelSelector)
+		assert.Equal(t, want.Spec.Selectors[i].GameServerState, out.Spec.Selectors[i].GameServerState)
+	}
+}
+
+func TestConvertCapacityQueryToCapacityResponse(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, ConvertCapacityQueryToCapacityResponse(nil))
+
+	in := &allocationv1.GameServerCapacityQuery{
+		Status: allocationv1.GameServerCapacityQueryStatus{
+			Selectors: []allocationv1.SelectorCapacity{
+				{Count: 3, Groups: map[string]int64{"eu": 1, "us": 2}},
+				{Count: 0},
+			},
+		},
+	}
+	want := &pb.CapacityResponse{
+		Selectors: []*pb.CapacityResponse_SelectorCapacity{
+			{Count: 3, Groups: map[string]int64{"eu": 1, "us": 2}},
+			{Count: 0},
+		},
+	}
+	assert.Equal(t, want, ConvertCapacityQueryToCapacityResponse(in))
+}
+
+func TestConvertLifecyclePriorities(t *testing.T) {
+	t.Parallel()
+
+	in := []*pb.Priority{
+		{Type: pb.Priority_CreationTime, Order: pb.Priority_Ascending},
+		{Type: pb.Priority_ReadyTime, Order: pb.Priority_Descending},
+		{Type: pb.Priority_Revision, Order: pb.Priority_Descending},
+	}
+	want := []agonesv1.Priority{
+		{Type: agonesv1.GameServerPriorityCreationTime, Order: agonesv1.GameServerPriorityAscending},
+		{Type: agonesv1.GameServerPriorityReadyTime, Order: agonesv1.GameServerPriorityDescending},
+		{Type: agonesv1.GameServerPriorityRevision, Order: agonesv1.GameServerPriorityDescending},
+	}
+
+	out := convertAllocationPrioritiesToGSAPriorities(in)
+	assert.Equal(t, want, out)
+	assert.Equal(t, in, convertGSAPrioritiesToAllocationPriorities(out))
+}
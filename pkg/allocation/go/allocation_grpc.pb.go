@@ -39,6 +39,14 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AllocationServiceClient interface {
 	Allocate(ctx context.Context, in *AllocationRequest, opts ...grpc.CallOption) (*AllocationResponse, error)
+	// [Stage: Dev]
+	// [FeatureFlag:AllocatorRelease]
+	// Release returns an Allocated GameServer back to the Ready state.
+	Release(ctx context.Context, in *ReleaseRequest, opts ...grpc.CallOption) (*ReleaseResponse, error)
+	// [Stage: Dev]
+	// [FeatureFlag:CapacityQuery]
+	// QueryCapacity returns how many GameServers currently match each selector, without allocating any of them.
+	QueryCapacity(ctx context.Context, in *CapacityRequest, opts ...grpc.CallOption) (*CapacityResponse, error)
 }
 
 type allocationServiceClient struct {
@@ -58,11 +66,37 @@ func (c *allocationServiceClient) Allocate(ctx context.Context, in *AllocationRe
 	return out, nil
 }
 
+func (c *allocationServiceClient) Release(ctx context.Context, in *ReleaseRequest, opts ...grpc.CallOption) (*ReleaseResponse, error) {
+	out := new(ReleaseResponse)
+	err := c.cc.Invoke(ctx, "/allocation.AllocationService/Release", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allocationServiceClient) QueryCapacity(ctx context.Context, in *CapacityRequest, opts ...grpc.CallOption) (*CapacityResponse, error) {
+	out := new(CapacityResponse)
+	err := c.cc.Invoke(ctx, "/allocation.AllocationService/QueryCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AllocationServiceServer is the server API for AllocationService service.
 // All implementations should embed UnimplementedAllocationServiceServer
 // for forward compatibility
 type AllocationServiceServer interface {
 	Allocate(context.Context, *AllocationRequest) (*AllocationResponse, error)
+	// [Stage: Dev]
+	// [FeatureFlag:AllocatorRelease]
+	// Release returns an Allocated GameServer back to the Ready state.
+	Release(context.Context, *ReleaseRequest) (*ReleaseResponse, error)
+	// [Stage: Dev]
+	// [FeatureFlag:CapacityQuery]
+	// QueryCapacity returns how many GameServers currently match each selector, without allocating any of them.
+	QueryCapacity(context.Context, *CapacityRequest) (*CapacityResponse, error)
 }
 
 // UnimplementedAllocationServiceServer should be embedded to have forward compatible implementations.
@@ -72,6 +106,12 @@ type UnimplementedAllocationServiceServer struct {
 func (UnimplementedAllocationServiceServer) Allocate(context.Context, *AllocationRequest) (*AllocationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Allocate not implemented")
 }
+func (UnimplementedAllocationServiceServer) Release(context.Context, *ReleaseRequest) (*ReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Release not implemented")
+}
+func (UnimplementedAllocationServiceServer) QueryCapacity(context.Context, *CapacityRequest) (*CapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCapacity not implemented")
+}
 
 // UnsafeAllocationServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to AllocationServiceServer will
@@ -102,6 +142,42 @@ func _AllocationService_Allocate_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AllocationService_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllocationServiceServer).Release(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/allocation.AllocationService/Release",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllocationServiceServer).Release(ctx, req.(*ReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllocationService_QueryCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllocationServiceServer).QueryCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/allocation.AllocationService/QueryCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllocationServiceServer).QueryCapacity(ctx, req.(*CapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AllocationService_ServiceDesc is the grpc.ServiceDesc for AllocationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -113,6 +189,14 @@ var AllocationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Allocate",
 			Handler:    _AllocationService_Allocate_Handler,
 		},
+		{
+			MethodName: "Release",
+			Handler:    _AllocationService_Release_Handler,
+		},
+		{
+			MethodName: "QueryCapacity",
+			Handler:    _AllocationService_QueryCapacity_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/allocation/allocation.proto",
@@ -15,8 +15,10 @@
 package v1
 
 import (
+	"fmt"
 	"math"
 
+	"agones.dev/agones/pkg/util/runtime"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
@@ -42,6 +44,12 @@ const (
 	GameServerPriorityCounter string = "Counter"
 	// GameServerPriorityList is a Type for sorting Game Servers by List
 	GameServerPriorityList string = "List"
+	// GameServerPriorityCreationTime is a Type for sorting Game Servers by when they were created
+	GameServerPriorityCreationTime string = "CreationTime"
+	// GameServerPriorityReadyTime is a Type for sorting Game Servers by when they last moved to Ready
+	GameServerPriorityReadyTime string = "ReadyTime"
+	// GameServerPriorityRevision is a Type for sorting Game Servers by the revision of their GameServerSet within its Fleet
+	GameServerPriorityRevision string = "Revision"
 	// GameServerPriorityAscending is a Priority Order where the smaller count is preferred in sorting.
 	GameServerPriorityAscending string = "Ascending"
 	// GameServerPriorityDescending is a Priority Order where the larger count is preferred in sorting.
@@ -102,6 +110,27 @@ func validateGSSpec(apiHooks APIHooks, gs gsSpec, fldPath *field.Path) field.Err
 	return allErrs
 }
 
+// validatePriorityFeatures checks that the Priorities only use the Types of enabled feature gates.
+// Used by Fleet and GameServerSet
+func validatePriorityFeatures(priorities []Priority, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if runtime.FeatureEnabled(runtime.FeatureLifecyclePriorities) {
+		return allErrs
+	}
+	for i, p := range priorities {
+		if IsLifecyclePriority(p.Type) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("type"), fmt.Sprintf("Value cannot be set to %s unless feature flag %s is enabled", p.Type, runtime.FeatureLifecyclePriorities)))
+		}
+	}
+	return allErrs
+}
+
+// IsLifecyclePriority returns true if the Priority Type sorts by the lifecycle of the GameServers,
+// rather than by a Counter or List.
+func IsLifecyclePriority(t string) bool {
+	return t == GameServerPriorityCreationTime || t == GameServerPriorityReadyTime || t == GameServerPriorityRevision
+}
+
 // validateObjectMeta Check ObjectMeta specification
 // Used by Fleet, GameServerSet and GameServer
 func validateObjectMeta(objMeta *metav1.ObjectMeta, fldPath *field.Path) field.ErrorList {
@@ -177,10 +206,15 @@ func (ao *AllocationOverflow) Apply(gs *GameServer) {
 
 // Priority is a sorting option for GameServers with Counters or Lists based on the available capacity,
 // i.e. the current Capacity value, minus either the Count value or List length.
+// [Stage:Dev]
+// [FeatureFlag:LifecyclePriorities]
+// GameServers can also be sorted by their creation time, the time they last moved to Ready, or the
+// revision of their GameServerSet within its Fleet.
 type Priority struct {
-	// Type: Sort by a "Counter" or a "List".
+	// Type: Sort by a "Counter" or a "List", or by "CreationTime", "ReadyTime" or "Revision".
 	Type string `json:"type"`
 	// Key: The name of the Counter or List. If not found on the GameServer, has no impact.
+	// Not used by the other Types.
 	Key string `json:"key"`
 	// Order: Sort by "Ascending" or "Descending". "Descending" a bigger available capacity is preferred.
 	// "Ascending" would be smaller available capacity is preferred.
@@ -15,8 +15,10 @@
 package v1
 
 import (
+	"fmt"
 	"testing"
 
+	"agones.dev/agones/pkg/util/runtime"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -194,3 +196,23 @@ func TestAllocationOverflowApply(t *testing.T) {
 	require.Equal(t, map[string]string{"colour": "red", "version": "1.0"}, gs.ObjectMeta.Labels)
 	require.Equal(t, map[string]string{"colour": "green", "map": "ice cream", "version": "1.0"}, gs.ObjectMeta.Annotations)
 }
+
+func TestValidatePriorityFeatures(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	priorities := []Priority{
+		{Type: GameServerPriorityCounter, Key: "rooms", Order: GameServerPriorityAscending},
+		{Type: GameServerPriorityRevision, Order: GameServerPriorityDescending},
+	}
+	fldPath := field.NewPath("spec", "priorities")
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=false", runtime.FeatureLifecyclePriorities)))
+	errs := validatePriorityFeatures(priorities, fldPath)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.priorities[1].type", errs[0].Field)
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureLifecyclePriorities)))
+	assert.Empty(t, validatePriorityFeatures(priorities, fldPath))
+}
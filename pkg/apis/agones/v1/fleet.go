@@ -16,6 +16,7 @@ package v1
 
 import (
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -30,6 +31,9 @@ const (
 	// FleetNameLabel is the label that the name of the Fleet
 	// is set to on GameServerSet and GameServer  the Fleet controls
 	FleetNameLabel = agones.GroupName + "/fleet"
+	// FleetRevisionAnnotation is the annotation that the revision of a GameServerSet within its Fleet
+	// is set to on the GameServerSet and the GameServers it controls
+	FleetRevisionAnnotation = agones.GroupName + "/fleet-revision"
 )
 
 // +genclient
@@ -109,6 +113,17 @@ type FleetStatus struct {
 	// for this Fleet.
 	// +optional
 	Lists map[string]AggregatedListStatus `json:"lists,omitempty"`
+	// [Stage:Dev]
+	// [FeatureFlag:MinReadySeconds]
+	// WarmingReplicas are the number of Ready GameServer replicas that have not yet been Ready for their
+	// MinReadySeconds, and so cannot be allocated yet.
+	// +optional
+	WarmingReplicas int32 `json:"warmingReplicas,omitempty"`
+	// [Stage:Dev]
+	// [FeatureFlag:CrashLoopBackoff]
+	// Conditions are the conditions of the Fleet, aggregated from those of its GameServerSets, such as CrashLoopBackOff.
+	// +optional
+	Conditions []GameServerSetCondition `json:"conditions,omitempty"`
 }
 
 // GameServerSet returns a single GameServerSet for this Fleet definition
@@ -226,6 +241,7 @@ func (f *Fleet) Validate(apiHooks APIHooks) field.ErrorList {
 	if f.Spec.Priorities != nil && !runtime.FeatureEnabled(runtime.FeatureCountsAndLists) {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "priorities"), "FeatureCountsAndLists is not enabled"))
 	}
+	allErrs = append(allErrs, validatePriorityFeatures(f.Spec.Priorities, field.NewPath("spec", "priorities"))...)
 
 	return allErrs
 }
@@ -248,6 +264,17 @@ func (f *Fleet) LowerBoundReplicas(i int32) int32 {
 	return i
 }
 
+// Condition returns the condition of the given type from the Fleet Status, or nil if it has not been set.
+func (s *FleetStatus) Condition(conditionType string) *GameServerSetCondition {
+	return findGameServerSetCondition(s.Conditions, conditionType)
+}
+
+// SetCondition sets the Status and Message of the condition of the given type on the Fleet Status,
+// adding the condition if it has not been set yet. LastTransitionTime is only updated when the Status changes.
+func (s *FleetStatus) SetCondition(conditionType string, status corev1.ConditionStatus, message string, now metav1.Time) {
+	s.Conditions = setGameServerSetCondition(s.Conditions, conditionType, status, message, now)
+}
+
 // SumGameServerSets calculates a total from the value returned from the passed in function.
 // Useful for calculating totals based on status value(s), such as gsSet.Status.Replicas
 func SumGameServerSets(list []*GameServerSet, f func(gsSet *GameServerSet) int32) int32 {
@@ -19,7 +19,9 @@ import (
 	"fmt"
 	"net"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"agones.dev/agones/pkg"
 	"agones.dev/agones/pkg/apis"
@@ -32,6 +34,7 @@ import (
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -106,6 +109,30 @@ const (
 	EvictionSafeNever EvictionSafe = "Never"
 )
 
+// IdleAction is what happens to an Allocated GameServer once it has been empty for its Idle.Seconds
+type IdleAction string
+
+const (
+	// IdleActionShutdown moves the GameServer to Shutdown.
+	IdleActionShutdown IdleAction = "Shutdown"
+	// IdleActionReady moves the GameServer back to Ready, as if SDK.Ready() had been called.
+	IdleActionReady IdleAction = "Ready"
+)
+
+// GameServerHealthStatus is the status of a health report sent by the game server through the SDK.
+type GameServerHealthStatus string
+
+const (
+	// GameServerHealthStatusHealthy is reported when the game server is working as expected.
+	GameServerHealthStatusHealthy GameServerHealthStatus = "Healthy"
+	// GameServerHealthStatusDegraded is reported when the game server can keep its current players, but should not
+	// be given new ones. A Degraded GameServer cannot be allocated.
+	GameServerHealthStatusDegraded GameServerHealthStatus = "Degraded"
+	// GameServerHealthStatusUnhealthy is reported when the game server cannot recover, and moves the GameServer
+	// to Unhealthy.
+	GameServerHealthStatusUnhealthy GameServerHealthStatus = "Unhealthy"
+)
+
 // SdkServerLogLevel is the log level for SDK server (sidecar) logs
 type SdkServerLogLevel string
 
@@ -157,6 +184,36 @@ const (
 	// GameServerErroredAtAnnotation is an annotation that records the timestamp the GameServer entered the
 	// error state. The timestamp is encoded in RFC3339 format.
 	GameServerErroredAtAnnotation = agones.GroupName + "/errored-at"
+	// GameServerLastAllocatedAnnotation is an annotation containing an RFC 3339 formatted
+	// timestamp of the most recent allocation of the GameServer.
+	GameServerLastAllocatedAnnotation = agones.GroupName + "/last-allocated"
+	// GameServerShutdownAtAnnotation is an annotation that records the time at which a GameServer that has exceeded
+	// one of its lifetime limits will be moved to Shutdown. The timestamp is encoded in RFC3339 format.
+	GameServerShutdownAtAnnotation = agones.GroupName + "/shutdown-at"
+	// GameServerRecycleAnnotation is an annotation set together with the RequestReady state by SDK.Recycle(), so
+	// the GameServer is reset to its initial labels, annotations, Counters, Lists and player tracking before it
+	// moves back to Ready.
+	GameServerRecycleAnnotation = agones.GroupName + "/recycle"
+	// GameServerTerminationReasonAnnotation is an annotation that records why the GameServer is about to be
+	// terminated, so the SDK can notify the game server process before it receives SIGTERM.
+	GameServerTerminationReasonAnnotation = agones.GroupName + "/termination-reason"
+	// GameServerTerminationDeadlineAnnotation is an annotation that records the time by which a GameServer that is
+	// about to be terminated will have been stopped. The timestamp is encoded in RFC3339 format.
+	GameServerTerminationDeadlineAnnotation = agones.GroupName + "/termination-deadline"
+	// GameServerAllocatorClientAnnotation is an annotation that records the identity of the allocator client that
+	// allocated the GameServer, so Allocated GameServers can be counted against allocation quotas.
+	GameServerAllocatorClientAnnotation = agones.GroupName + "/allocator-client"
+	// GameServerPostMortemLabel is a label set to "true" on a failed GameServer that is kept for post-mortem
+	// inspection, as configured by its Spec.PostMortem.
+	GameServerPostMortemLabel = agones.GroupName + "/post-mortem"
+	// GameServerPostMortemUntilAnnotation is an annotation that records the time until which a failed GameServer is
+	// kept for post-mortem inspection, before it is deleted. The timestamp is encoded in RFC3339 format.
+	GameServerPostMortemUntilAnnotation = agones.GroupName + "/post-mortem-until"
+	// GameServerHealthyCondition is the type of the condition in the GameServer's status.conditions that is True
+	// when the last health report of the game server was Healthy, and False otherwise.
+	GameServerHealthyCondition = agones.GroupName + "/Healthy"
+	// sdkMetadataPrefix is the prefix of the labels and annotations set through SDK.SetLabel() and SDK.SetAnnotation().
+	sdkMetadataPrefix = agones.GroupName + "/sdk-"
 	// FinalizerName is the domain name and finalizer path used to manage garbage collection of the GameServer.
 	FinalizerName = agones.GroupName + "/controller"
 
@@ -166,6 +223,17 @@ const (
 	// PassthroughPortAssignmentAnnotation is an annotation to keep track of game server container and its Passthrough ports indices
 	PassthroughPortAssignmentAnnotation = "agones.dev/container-passthrough-port-assignment"
 
+	// TerminationReasonEviction is the termination reason of a GameServer whose Pod is being evicted or deleted.
+	TerminationReasonEviction = "Eviction"
+	// TerminationReasonNodeDrain is the termination reason of a GameServer whose Pod is being evicted from a
+	// cordoned Node.
+	TerminationReasonNodeDrain = "NodeDrain"
+	// TerminationReasonScaleDown is the termination reason of a GameServer removed by its GameServerSet scaling down.
+	TerminationReasonScaleDown = "ScaleDown"
+	// TerminationReasonLifetimeExpired is the termination reason of a GameServer that has exceeded one of its
+	// lifetime limits.
+	TerminationReasonLifetimeExpired = "LifetimeExpired"
+
 	// True is the string "true" to appease the goconst lint.
 	True = "true"
 	// False is the string "false" to appease the goconst lint.
@@ -247,9 +315,109 @@ type GameServerSpec struct {
 	// Eviction specifies the eviction tolerance of the GameServer. Defaults to "Never".
 	// +optional
 	Eviction *Eviction `json:"eviction,omitempty"`
+	// [Stage:Dev]
+	// [FeatureFlag:MinReadySeconds]
+	// MinReadySeconds is the minimum number of seconds that the GameServer must have been Ready for, before it can be
+	// allocated, to give it time to warm up. Defaults to 0, which makes it allocatable as soon as it is Ready.
+	// +optional
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
+	// [Stage:Dev]
+	// [FeatureFlag:ReadinessGates]
+	// ReadinessGates are the conditions in the GameServer's status.conditions that must all be True before the
+	// GameServer can move from RequestReady to Ready, once SDK.Ready() has been called.
+	// +optional
+	ReadinessGates []GameServerReadinessGate `json:"readinessGates,omitempty"`
+	// [Stage:Dev]
+	// [FeatureFlag:GameServerLifetime]
+	// Lifetime configures limits on how long the GameServer can run for in total, and stay Ready or Allocated for,
+	// before it is moved to Shutdown.
+	// +optional
+	Lifetime *Lifetime `json:"lifetime,omitempty"`
+	// [Stage:Dev]
+	// [FeatureFlag:IdleGameServers]
+	// Idle configures what happens to an Allocated GameServer once it has been empty for a period of time.
+	// +optional
+	Idle *Idle `json:"idle,omitempty"`
+	// [Stage:Dev]
+	// [FeatureFlag:PostMortemRetention]
+	// PostMortem configures keeping GameServers of a Fleet that became Unhealthy or Error, and their Pods, for
+	// inspection, rather than deleting them. Replacements are still created immediately.
+	// +optional
+	PostMortem *PostMortem `json:"postMortem,omitempty"`
 	// immutableReplicas is present in gameservers.agones.dev but omitted here (it's always 1).
 }
 
+// PostMortem configures how many failed GameServers of a Fleet are kept for inspection, and for how long.
+// Kept GameServers are labelled with agones.dev/post-mortem=true, and are not counted in the replicas of their
+// Fleet. They are deleted together with their GameServerSet, such as at the end of a rolling update.
+type PostMortem struct {
+	// MaxGameServers is the maximum number of failed GameServers kept per Fleet, or per GameServerSet if it is not
+	// part of a Fleet. Defaults to 1.
+	// +optional
+	MaxGameServers int32 `json:"maxGameServers,omitempty"`
+	// Seconds is the number of seconds a failed GameServer is kept for, before it is deleted. Defaults to 3600.
+	// +optional
+	Seconds int32 `json:"seconds,omitempty"`
+}
+
+// Lifetime configures limits on how long a GameServer can run for, before it is moved to Shutdown.
+// A limit of 0 means there is no limit.
+type Lifetime struct {
+	// MaxSeconds is the maximum number of seconds since the GameServer was created.
+	// +optional
+	MaxSeconds int32 `json:"maxSeconds,omitempty"`
+	// MaxReadySeconds is the maximum number of seconds the GameServer can stay Ready for.
+	// +optional
+	MaxReadySeconds int32 `json:"maxReadySeconds,omitempty"`
+	// MaxAllocatedSeconds is the maximum number of seconds the GameServer can stay Allocated for, since it was last
+	// allocated.
+	// +optional
+	MaxAllocatedSeconds int32 `json:"maxAllocatedSeconds,omitempty"`
+	// GracePeriodSeconds is the number of seconds between a limit being exceeded and the GameServer being moved to
+	// Shutdown. In the meantime, the GameServer is annotated with the time at which it will be shut down, and it
+	// cannot be allocated. Defaults to 0, which moves the GameServer to Shutdown as soon as a limit is exceeded.
+	// +optional
+	GracePeriodSeconds int32 `json:"gracePeriodSeconds,omitempty"`
+}
+
+// Idle configures what happens to an Allocated GameServer once it has been empty for a period of time.
+// The GameServer is empty when the Count of the named Counter is 0, the named List has no values, or, if neither
+// is set, its player tracking count is 0. This includes the time before any player has connected.
+type Idle struct {
+	// Seconds is the number of seconds the Allocated GameServer has to be empty for, before the Action is taken.
+	Seconds int32 `json:"seconds"`
+	// Action is what happens to the GameServer, either "Shutdown" or "Ready". Defaults to "Shutdown".
+	// +optional
+	Action IdleAction `json:"action,omitempty"`
+	// Counter is the name of the Counter whose Count is 0 when the GameServer is empty.
+	// +optional
+	Counter string `json:"counter,omitempty"`
+	// List is the name of the List that has no values when the GameServer is empty.
+	// +optional
+	List string `json:"list,omitempty"`
+}
+
+// GameServerReadinessGate refers to a condition that must be True before a GameServer can move to Ready
+type GameServerReadinessGate struct {
+	// ConditionType refers to a condition in the GameServer's status.conditions with a matching type.
+	ConditionType string `json:"conditionType"`
+}
+
+// GameServerCondition is a condition of a GameServer, set either through the SDK, or by an external controller
+// updating the GameServer's status.conditions.
+type GameServerCondition struct {
+	// Type of the condition, as referred to by a readiness gate.
+	Type string `json:"type"`
+	// Status of the condition, one of True, False or Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// LastTransitionTime is the last time the condition changed Status.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Message is a human readable message with details about the condition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // PlayersSpec tracks the initial player capacity
 type PlayersSpec struct {
 	InitialCapacity int64 `json:"initialCapacity,omitempty"`
@@ -274,6 +442,64 @@ type Health struct {
 	FailureThreshold int32 `json:"failureThreshold,omitempty"`
 	// InitialDelaySeconds initial delay before checking health
 	InitialDelaySeconds int32 `json:"initialDelaySeconds,omitempty"`
+	// [Stage:Dev]
+	// [FeatureFlag:SidecarHealthProbes]
+	// Probe configures the SDK server sidecar to check the health of the game server process itself twice every
+	// PeriodSeconds, for game servers that cannot send SDK Health() pings. A successful probe counts as a health ping.
+	// +optional
+	Probe *HealthProbe `json:"probe,omitempty"`
+}
+
+// HealthProbe configures how the SDK server sidecar checks the health of the game server process.
+// Exactly one of UDP, TCP, HTTP or GRPC must be set. Ports are container ports, reached on localhost.
+type HealthProbe struct {
+	// UDP sends a datagram to a port, and expects a datagram with a specific payload in response.
+	// +optional
+	UDP *UDPHealthProbe `json:"udp,omitempty"`
+	// TCP expects a port to accept a connection.
+	// +optional
+	TCP *TCPHealthProbe `json:"tcp,omitempty"`
+	// HTTP sends a GET request to a port, and expects a 2xx or 3xx response.
+	// +optional
+	HTTP *HTTPHealthProbe `json:"http,omitempty"`
+	// GRPC calls the standard gRPC health checking service on a port, and expects it to be SERVING.
+	// +optional
+	GRPC *GRPCHealthProbe `json:"grpc,omitempty"`
+}
+
+// UDPHealthProbe sends Request to Port, and expects Response back.
+type UDPHealthProbe struct {
+	// Port is the container port to send the request to.
+	Port int32 `json:"port"`
+	// Request is the payload of the datagram sent to the game server.
+	// +optional
+	Request string `json:"request,omitempty"`
+	// Response is the payload the game server must respond with.
+	Response string `json:"response"`
+}
+
+// TCPHealthProbe opens a connection to Port.
+type TCPHealthProbe struct {
+	// Port is the container port to connect to.
+	Port int32 `json:"port"`
+}
+
+// HTTPHealthProbe sends a GET request to Path on Port.
+type HTTPHealthProbe struct {
+	// Port is the container port to send the request to.
+	Port int32 `json:"port"`
+	// Path is the path of the request. Defaults to "/".
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// GRPCHealthProbe calls the gRPC health checking service on Port.
+type GRPCHealthProbe struct {
+	// Port is the container port of the gRPC server.
+	Port int32 `json:"port"`
+	// Service is the name of the service to check. Defaults to "", which checks the server as a whole.
+	// +optional
+	Service string `json:"service,omitempty"`
 }
 
 // GameServerPort defines a set of Ports that
@@ -337,9 +563,71 @@ type GameServerStatus struct {
 	// Eviction specifies the eviction tolerance of the GameServer.
 	// +optional
 	Eviction *Eviction `json:"eviction,omitempty"`
+	// [Stage:Dev]
+	// [FeatureFlag:AllocationPayload]
+	// AllocationPayload is the payload of the GameServerAllocation that the GameServer was last allocated with.
+	// It is cleared when the GameServer moves back to Ready.
+	// As it is sent with every list and watch of GameServers, and every SDK WatchGameServer update,
+	// the payload is limited to 4KiB. Store larger data elsewhere, and reference it from the payload.
+	// +optional
+	AllocationPayload []byte `json:"allocationPayload,omitempty"`
+	// [Stage:Dev]
+	// [FeatureFlag:MinReadySeconds]
+	// ReadyTime is the time at which the GameServer last moved to Ready. Also recorded when the
+	// LifecyclePriorities or GameServerLifetime feature flags are enabled.
+	// +optional
+	ReadyTime *metav1.Time `json:"readyTime,omitempty"`
+	// [Stage:Dev]
+	// [FeatureFlag:ReadinessGates]
+	// Conditions are the conditions of the GameServer that its readiness gates refer to.
+	// +optional
+	Conditions []GameServerCondition `json:"conditions,omitempty"`
+	// [Stage:Dev]
+	// [FeatureFlag:IdleGameServers]
+	// IdleSince is the time since which the Allocated GameServer has been empty, as configured by its Spec.Idle.
+	// +optional
+	IdleSince *metav1.Time `json:"idleSince,omitempty"`
+	// [Stage:Dev]
+	// [FeatureFlag:HealthReports]
+	// Health is the last health report sent by the game server through the SDK, or the reason it was found
+	// Unhealthy by its health checks.
+	// +optional
+	Health *GameServerHealth `json:"health,omitempty"`
+	// [Stage:Dev]
+	// [FeatureFlag:GameServerStateHistory]
+	// StateHistory is the list of the most recent changes of State of the GameServer, oldest first, keeping at most
+	// GameServerStateHistoryLimit transitions.
+	// +optional
+	StateHistory []GameServerStateTransition `json:"stateHistory,omitempty"`
 	// immutableReplicas is present in gameservers.agones.dev but omitted here (it's always 1).
 }
 
+// GameServerStateHistoryLimit is the maximum number of transitions kept in a GameServer's Status.StateHistory.
+const GameServerStateHistoryLimit = 20
+
+// GameServerStateTransition is a change of the State of a GameServer.
+type GameServerStateTransition struct {
+	// State is the State the GameServer moved to.
+	State GameServerState `json:"state"`
+	// Time is when the GameServer moved to State.
+	Time metav1.Time `json:"time"`
+	// Reason is why the GameServer moved to State, such as "SDK.Ready() complete".
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// GameServerHealth is a report of the health of a GameServer, with the reason for it.
+type GameServerHealth struct {
+	// Status is one of Healthy, Degraded or Unhealthy.
+	Status GameServerHealthStatus `json:"status"`
+	// Reason is why the GameServer is in this Status, such as "tick loop stalled".
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// LastTransitionTime is the last time the Status changed.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
 // GameServerStatusPort shows the port that was allocated to a
 // GameServer.
 type GameServerStatusPort struct {
@@ -388,6 +676,28 @@ func (gss *GameServerSpec) ApplyDefaults() {
 	gss.applyEvictionDefaults()
 	gss.applySchedulingDefaults()
 	gss.applySdkServerDefaults()
+	gss.applyIdleDefaults()
+	gss.applyPostMortemDefaults()
+}
+
+// applyPostMortemDefaults applies the default number of failed GameServers to keep (1), and for how long (1 hour)
+func (gss *GameServerSpec) applyPostMortemDefaults() {
+	if gss.PostMortem == nil {
+		return
+	}
+	if gss.PostMortem.MaxGameServers == 0 {
+		gss.PostMortem.MaxGameServers = 1
+	}
+	if gss.PostMortem.Seconds == 0 {
+		gss.PostMortem.Seconds = 3600
+	}
+}
+
+// applyIdleDefaults applies the default action ("Shutdown") for idle GameServers
+func (gss *GameServerSpec) applyIdleDefaults() {
+	if gss.Idle != nil && gss.Idle.Action == "" {
+		gss.Idle.Action = IdleActionShutdown
+	}
 }
 
 // applySdkServerDefaults applies the default log level ("Info") for the sidecar
@@ -422,17 +732,21 @@ func (gss *GameServerSpec) applyHealthDefaults() {
 		if gss.Health.InitialDelaySeconds <= 0 {
 			gss.Health.InitialDelaySeconds = 5
 		}
+		if p := gss.Health.Probe; p != nil && p.HTTP != nil && p.HTTP.Path == "" {
+			p.HTTP.Path = "/"
+		}
 	}
 }
 
 // applyStatusDefaults applies Status defaults
 func (gs *GameServer) applyStatusDefaults() {
 	if gs.Status.State == "" {
-		gs.Status.State = GameServerStateCreating
+		state := GameServerStateCreating
 		// applyStatusDefaults() should be called after applyPortDefaults()
 		if gs.HasPortPolicy(Dynamic) || gs.HasPortPolicy(Passthrough) {
-			gs.Status.State = GameServerStatePortAllocation
+			state = GameServerStatePortAllocation
 		}
+		gs.SetState(state, "GameServer created")
 	}
 
 	if runtime.FeatureEnabled(runtime.FeaturePlayerTracking) {
@@ -535,6 +849,49 @@ func (gss *GameServerSpec) validateFeatureGates(fldPath *field.Path) field.Error
 		}
 	}
 
+	if !runtime.FeatureEnabled(runtime.FeatureMinReadySeconds) {
+		if gss.MinReadySeconds != 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("minReadySeconds"), fmt.Sprintf("Value cannot be set unless feature flag %s is enabled", runtime.FeatureMinReadySeconds)))
+		}
+	}
+
+	if !runtime.FeatureEnabled(runtime.FeatureReadinessGates) {
+		if len(gss.ReadinessGates) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("readinessGates"), fmt.Sprintf("Value cannot be set unless feature flag %s is enabled", runtime.FeatureReadinessGates)))
+		}
+	}
+
+	if !runtime.FeatureEnabled(runtime.FeatureGameServerLifetime) {
+		if gss.Lifetime != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("lifetime"), fmt.Sprintf("Value cannot be set unless feature flag %s is enabled", runtime.FeatureGameServerLifetime)))
+		}
+	}
+
+	if !runtime.FeatureEnabled(runtime.FeaturePostMortemRetention) {
+		if gss.PostMortem != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("postMortem"), fmt.Sprintf("Value cannot be set unless feature flag %s is enabled", runtime.FeaturePostMortemRetention)))
+		}
+	}
+
+	if !runtime.FeatureEnabled(runtime.FeatureSidecarHealthProbes) {
+		if gss.Health.Probe != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("health", "probe"), fmt.Sprintf("Value cannot be set unless feature flag %s is enabled", runtime.FeatureSidecarHealthProbes)))
+		}
+	}
+
+	if gss.Idle != nil {
+		switch {
+		case !runtime.FeatureEnabled(runtime.FeatureIdleGameServers):
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("idle"), fmt.Sprintf("Value cannot be set unless feature flag %s is enabled", runtime.FeatureIdleGameServers)))
+		case gss.Idle.Counter != "" && !runtime.FeatureEnabled(runtime.FeatureCountsAndLists):
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("idle", "counter"), fmt.Sprintf("Value cannot be set unless feature flag %s is enabled", runtime.FeatureCountsAndLists)))
+		case gss.Idle.List != "" && !runtime.FeatureEnabled(runtime.FeatureCountsAndLists):
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("idle", "list"), fmt.Sprintf("Value cannot be set unless feature flag %s is enabled", runtime.FeatureCountsAndLists)))
+		case gss.Idle.Counter == "" && gss.Idle.List == "" && !runtime.FeatureEnabled(runtime.FeaturePlayerTracking):
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("idle"), fmt.Sprintf("A counter or list is required unless feature flag %s is enabled", runtime.FeaturePlayerTracking)))
+		}
+	}
+
 	if !runtime.FeatureEnabled(runtime.FeaturePortPolicyNone) {
 		for i, p := range gss.Ports {
 			if p.PortPolicy == None {
@@ -546,11 +903,125 @@ func (gss *GameServerSpec) validateFeatureGates(fldPath *field.Path) field.Error
 	return allErrs
 }
 
+// Validate validates that none of the Lifetime limits are negative.
+func (l *Lifetime) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	validate := func(name string, value int32) {
+		if value < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), value, apimachineryvalidation.IsNegativeErrorMsg))
+		}
+	}
+	validate("maxSeconds", l.MaxSeconds)
+	validate("maxReadySeconds", l.MaxReadySeconds)
+	validate("maxAllocatedSeconds", l.MaxAllocatedSeconds)
+	validate("gracePeriodSeconds", l.GracePeriodSeconds)
+	return allErrs
+}
+
+// Validate validates that neither the number of GameServers to keep, nor how long to keep them for, is negative.
+func (p *PostMortem) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if p.MaxGameServers < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxGameServers"), p.MaxGameServers, apimachineryvalidation.IsNegativeErrorMsg))
+	}
+	if p.Seconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("seconds"), p.Seconds, apimachineryvalidation.IsNegativeErrorMsg))
+	}
+	return allErrs
+}
+
+// Validate validates that exactly one kind of HealthProbe is set, with a valid port.
+func (p *HealthProbe) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	var kinds []string
+	validatePort := func(kind string, port int32) {
+		kinds = append(kinds, kind)
+		for _, msg := range validation.IsValidPortNum(int(port)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(kind, "port"), port, msg))
+		}
+	}
+	if p.UDP != nil {
+		validatePort("udp", p.UDP.Port)
+		if p.UDP.Response == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("udp", "response"), "the expected response payload is required"))
+		}
+	}
+	if p.TCP != nil {
+		validatePort("tcp", p.TCP.Port)
+	}
+	if p.HTTP != nil {
+		validatePort("http", p.HTTP.Port)
+	}
+	if p.GRPC != nil {
+		validatePort("grpc", p.GRPC.Port)
+	}
+	if len(kinds) != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, strings.Join(kinds, ", "), "exactly one of udp, tcp, http or grpc must be set"))
+	}
+	return allErrs
+}
+
+// validateIdle validates that the Idle period is positive, and that it refers to at most one of the
+// GameServer's Counters or Lists.
+func (gss *GameServerSpec) validateIdle(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	idle := gss.Idle
+	if idle.Seconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("seconds"), idle.Seconds, "must be greater than 0"))
+	}
+	if idle.Action != IdleActionShutdown && idle.Action != IdleActionReady {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("action"), idle.Action, []string{string(IdleActionShutdown), string(IdleActionReady)}))
+	}
+	if idle.Counter != "" && idle.List != "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("list"), idle.List, "cannot be set together with counter"))
+	}
+	if _, ok := gss.Counters[idle.Counter]; idle.Counter != "" && !ok {
+		allErrs = append(allErrs, field.NotFound(fldPath.Child("counter"), idle.Counter))
+	}
+	if _, ok := gss.Lists[idle.List]; idle.List != "" && !ok {
+		allErrs = append(allErrs, field.NotFound(fldPath.Child("list"), idle.List))
+	}
+	return allErrs
+}
+
+// validateReadinessGates validates that each readiness gate refers to a qualified condition type, at most once.
+func validateReadinessGates(gates []GameServerReadinessGate, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	seen := map[string]bool{}
+	for i, gate := range gates {
+		path := fldPath.Index(i).Child("conditionType")
+		for _, msg := range validation.IsQualifiedName(gate.ConditionType) {
+			allErrs = append(allErrs, field.Invalid(path, gate.ConditionType, msg))
+		}
+		if seen[gate.ConditionType] {
+			allErrs = append(allErrs, field.Duplicate(path, gate.ConditionType))
+		}
+		seen[gate.ConditionType] = true
+	}
+	return allErrs
+}
+
 // Validate validates the GameServerSpec configuration.
 // devAddress is a specific IP address used for local Gameservers, for fleets "" is used
 // If a GameServer Spec is invalid there will be > 0 values in the returned array
 func (gss *GameServerSpec) Validate(apiHooks APIHooks, devAddress string, fldPath *field.Path) field.ErrorList {
 	allErrs := gss.validateFeatureGates(fldPath)
+	if gss.MinReadySeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReadySeconds"), gss.MinReadySeconds, apimachineryvalidation.IsNegativeErrorMsg))
+	}
+	allErrs = append(allErrs, validateReadinessGates(gss.ReadinessGates, fldPath.Child("readinessGates"))...)
+	if gss.Lifetime != nil {
+		allErrs = append(allErrs, gss.Lifetime.Validate(fldPath.Child("lifetime"))...)
+	}
+	if gss.Idle != nil {
+		allErrs = append(allErrs, gss.validateIdle(fldPath.Child("idle"))...)
+	}
+	if gss.Health.Probe != nil {
+		allErrs = append(allErrs, gss.Health.Probe.Validate(fldPath.Child("health", "probe"))...)
+	}
+	if gss.PostMortem != nil {
+		allErrs = append(allErrs, gss.PostMortem.Validate(fldPath.Child("postMortem"))...)
+	}
 	if len(devAddress) > 0 {
 		// verify that the value is a valid IP address.
 		if net.ParseIP(devAddress) == nil {
@@ -687,6 +1158,298 @@ func (gs *GameServer) IsBeingDeleted() bool {
 	return !gs.ObjectMeta.DeletionTimestamp.IsZero() || gs.Status.State == GameServerStateShutdown
 }
 
+// WarmUpRemaining returns how much longer the GameServer has to be Ready for, before it has been Ready
+// for its MinReadySeconds and can be allocated. Returns 0 if the GameServer is not Ready, or has warmed up.
+func (gs *GameServer) WarmUpRemaining(now time.Time) time.Duration {
+	if !runtime.FeatureEnabled(runtime.FeatureMinReadySeconds) || gs.Spec.MinReadySeconds <= 0 ||
+		gs.Status.State != GameServerStateReady || gs.Status.ReadyTime == nil {
+		return 0
+	}
+	remaining := gs.Status.ReadyTime.Add(time.Duration(gs.Spec.MinReadySeconds) * time.Second).Sub(now)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// IsWarmingUp returns true if the GameServer is Ready, but has not yet been Ready for its MinReadySeconds
+func (gs *GameServer) IsWarmingUp(now time.Time) bool {
+	return gs.WarmUpRemaining(now) > 0
+}
+
+// LifetimeLimit returns the time at which the first of the GameServer's lifetime limits that applies to its current
+// state is exceeded, and a description of that limit. Returns false if no limit applies, such as when the
+// GameServerLifetime feature flag is not enabled.
+func (gs *GameServer) LifetimeLimit() (time.Time, string, bool) {
+	l := gs.Spec.Lifetime
+	if !runtime.FeatureEnabled(runtime.FeatureGameServerLifetime) || l == nil || TerminalGameServerStates[gs.Status.State] {
+		return time.Time{}, "", false
+	}
+
+	var limit time.Time
+	var reason string
+	apply := func(start time.Time, seconds int32, r string) {
+		if seconds <= 0 {
+			return
+		}
+		t := start.Add(time.Duration(seconds) * time.Second)
+		if limit.IsZero() || t.Before(limit) {
+			limit = t
+			reason = r
+		}
+	}
+
+	apply(gs.ObjectMeta.CreationTimestamp.Time, l.MaxSeconds, "Maximum lifetime")
+	switch gs.Status.State {
+	case GameServerStateReady:
+		if gs.Status.ReadyTime != nil {
+			apply(gs.Status.ReadyTime.Time, l.MaxReadySeconds, "Maximum time Ready")
+		}
+	case GameServerStateAllocated:
+		if allocated, err := time.Parse(time.RFC3339Nano, gs.ObjectMeta.Annotations[GameServerLastAllocatedAnnotation]); err == nil {
+			apply(allocated, l.MaxAllocatedSeconds, "Maximum time Allocated")
+		}
+	}
+
+	return limit, reason, !limit.IsZero()
+}
+
+// Recycle resets the GameServer for a new session, keeping its Pod and ports. Labels and annotations set through the
+// SDK, and the last allocation time, allocator client and termination notice of the session, are removed. If the
+// GameServer was created from a template, all other labels and annotations that Agones does not manage are reset to
+// the ones in the template. Counters, Lists and player tracking are reset to the values in the GameServer's Spec.
+func (gs *GameServer) Recycle(template *metav1.ObjectMeta) {
+	var labels, annotations map[string]string
+	if template != nil {
+		labels = template.Labels
+		annotations = template.Annotations
+	}
+	gs.ObjectMeta.Labels = recycleMetadata(gs.ObjectMeta.Labels, labels, template != nil)
+	gs.ObjectMeta.Annotations = recycleMetadata(gs.ObjectMeta.Annotations, annotations, template != nil)
+	delete(gs.ObjectMeta.Annotations, GameServerRecycleAnnotation)
+
+	if gs.Status.Players != nil {
+		gs.Status.Players = &PlayerStatus{}
+		if gs.Spec.Players != nil {
+			gs.Status.Players.Capacity = gs.Spec.Players.InitialCapacity
+		}
+	}
+	gs.applyCountsListsStatus()
+	gs.Status.AllocationPayload = nil
+	gs.Status.IdleSince = nil
+}
+
+// recycleMetadata returns the labels or annotations of a recycled GameServer.
+func recycleMetadata(current, template map[string]string, fromTemplate bool) map[string]string {
+	result := make(map[string]string, len(current))
+	for k, v := range current {
+		switch {
+		case k == GameServerLastAllocatedAnnotation, k == GameServerAllocatorClientAnnotation,
+			k == GameServerTerminationReasonAnnotation, k == GameServerTerminationDeadlineAnnotation:
+		case strings.HasPrefix(k, sdkMetadataPrefix) && k != VersionAnnotation:
+		case !fromTemplate || strings.HasPrefix(k, agones.GroupName+"/"):
+			result[k] = v
+		}
+	}
+	for k, v := range template {
+		result[k] = v
+	}
+	return result
+}
+
+// IsEmpty returns true if the GameServer is empty, as configured by its Spec.Idle: the Count of the Counter is 0,
+// the List has no values, or, if neither is set, its player tracking count is 0. Returns false if the GameServer
+// has no Spec.Idle, or the Counter, List or player tracking status it refers to is missing.
+func (gs *GameServer) IsEmpty() bool {
+	idle := gs.Spec.Idle
+	switch {
+	case idle == nil:
+		return false
+	case idle.Counter != "":
+		counter, ok := gs.Status.Counters[idle.Counter]
+		return ok && counter.Count == 0
+	case idle.List != "":
+		list, ok := gs.Status.Lists[idle.List]
+		return ok && len(list.Values) == 0
+	default:
+		return gs.Status.Players != nil && gs.Status.Players.Count == 0
+	}
+}
+
+// IsShuttingDown returns true if the GameServer has exceeded one of its lifetime limits, and is waiting out its
+// grace period before being moved to Shutdown.
+func (gs *GameServer) IsShuttingDown() bool {
+	if !runtime.FeatureEnabled(runtime.FeatureGameServerLifetime) {
+		return false
+	}
+	_, ok := gs.ObjectMeta.Annotations[GameServerShutdownAtAnnotation]
+	return ok
+}
+
+// IsPostMortem returns true if the GameServer has failed, and is being kept for post-mortem inspection.
+// Always false if the PostMortemRetention feature flag is not enabled.
+func (gs *GameServer) IsPostMortem() bool {
+	if !runtime.FeatureEnabled(runtime.FeaturePostMortemRetention) {
+		return false
+	}
+	return gs.ObjectMeta.Labels[GameServerPostMortemLabel] == True
+}
+
+// PostMortemUntil returns the time until which the GameServer is kept for post-mortem inspection. Returns false
+// if the GameServer is not being kept, or the time cannot be parsed.
+func (gs *GameServer) PostMortemUntil() (time.Time, bool) {
+	if !gs.IsPostMortem() {
+		return time.Time{}, false
+	}
+	until, err := time.Parse(time.RFC3339, gs.ObjectMeta.Annotations[GameServerPostMortemUntilAnnotation])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+// KeepForPostMortem labels the failed GameServer as being kept for post-mortem inspection, and annotates it with
+// the time until which it is kept, as configured by its Spec.PostMortem.
+func (gs *GameServer) KeepForPostMortem(now time.Time) {
+	if gs.ObjectMeta.Labels == nil {
+		gs.ObjectMeta.Labels = map[string]string{}
+	}
+	if gs.ObjectMeta.Annotations == nil {
+		gs.ObjectMeta.Annotations = map[string]string{}
+	}
+	var seconds int32
+	if gs.Spec.PostMortem != nil {
+		seconds = gs.Spec.PostMortem.Seconds
+	}
+	gs.ObjectMeta.Labels[GameServerPostMortemLabel] = True
+	gs.ObjectMeta.Annotations[GameServerPostMortemUntilAnnotation] = now.Add(time.Duration(seconds) * time.Second).UTC().Format(time.RFC3339)
+}
+
+// TerminationGracePeriod returns how long the GameServer's Pod is given to stop after it receives SIGTERM.
+func (gs *GameServer) TerminationGracePeriod() time.Duration {
+	if seconds := gs.Spec.Template.Spec.TerminationGracePeriodSeconds; seconds != nil {
+		return time.Duration(*seconds) * time.Second
+	}
+	return corev1.DefaultTerminationGracePeriodSeconds * time.Second
+}
+
+// TerminationNotice returns why the GameServer is about to be terminated, and the time by which it will have been
+// stopped, which is zero if not yet known. Returns false if the GameServer is not about to be terminated, or the
+// TerminationNotices feature flag is not enabled.
+func (gs *GameServer) TerminationNotice() (string, time.Time, bool) {
+	if !runtime.FeatureEnabled(runtime.FeatureTerminationNotices) {
+		return "", time.Time{}, false
+	}
+	if reason, ok := gs.ObjectMeta.Annotations[GameServerTerminationReasonAnnotation]; ok {
+		// the deadline is zero if the annotation is missing or invalid
+		deadline, _ := time.Parse(time.RFC3339, gs.ObjectMeta.Annotations[GameServerTerminationDeadlineAnnotation])
+		return reason, deadline, true
+	}
+	if gs.IsShuttingDown() {
+		shutdownAt, err := time.Parse(time.RFC3339, gs.ObjectMeta.Annotations[GameServerShutdownAtAnnotation])
+		if err != nil {
+			return TerminationReasonLifetimeExpired, time.Time{}, true
+		}
+		return TerminationReasonLifetimeExpired, shutdownAt.Add(gs.TerminationGracePeriod()), true
+	}
+	return "", time.Time{}, false
+}
+
+// SetTerminationNotice records why the GameServer is about to be terminated, and the time by which it will have been
+// stopped, if known.
+func (gs *GameServer) SetTerminationNotice(reason string, deadline time.Time) {
+	if gs.ObjectMeta.Annotations == nil {
+		gs.ObjectMeta.Annotations = map[string]string{}
+	}
+	gs.ObjectMeta.Annotations[GameServerTerminationReasonAnnotation] = reason
+	if deadline.IsZero() {
+		delete(gs.ObjectMeta.Annotations, GameServerTerminationDeadlineAnnotation)
+		return
+	}
+	gs.ObjectMeta.Annotations[GameServerTerminationDeadlineAnnotation] = deadline.UTC().Format(time.RFC3339)
+}
+
+// SetState sets the State of the GameServer Status. If the State changes and the GameServerStateHistory feature
+// flag is enabled, the transition is also recorded in the Status.StateHistory with the given reason, dropping the
+// oldest transitions beyond GameServerStateHistoryLimit.
+func (gs *GameServer) SetState(state GameServerState, reason string) {
+	if gs.Status.State != state && runtime.FeatureEnabled(runtime.FeatureGameServerStateHistory) {
+		gs.Status.StateHistory = append(gs.Status.StateHistory, GameServerStateTransition{State: state, Time: metav1.Now(), Reason: reason})
+		if n := len(gs.Status.StateHistory); n > GameServerStateHistoryLimit {
+			gs.Status.StateHistory = gs.Status.StateHistory[n-GameServerStateHistoryLimit:]
+		}
+	}
+	gs.Status.State = state
+}
+
+// IsDegraded returns true if the last health report of the GameServer was Degraded, in which case it cannot be
+// allocated. Always false if the HealthReports feature flag is not enabled.
+func (gs *GameServer) IsDegraded() bool {
+	if !runtime.FeatureEnabled(runtime.FeatureHealthReports) {
+		return false
+	}
+	return gs.Status.Health != nil && gs.Status.Health.Status == GameServerHealthStatusDegraded
+}
+
+// SetHealth records a health report on the GameServer Status, and reflects it in its Healthy condition.
+// LastTransitionTime is only updated when the health Status changes.
+func (gs *GameServer) SetHealth(status GameServerHealthStatus, reason string, now metav1.Time) {
+	if gs.Status.Health == nil || gs.Status.Health.Status != status {
+		gs.Status.Health = &GameServerHealth{Status: status, LastTransitionTime: now}
+	}
+	gs.Status.Health.Reason = reason
+
+	conditionStatus := corev1.ConditionFalse
+	if status == GameServerHealthStatusHealthy {
+		conditionStatus = corev1.ConditionTrue
+	}
+	gs.SetCondition(GameServerHealthyCondition, conditionStatus, reason, now)
+}
+
+// ReadinessGatesPassed returns true if every condition that the GameServer's readiness gates refer to is True.
+// Always true if the ReadinessGates feature flag is not enabled.
+func (gs *GameServer) ReadinessGatesPassed() bool {
+	if !runtime.FeatureEnabled(runtime.FeatureReadinessGates) {
+		return true
+	}
+	for _, gate := range gs.Spec.ReadinessGates {
+		c := gs.Condition(gate.ConditionType)
+		if c == nil || c.Status != corev1.ConditionTrue {
+			return false
+		}
+	}
+	return true
+}
+
+// Condition returns the condition of the given type from the GameServer Status, or nil if it has not been set.
+func (gs *GameServer) Condition(conditionType string) *GameServerCondition {
+	for i := range gs.Status.Conditions {
+		if gs.Status.Conditions[i].Type == conditionType {
+			return &gs.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetCondition sets the Status and Message of the condition of the given type on the GameServer Status,
+// adding the condition if it has not been set yet. LastTransitionTime is only updated when the Status changes.
+func (gs *GameServer) SetCondition(conditionType string, status corev1.ConditionStatus, message string, now metav1.Time) {
+	if c := gs.Condition(conditionType); c != nil {
+		if c.Status != status {
+			c.LastTransitionTime = now
+		}
+		c.Status = status
+		c.Message = message
+		return
+	}
+	gs.Status.Conditions = append(gs.Status.Conditions, GameServerCondition{
+		Type:               conditionType,
+		Status:             status,
+		LastTransitionTime: now,
+		Message:            message,
+	})
+}
+
 // IsBeforeReady returns true if the GameServer Status has yet to move to or past the Ready
 // state in its lifecycle, such as Allocated or Reserved, or any of the Error/Unhealthy states
 func (gs *GameServer) IsBeforeReady() bool {
@@ -1140,7 +1903,7 @@ func MergeRemoveDuplicates(list1 []string, list2 []string) []string {
 }
 
 // CompareCountAndListPriorities compares two game servers based on a list of CountsAndLists Priorities using available
-// capacity as the comparison.
+// capacity as the comparison, and on any lifecycle Priorities.
 func (gs *GameServer) CompareCountAndListPriorities(priorities []Priority, other *GameServer) *bool {
 	for _, priority := range priorities {
 		res := gs.compareCountAndListPriority(&priority, other)
@@ -1158,8 +1921,33 @@ func (gs *GameServer) CompareCountAndListPriorities(priorities []Priority, other
 	return nil
 }
 
+// lifecycleValue returns the value of the GameServer to sort on for a lifecycle Priority Type, and
+// whether the GameServer has one: the creation or Ready time in nanoseconds, or the revision.
+func (gs *GameServer) lifecycleValue(t string) (int64, bool) {
+	switch t {
+	case GameServerPriorityCreationTime:
+		if gs.ObjectMeta.CreationTimestamp.IsZero() {
+			return 0, false
+		}
+		return gs.ObjectMeta.CreationTimestamp.UnixNano(), true
+	case GameServerPriorityReadyTime:
+		if gs.Status.ReadyTime == nil {
+			return 0, false
+		}
+		return gs.Status.ReadyTime.UnixNano(), true
+	case GameServerPriorityRevision:
+		revision, err := strconv.ParseInt(gs.ObjectMeta.Annotations[FleetRevisionAnnotation], 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		return revision, true
+	}
+	return 0, false
+}
+
 // compareCountAndListPriority compares two game servers based on a CountsAndLists Priority using available
-// capacity (Capacity - Count for Counters, and Capacity - len(Values) for Lists) as the comparison.
+// capacity (Capacity - Count for Counters, and Capacity - len(Values) for Lists) as the comparison, or
+// on a lifecycle Priority using the creation time, Ready time or revision.
 // Returns true if gs1 < gs2; false if gs1 > gs2; nil if gs1 == gs2; nil if neither gamer server has the Priority.
 // If only one game server has the Priority, prefer that server. I.e. nil < gsX when Priority
 // Order is Descending (3, 2, 1, 0, nil), and nil > gsX when Order is Ascending (0, 1, 2, 3, nil).
@@ -1208,6 +1996,23 @@ func (gs *GameServer) compareCountAndListPriority(p *Priority, other *GameServer
 		}
 		gs1ok = ok1
 		gs2ok = ok2
+	case GameServerPriorityCreationTime, GameServerPriorityReadyTime, GameServerPriorityRevision:
+		if !runtime.FeatureEnabled(runtime.FeatureLifecyclePriorities) {
+			return nil
+		}
+		value1, ok1 := gs.lifecycleValue(p.Type)
+		value2, ok2 := other.lifecycleValue(p.Type)
+		if ok1 && ok2 {
+			if value1 < value2 {
+				return &t
+			}
+			if value1 > value2 {
+				return &f
+			}
+			return nil
+		}
+		gs1ok = ok1
+		gs2ok = ok2
 	}
 	// If only one game server has the Priority, prefer that server. I.e. nil < gsX when Order is
 	// Descending (3, 2, 1, 0, nil), and nil > gsX when Order is Ascending (0, 1, 2, 3, nil).
@@ -308,6 +308,14 @@ func TestGameServerApplyDefaults(t *testing.T) {
 				e.health = Health{Disabled: true}
 			}),
 		},
+		"http health probe": {
+			gameServer: defaultGameServerAnd(func(gss *GameServerSpec) {
+				gss.Health = Health{Probe: &HealthProbe{HTTP: &HTTPHealthProbe{Port: 8080}}}
+			}),
+			expected: wantDefaultAnd(func(e *expected) {
+				e.health.Probe = &HealthProbe{HTTP: &HTTPHealthProbe{Port: 8080, Path: "/"}}
+			}),
+		},
 		"convert from legacy single port to multiple": {
 			gameServer: defaultGameServerAnd(func(gss *GameServerSpec) {
 				gss.Ports[0] = GameServerPort{
@@ -1582,6 +1590,331 @@ func TestGameServerValidateFeatures(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "MinReadySeconds is disabled, MinReadySeconds field set",
+			feature:     fmt.Sprintf("%s=false", runtime.FeatureMinReadySeconds),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container:       "testing",
+					MinReadySeconds: 10,
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+			want: field.ErrorList{
+				field.Forbidden(
+					field.NewPath("spec.minReadySeconds"),
+					"Value cannot be set unless feature flag MinReadySeconds is enabled",
+				),
+			},
+		},
+		{
+			description: "MinReadySeconds is enabled, MinReadySeconds field is negative",
+			feature:     fmt.Sprintf("%s=true", runtime.FeatureMinReadySeconds),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container:       "testing",
+					MinReadySeconds: -1,
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+			want: field.ErrorList{
+				field.Invalid(field.NewPath("spec.minReadySeconds"), int32(-1), "must be greater than or equal to 0"),
+			},
+		},
+		{
+			description: "MinReadySeconds is enabled, MinReadySeconds field set",
+			feature:     fmt.Sprintf("%s=true", runtime.FeatureMinReadySeconds),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container:       "testing",
+					MinReadySeconds: 10,
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+		},
+		{
+			description: "ReadinessGates is disabled, ReadinessGates field set",
+			feature:     fmt.Sprintf("%s=false", runtime.FeatureReadinessGates),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container:      "testing",
+					ReadinessGates: []GameServerReadinessGate{{ConditionType: "AssetsCached"}},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+			want: field.ErrorList{
+				field.Forbidden(
+					field.NewPath("spec.readinessGates"),
+					"Value cannot be set unless feature flag ReadinessGates is enabled",
+				),
+			},
+		},
+		{
+			description: "ReadinessGates is enabled, invalid and duplicate condition types",
+			feature:     fmt.Sprintf("%s=true", runtime.FeatureReadinessGates),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container: "testing",
+					ReadinessGates: []GameServerReadinessGate{
+						{ConditionType: "AssetsCached"},
+						{ConditionType: "assets cached"},
+						{ConditionType: "AssetsCached"},
+					},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+			want: field.ErrorList{
+				field.Invalid(field.NewPath("spec.readinessGates[1].conditionType"), "assets cached",
+					"name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')"),
+				field.Duplicate(field.NewPath("spec.readinessGates[2].conditionType"), "AssetsCached"),
+			},
+		},
+		{
+			description: "GameServerLifetime is disabled, Lifetime field set",
+			feature:     fmt.Sprintf("%s=false", runtime.FeatureGameServerLifetime),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container: "testing",
+					Lifetime:  &Lifetime{MaxSeconds: 3600},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+			want: field.ErrorList{
+				field.Forbidden(
+					field.NewPath("spec.lifetime"),
+					"Value cannot be set unless feature flag GameServerLifetime is enabled",
+				),
+			},
+		},
+		{
+			description: "GameServerLifetime is enabled, Lifetime fields are negative",
+			feature:     fmt.Sprintf("%s=true", runtime.FeatureGameServerLifetime),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container: "testing",
+					Lifetime:  &Lifetime{MaxSeconds: 3600, MaxReadySeconds: -1, GracePeriodSeconds: -2},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+			want: field.ErrorList{
+				field.Invalid(field.NewPath("spec.lifetime.maxReadySeconds"), int32(-1), "must be greater than or equal to 0"),
+				field.Invalid(field.NewPath("spec.lifetime.gracePeriodSeconds"), int32(-2), "must be greater than or equal to 0"),
+			},
+		},
+		{
+			description: "GameServerLifetime is enabled, Lifetime field set",
+			feature:     fmt.Sprintf("%s=true", runtime.FeatureGameServerLifetime),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container: "testing",
+					Lifetime:  &Lifetime{MaxSeconds: 86400, MaxReadySeconds: 3600, MaxAllocatedSeconds: 7200, GracePeriodSeconds: 60},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+		},
+		{
+			description: "IdleGameServers is disabled, Idle field set",
+			feature:     fmt.Sprintf("%s=false&%s=true", runtime.FeatureIdleGameServers, runtime.FeaturePlayerTracking),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container: "testing",
+					Idle:      &Idle{Seconds: 60, Action: IdleActionShutdown},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+			want: field.ErrorList{
+				field.Forbidden(
+					field.NewPath("spec.idle"),
+					"Value cannot be set unless feature flag IdleGameServers is enabled",
+				),
+			},
+		},
+		{
+			description: "IdleGameServers is enabled, player tracking is disabled",
+			feature:     fmt.Sprintf("%s=true&%s=false", runtime.FeatureIdleGameServers, runtime.FeaturePlayerTracking),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container: "testing",
+					Idle:      &Idle{Seconds: 60, Action: IdleActionShutdown},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+			want: field.ErrorList{
+				field.Forbidden(
+					field.NewPath("spec.idle"),
+					"A counter or list is required unless feature flag PlayerTracking is enabled",
+				),
+			},
+		},
+		{
+			description: "IdleGameServers is enabled, invalid Idle",
+			feature:     fmt.Sprintf("%s=true&%s=true", runtime.FeatureIdleGameServers, runtime.FeatureCountsAndLists),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container: "testing",
+					Idle:      &Idle{Action: "Delete", Counter: "players", List: "sessions"},
+					Counters:  map[string]CounterStatus{"players": {Capacity: 10}},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+			want: field.ErrorList{
+				field.Invalid(field.NewPath("spec.idle.seconds"), int32(0), "must be greater than 0"),
+				field.NotSupported(field.NewPath("spec.idle.action"), IdleAction("Delete"), []string{"Shutdown", "Ready"}),
+				field.Invalid(field.NewPath("spec.idle.list"), "sessions", "cannot be set together with counter"),
+				field.NotFound(field.NewPath("spec.idle.list"), "sessions"),
+			},
+		},
+		{
+			description: "IdleGameServers is enabled, Idle field set",
+			feature:     fmt.Sprintf("%s=true&%s=true", runtime.FeatureIdleGameServers, runtime.FeatureCountsAndLists),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container: "testing",
+					Idle:      &Idle{Seconds: 60, Action: IdleActionReady, List: "players"},
+					Lists:     map[string]ListStatus{"players": {Capacity: 10}},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+		},
+		{
+			description: "PostMortemRetention is disabled, PostMortem field set",
+			feature:     fmt.Sprintf("%s=false", runtime.FeaturePostMortemRetention),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container:  "testing",
+					PostMortem: &PostMortem{MaxGameServers: 2, Seconds: 600},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+			want: field.ErrorList{
+				field.Forbidden(
+					field.NewPath("spec.postMortem"),
+					"Value cannot be set unless feature flag PostMortemRetention is enabled",
+				),
+			},
+		},
+		{
+			description: "PostMortemRetention is enabled, invalid PostMortem",
+			feature:     fmt.Sprintf("%s=true", runtime.FeaturePostMortemRetention),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container:  "testing",
+					PostMortem: &PostMortem{MaxGameServers: -1, Seconds: -10},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+			want: field.ErrorList{
+				field.Invalid(field.NewPath("spec.postMortem.maxGameServers"), int32(-1), "must be greater than or equal to 0"),
+				field.Invalid(field.NewPath("spec.postMortem.seconds"), int32(-10), "must be greater than or equal to 0"),
+			},
+		},
+		{
+			description: "PostMortemRetention is enabled, PostMortem field set",
+			feature:     fmt.Sprintf("%s=true", runtime.FeaturePostMortemRetention),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container:  "testing",
+					PostMortem: &PostMortem{MaxGameServers: 2, Seconds: 600},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+		},
+		{
+			description: "ReadinessGates is enabled, ReadinessGates field set",
+			feature:     fmt.Sprintf("%s=true", runtime.FeatureReadinessGates),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container:      "testing",
+					ReadinessGates: []GameServerReadinessGate{{ConditionType: "AssetsCached"}, {ConditionType: "example.com/AntiCheat"}},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+		},
+		{
+			description: "SidecarHealthProbes is disabled, Probe field set",
+			feature:     fmt.Sprintf("%s=false", runtime.FeatureSidecarHealthProbes),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container: "testing",
+					Health:    Health{Probe: &HealthProbe{TCP: &TCPHealthProbe{Port: 7654}}},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+			want: field.ErrorList{
+				field.Forbidden(
+					field.NewPath("spec.health.probe"),
+					"Value cannot be set unless feature flag SidecarHealthProbes is enabled",
+				),
+			},
+		},
+		{
+			description: "SidecarHealthProbes is enabled, invalid Probe",
+			feature:     fmt.Sprintf("%s=true", runtime.FeatureSidecarHealthProbes),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container: "testing",
+					Health: Health{Probe: &HealthProbe{
+						UDP: &UDPHealthProbe{Port: 0, Request: "PING"},
+						TCP: &TCPHealthProbe{Port: 7654},
+					}},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+			want: field.ErrorList{
+				field.Invalid(field.NewPath("spec.health.probe.udp.port"), int32(0), "must be between 1 and 65535, inclusive"),
+				field.Required(field.NewPath("spec.health.probe.udp.response"), "the expected response payload is required"),
+				field.Invalid(field.NewPath("spec.health.probe"), "udp, tcp", "exactly one of udp, tcp, http or grpc must be set"),
+			},
+		},
+		{
+			description: "SidecarHealthProbes is enabled, Probe field set",
+			feature:     fmt.Sprintf("%s=true", runtime.FeatureSidecarHealthProbes),
+			gs: GameServer{
+				Spec: GameServerSpec{
+					Container: "testing",
+					Health:    Health{Probe: &HealthProbe{UDP: &UDPHealthProbe{Port: 7654, Request: "PING", Response: "PONG"}}},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2026,6 +2359,551 @@ func TestGameServerIsBeforeReady(t *testing.T) {
 	}
 }
 
+func TestGameServerWarmUpRemaining(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	now := time.Now()
+	readyTime := metav1.NewTime(now.Add(-4 * time.Second))
+	gs := &GameServer{
+		Spec:   GameServerSpec{MinReadySeconds: 10},
+		Status: GameServerStatus{State: GameServerStateReady, ReadyTime: &readyTime},
+	}
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=false", runtime.FeatureMinReadySeconds)))
+	assert.Equal(t, time.Duration(0), gs.WarmUpRemaining(now))
+	assert.False(t, gs.IsWarmingUp(now))
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureMinReadySeconds)))
+	assert.Equal(t, 6*time.Second, gs.WarmUpRemaining(now))
+	assert.True(t, gs.IsWarmingUp(now))
+
+	// warmed up
+	assert.False(t, gs.IsWarmingUp(now.Add(6*time.Second)))
+
+	// only Ready GameServers warm up
+	gs.Status.State = GameServerStateAllocated
+	assert.False(t, gs.IsWarmingUp(now))
+
+	// GameServers that were Ready before the feature was enabled are warm
+	gs.Status.State = GameServerStateReady
+	gs.Status.ReadyTime = nil
+	assert.False(t, gs.IsWarmingUp(now))
+}
+
+func TestGameServerLifetimeLimit(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ready := metav1.NewTime(created.Add(10 * time.Minute))
+	allocated := created.Add(20 * time.Minute)
+	allocatedText, err := allocated.MarshalText()
+	require.NoError(t, err)
+
+	newGS := func(state GameServerState, lifetime *Lifetime) *GameServer {
+		return &GameServer{
+			ObjectMeta: metav1.ObjectMeta{
+				CreationTimestamp: metav1.NewTime(created),
+				Annotations:       map[string]string{GameServerLastAllocatedAnnotation: string(allocatedText)},
+			},
+			Spec:   GameServerSpec{Lifetime: lifetime},
+			Status: GameServerStatus{State: state, ReadyTime: &ready},
+		}
+	}
+
+	fixtures := map[string]struct {
+		features string
+		gs       *GameServer
+		limit    time.Time
+		reason   string
+		ok       bool
+	}{
+		"feature disabled": {
+			features: fmt.Sprintf("%s=false", runtime.FeatureGameServerLifetime),
+			gs:       newGS(GameServerStateReady, &Lifetime{MaxSeconds: 3600}),
+		},
+		"no lifetime": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureGameServerLifetime),
+			gs:       newGS(GameServerStateReady, nil),
+		},
+		"maximum lifetime": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureGameServerLifetime),
+			gs:       newGS(GameServerStateScheduled, &Lifetime{MaxSeconds: 3600, MaxReadySeconds: 60}),
+			limit:    created.Add(time.Hour),
+			reason:   "Maximum lifetime",
+			ok:       true,
+		},
+		"maximum time Ready": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureGameServerLifetime),
+			gs:       newGS(GameServerStateReady, &Lifetime{MaxSeconds: 3600, MaxReadySeconds: 60, MaxAllocatedSeconds: 1}),
+			limit:    ready.Add(time.Minute),
+			reason:   "Maximum time Ready",
+			ok:       true,
+		},
+		"maximum time Allocated": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureGameServerLifetime),
+			gs:       newGS(GameServerStateAllocated, &Lifetime{MaxSeconds: 3600, MaxReadySeconds: 1, MaxAllocatedSeconds: 60}),
+			limit:    allocated.Add(time.Minute),
+			reason:   "Maximum time Allocated",
+			ok:       true,
+		},
+		"maximum lifetime is sooner than the state limit": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureGameServerLifetime),
+			gs:       newGS(GameServerStateAllocated, &Lifetime{MaxSeconds: 1500, MaxAllocatedSeconds: 3600}),
+			limit:    created.Add(25 * time.Minute),
+			reason:   "Maximum lifetime",
+			ok:       true,
+		},
+		"no limit for the state": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureGameServerLifetime),
+			gs:       newGS(GameServerStateReserved, &Lifetime{MaxReadySeconds: 60, MaxAllocatedSeconds: 60}),
+		},
+		"terminal state": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureGameServerLifetime),
+			gs:       newGS(GameServerStateShutdown, &Lifetime{MaxSeconds: 60}),
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			require.NoError(t, runtime.ParseFeatures(v.features))
+			limit, reason, ok := v.gs.LifetimeLimit()
+			assert.Equal(t, v.ok, ok)
+			assert.True(t, v.limit.Equal(limit), "expected %s, got %s", v.limit, limit)
+			assert.Equal(t, v.reason, reason)
+		})
+	}
+}
+
+func TestGameServerTerminationNotice(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	deadline := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	gracePeriod := int64(60)
+	enabled := fmt.Sprintf("%s=true&%s=true", runtime.FeatureTerminationNotices, runtime.FeatureGameServerLifetime)
+
+	fixtures := map[string]struct {
+		features    string
+		annotations map[string]string
+		reason      string
+		deadline    time.Time
+		ok          bool
+	}{
+		"feature disabled": {
+			features:    fmt.Sprintf("%s=false", runtime.FeatureTerminationNotices),
+			annotations: map[string]string{GameServerTerminationReasonAnnotation: TerminationReasonEviction},
+		},
+		"no notice": {
+			features: enabled,
+		},
+		"reason and deadline": {
+			features: enabled,
+			annotations: map[string]string{
+				GameServerTerminationReasonAnnotation:   TerminationReasonScaleDown,
+				GameServerTerminationDeadlineAnnotation: deadline.Format(time.RFC3339),
+			},
+			reason:   TerminationReasonScaleDown,
+			deadline: deadline,
+			ok:       true,
+		},
+		"reason without deadline": {
+			features:    enabled,
+			annotations: map[string]string{GameServerTerminationReasonAnnotation: TerminationReasonNodeDrain},
+			reason:      TerminationReasonNodeDrain,
+			ok:          true,
+		},
+		"lifetime grace period": {
+			features:    enabled,
+			annotations: map[string]string{GameServerShutdownAtAnnotation: deadline.Format(time.RFC3339)},
+			reason:      TerminationReasonLifetimeExpired,
+			deadline:    deadline.Add(time.Minute),
+			ok:          true,
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			require.NoError(t, runtime.ParseFeatures(v.features))
+			gs := &GameServer{ObjectMeta: metav1.ObjectMeta{Annotations: v.annotations}}
+			gs.Spec.Template.Spec.TerminationGracePeriodSeconds = &gracePeriod
+
+			reason, deadline, ok := gs.TerminationNotice()
+			assert.Equal(t, v.ok, ok)
+			assert.Equal(t, v.reason, reason)
+			assert.True(t, v.deadline.Equal(deadline), "expected %s, got %s", v.deadline, deadline)
+		})
+	}
+}
+
+func TestGameServerSetTerminationNotice(t *testing.T) {
+	t.Parallel()
+
+	gs := &GameServer{}
+	deadline := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	gs.SetTerminationNotice(TerminationReasonEviction, deadline)
+	assert.Equal(t, map[string]string{
+		GameServerTerminationReasonAnnotation:   TerminationReasonEviction,
+		GameServerTerminationDeadlineAnnotation: "2024-01-01T00:00:00Z",
+	}, gs.ObjectMeta.Annotations)
+
+	gs.SetTerminationNotice(TerminationReasonNodeDrain, time.Time{})
+	assert.Equal(t, map[string]string{GameServerTerminationReasonAnnotation: TerminationReasonNodeDrain}, gs.ObjectMeta.Annotations)
+}
+
+func TestGameServerIsEmpty(t *testing.T) {
+	t.Parallel()
+
+	fixtures := map[string]struct {
+		idle   *Idle
+		status GameServerStatus
+		want   bool
+	}{
+		"no idle": {
+			status: GameServerStatus{Players: &PlayerStatus{Count: 0}},
+		},
+		"no players": {
+			idle:   &Idle{Seconds: 60},
+			status: GameServerStatus{Players: &PlayerStatus{Count: 0}},
+			want:   true,
+		},
+		"players": {
+			idle:   &Idle{Seconds: 60},
+			status: GameServerStatus{Players: &PlayerStatus{Count: 1}},
+		},
+		"no player tracking status": {
+			idle: &Idle{Seconds: 60},
+		},
+		"empty counter": {
+			idle:   &Idle{Seconds: 60, Counter: "players"},
+			status: GameServerStatus{Counters: map[string]CounterStatus{"players": {Count: 0, Capacity: 10}}},
+			want:   true,
+		},
+		"counter": {
+			idle:   &Idle{Seconds: 60, Counter: "players"},
+			status: GameServerStatus{Counters: map[string]CounterStatus{"players": {Count: 2, Capacity: 10}}},
+		},
+		"missing counter": {
+			idle:   &Idle{Seconds: 60, Counter: "players"},
+			status: GameServerStatus{Counters: map[string]CounterStatus{}},
+		},
+		"empty list": {
+			idle:   &Idle{Seconds: 60, List: "sessions"},
+			status: GameServerStatus{Lists: map[string]ListStatus{"sessions": {Capacity: 10}}},
+			want:   true,
+		},
+		"list": {
+			idle:   &Idle{Seconds: 60, List: "sessions"},
+			status: GameServerStatus{Lists: map[string]ListStatus{"sessions": {Capacity: 10, Values: []string{"session1"}}}},
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			gs := &GameServer{Spec: GameServerSpec{Idle: v.idle}, Status: v.status}
+			assert.Equal(t, v.want, gs.IsEmpty())
+		})
+	}
+}
+
+func TestGameServerReadinessGatesPassed(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	now := metav1.Now()
+	gs := &GameServer{
+		Spec: GameServerSpec{ReadinessGates: []GameServerReadinessGate{{ConditionType: "AntiCheat"}, {ConditionType: "AssetsCached"}}},
+	}
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=false", runtime.FeatureReadinessGates)))
+	assert.True(t, gs.ReadinessGatesPassed())
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureReadinessGates)))
+	assert.False(t, gs.ReadinessGatesPassed())
+
+	gs.SetCondition("AntiCheat", corev1.ConditionTrue, "", now)
+	gs.SetCondition("AssetsCached", corev1.ConditionFalse, "loading", now)
+	assert.False(t, gs.ReadinessGatesPassed())
+
+	gs.SetCondition("AssetsCached", corev1.ConditionTrue, "", now)
+	assert.True(t, gs.ReadinessGatesPassed())
+
+	// conditions that no readiness gate refers to are ignored
+	gs.SetCondition("Other", corev1.ConditionFalse, "", now)
+	assert.True(t, gs.ReadinessGatesPassed())
+}
+
+func TestGameServerSetCondition(t *testing.T) {
+	t.Parallel()
+
+	first := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	second := metav1.NewTime(first.Add(time.Minute))
+	gs := &GameServer{}
+
+	gs.SetCondition("AssetsCached", corev1.ConditionFalse, "loading", first)
+	assert.Equal(t, []GameServerCondition{{Type: "AssetsCached", Status: corev1.ConditionFalse, LastTransitionTime: first, Message: "loading"}}, gs.Status.Conditions)
+
+	// same status keeps the transition time
+	gs.SetCondition("AssetsCached", corev1.ConditionFalse, "still loading", second)
+	assert.Equal(t, first, gs.Condition("AssetsCached").LastTransitionTime)
+	assert.Equal(t, "still loading", gs.Condition("AssetsCached").Message)
+
+	gs.SetCondition("AssetsCached", corev1.ConditionTrue, "", second)
+	assert.Equal(t, GameServerCondition{Type: "AssetsCached", Status: corev1.ConditionTrue, LastTransitionTime: second}, *gs.Condition("AssetsCached"))
+	assert.Len(t, gs.Status.Conditions, 1)
+	assert.Nil(t, gs.Condition("AntiCheat"))
+}
+
+func TestGameServerSetState(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=false", runtime.FeatureGameServerStateHistory)))
+	gs := &GameServer{Status: GameServerStatus{State: GameServerStateRequestReady}}
+	gs.SetState(GameServerStateReady, "SDK.Ready() complete")
+	assert.Equal(t, GameServerStateReady, gs.Status.State)
+	assert.Empty(t, gs.Status.StateHistory)
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureGameServerStateHistory)))
+	gs.SetState(GameServerStateAllocated, "Allocated")
+	require.Len(t, gs.Status.StateHistory, 1)
+	assert.Equal(t, GameServerStateAllocated, gs.Status.StateHistory[0].State)
+	assert.Equal(t, "Allocated", gs.Status.StateHistory[0].Reason)
+	assert.False(t, gs.Status.StateHistory[0].Time.IsZero())
+
+	// no transition if the State doesn't change
+	gs.SetState(GameServerStateAllocated, "Allocated")
+	assert.Len(t, gs.Status.StateHistory, 1)
+
+	// only the most recent transitions are kept
+	for i := 0; i < GameServerStateHistoryLimit; i++ {
+		gs.SetState(GameServerStateRequestReady, "")
+		gs.SetState(GameServerStateReady, fmt.Sprintf("ready %d", i))
+	}
+	require.Len(t, gs.Status.StateHistory, GameServerStateHistoryLimit)
+	last := gs.Status.StateHistory[GameServerStateHistoryLimit-1]
+	assert.Equal(t, GameServerStateReady, last.State)
+	assert.Equal(t, fmt.Sprintf("ready %d", GameServerStateHistoryLimit-1), last.Reason)
+	assert.Equal(t, GameServerStateRequestReady, gs.Status.StateHistory[0].State)
+
+	// the initial State is recorded on creation
+	gs = &GameServer{Spec: GameServerSpec{Ports: []GameServerPort{{ContainerPort: 7777}}}}
+	gs.ApplyDefaults()
+	require.Len(t, gs.Status.StateHistory, 1)
+	assert.Equal(t, GameServerStatePortAllocation, gs.Status.StateHistory[0].State)
+	assert.Equal(t, "GameServer created", gs.Status.StateHistory[0].Reason)
+}
+
+func TestGameServerPostMortem(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeaturePostMortemRetention)))
+
+	gs := &GameServer{Spec: GameServerSpec{PostMortem: &PostMortem{}}}
+	gs.ApplyDefaults()
+	assert.Equal(t, &PostMortem{MaxGameServers: 1, Seconds: 3600}, gs.Spec.PostMortem)
+	assert.False(t, gs.IsPostMortem())
+	_, ok := gs.PostMortemUntil()
+	assert.False(t, ok)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	gs.KeepForPostMortem(now)
+	assert.Equal(t, True, gs.ObjectMeta.Labels[GameServerPostMortemLabel])
+	assert.Equal(t, "2024-01-01T01:00:00Z", gs.ObjectMeta.Annotations[GameServerPostMortemUntilAnnotation])
+	assert.True(t, gs.IsPostMortem())
+	until, ok := gs.PostMortemUntil()
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(time.Hour), until)
+
+	gs.ObjectMeta.Annotations[GameServerPostMortemUntilAnnotation] = "soon"
+	_, ok = gs.PostMortemUntil()
+	assert.False(t, ok)
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=false", runtime.FeaturePostMortemRetention)))
+	assert.False(t, gs.IsPostMortem())
+}
+
+func TestGameServerSetHealth(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureHealthReports)))
+
+	first := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	second := metav1.NewTime(first.Add(time.Minute))
+	gs := &GameServer{}
+	assert.False(t, gs.IsDegraded())
+
+	gs.SetHealth(GameServerHealthStatusDegraded, "lost connection to backend", first)
+	assert.Equal(t, &GameServerHealth{Status: GameServerHealthStatusDegraded, Reason: "lost connection to backend", LastTransitionTime: first}, gs.Status.Health)
+	assert.Equal(t, GameServerCondition{Type: GameServerHealthyCondition, Status: corev1.ConditionFalse, LastTransitionTime: first, Message: "lost connection to backend"},
+		*gs.Condition(GameServerHealthyCondition))
+	assert.True(t, gs.IsDegraded())
+
+	// same status keeps the transition time
+	gs.SetHealth(GameServerHealthStatusDegraded, "tick loop stalled", second)
+	assert.Equal(t, first, gs.Status.Health.LastTransitionTime)
+	assert.Equal(t, "tick loop stalled", gs.Status.Health.Reason)
+
+	gs.SetHealth(GameServerHealthStatusHealthy, "", second)
+	assert.Equal(t, &GameServerHealth{Status: GameServerHealthStatusHealthy, LastTransitionTime: second}, gs.Status.Health)
+	assert.Equal(t, corev1.ConditionTrue, gs.Condition(GameServerHealthyCondition).Status)
+	assert.False(t, gs.IsDegraded())
+
+	gs.SetHealth(GameServerHealthStatusDegraded, "tick loop stalled", second)
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=false", runtime.FeatureHealthReports)))
+	assert.False(t, gs.IsDegraded())
+}
+
+func TestGameServerCompareLifecyclePriorities(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	now := time.Now()
+	newGS := func(age time.Duration, readyAge time.Duration, revision string) *GameServer {
+		gs := &GameServer{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(now.Add(-age))}}
+		if readyAge > 0 {
+			readyTime := metav1.NewTime(now.Add(-readyAge))
+			gs.Status.ReadyTime = &readyTime
+		}
+		if revision != "" {
+			gs.ObjectMeta.Annotations = map[string]string{FleetRevisionAnnotation: revision}
+		}
+		return gs
+	}
+	older := newGS(time.Hour, time.Second, "1")
+	newer := newGS(time.Minute, time.Minute, "2")
+	unknown := newGS(time.Minute, 0, "")
+
+	priority := func(t, order string) []Priority {
+		return []Priority{{Type: t, Order: order}}
+	}
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=false", runtime.FeatureLifecyclePriorities)))
+	assert.Nil(t, older.CompareCountAndListPriorities(priority(GameServerPriorityCreationTime, GameServerPriorityAscending), newer))
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureLifecyclePriorities)))
+
+	// oldest first
+	res := older.CompareCountAndListPriorities(priority(GameServerPriorityCreationTime, GameServerPriorityAscending), newer)
+	require.NotNil(t, res)
+	assert.True(t, *res)
+
+	// newest first
+	res = older.CompareCountAndListPriorities(priority(GameServerPriorityCreationTime, GameServerPriorityDescending), newer)
+	require.NotNil(t, res)
+	assert.False(t, *res)
+
+	// newer has been Ready for longer
+	res = older.CompareCountAndListPriorities(priority(GameServerPriorityReadyTime, GameServerPriorityAscending), newer)
+	require.NotNil(t, res)
+	assert.False(t, *res)
+
+	// latest revision first
+	res = newer.CompareCountAndListPriorities(priority(GameServerPriorityRevision, GameServerPriorityDescending), older)
+	require.NotNil(t, res)
+	assert.True(t, *res)
+
+	// GameServers without a value come last
+	res = unknown.CompareCountAndListPriorities(priority(GameServerPriorityRevision, GameServerPriorityAscending), older)
+	require.NotNil(t, res)
+	assert.False(t, *res)
+	res = unknown.CompareCountAndListPriorities(priority(GameServerPriorityReadyTime, GameServerPriorityDescending), older)
+	require.NotNil(t, res)
+	assert.False(t, *res)
+
+	// ties fall through to the next Priority
+	assert.Nil(t, older.CompareCountAndListPriorities(priority(GameServerPriorityRevision, GameServerPriorityAscending), older.DeepCopy()))
+	res = unknown.CompareCountAndListPriorities([]Priority{
+		{Type: GameServerPriorityCreationTime, Order: GameServerPriorityAscending},
+		{Type: GameServerPriorityRevision, Order: GameServerPriorityAscending},
+	}, newer)
+	require.NotNil(t, res)
+	assert.False(t, *res)
+}
+
+func TestGameServerRecycle(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(string(runtime.FeatureCountsAndLists)+"=true"))
+
+	newGameServer := func() *GameServer {
+		return &GameServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					"mode":                       "ctf",
+					GameServerSetGameServerLabel: "gss",
+					"agones.dev/sdk-map":         "dust",
+				},
+				Annotations: map[string]string{
+					"note":                                  "session",
+					GameServerLastAllocatedAnnotation:       "2025-01-01T00:00:00Z",
+					GameServerAllocatorClientAnnotation:     "matchmaker",
+					GameServerTerminationReasonAnnotation:   TerminationReasonLifetimeExpired,
+					GameServerTerminationDeadlineAnnotation: "2025-01-01T01:00:00Z",
+					VersionAnnotation:                       "1.2.3",
+					GameServerRecycleAnnotation:             True,
+				},
+			},
+			Spec: GameServerSpec{
+				Players:  &PlayersSpec{InitialCapacity: 10},
+				Counters: map[string]CounterStatus{"rooms": {Count: 1, Capacity: 5}},
+				Lists:    map[string]ListStatus{"sessions": {Capacity: 5}},
+			},
+			Status: GameServerStatus{
+				State:             GameServerStateRequestReady,
+				Players:           &PlayerStatus{Count: 2, Capacity: 4, IDs: []string{"p1", "p2"}},
+				Counters:          map[string]CounterStatus{"rooms": {Count: 4, Capacity: 5}},
+				Lists:             map[string]ListStatus{"sessions": {Capacity: 5, Values: []string{"s1"}}},
+				AllocationPayload: []byte(`{"map":"dust"}`),
+			},
+		}
+	}
+
+	fixtures := map[string]struct {
+		template        *metav1.ObjectMeta
+		wantLabels      map[string]string
+		wantAnnotations map[string]string
+	}{
+		"no template": {
+			wantLabels:      map[string]string{"mode": "ctf", GameServerSetGameServerLabel: "gss"},
+			wantAnnotations: map[string]string{"note": "session", VersionAnnotation: "1.2.3"},
+		},
+		"template": {
+			template: &metav1.ObjectMeta{
+				Labels:      map[string]string{"mode": "dm"},
+				Annotations: map[string]string{"owner": "team"},
+			},
+			wantLabels:      map[string]string{"mode": "dm", GameServerSetGameServerLabel: "gss"},
+			wantAnnotations: map[string]string{"owner": "team", VersionAnnotation: "1.2.3"},
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			gs := newGameServer()
+			gs.Recycle(v.template)
+
+			assert.Equal(t, v.wantLabels, gs.ObjectMeta.Labels)
+			assert.Equal(t, v.wantAnnotations, gs.ObjectMeta.Annotations)
+			assert.Equal(t, &PlayerStatus{Capacity: 10}, gs.Status.Players)
+			assert.Equal(t, map[string]CounterStatus{"rooms": {Count: 1, Capacity: 5}}, gs.Status.Counters)
+			assert.Equal(t, map[string]ListStatus{"sessions": {Capacity: 5}}, gs.Status.Lists)
+			assert.Nil(t, gs.Status.AllocationPayload)
+			assert.Equal(t, GameServerStateRequestReady, gs.Status.State)
+		})
+	}
+}
+
 func TestGameServerApplyToPodContainer(t *testing.T) {
 	t.Parallel()
 	type expected struct {
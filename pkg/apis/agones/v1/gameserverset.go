@@ -18,6 +18,7 @@ import (
 	"agones.dev/agones/pkg/apis"
 	"agones.dev/agones/pkg/apis/agones"
 	"agones.dev/agones/pkg/util/runtime"
+	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -27,6 +28,11 @@ const (
 	// GameServerSetGameServerLabel is the label that the name of the GameServerSet
 	// is set on the GameServer the GameServerSet controls
 	GameServerSetGameServerLabel = agones.GroupName + "/gameserverset"
+
+	// GameServerSetCrashLoopBackOffCondition is the type of the condition in the status.conditions of a GameServerSet,
+	// and of its Fleet, that is True while its GameServers keep failing before Ready, and the creation of
+	// replacements is backed off.
+	GameServerSetCrashLoopBackOffCondition = "CrashLoopBackOff"
 )
 
 // +genclient
@@ -107,6 +113,31 @@ type GameServerSetStatus struct {
 	// for this GameServerSet.
 	// +optional
 	Lists map[string]AggregatedListStatus `json:"lists,omitempty"`
+	// [Stage:Dev]
+	// [FeatureFlag:MinReadySeconds]
+	// WarmingReplicas is the number of Ready GameServer replicas that have not yet been Ready for their
+	// MinReadySeconds, and so cannot be allocated yet.
+	// +optional
+	WarmingReplicas int32 `json:"warmingReplicas,omitempty"`
+	// [Stage:Dev]
+	// [FeatureFlag:CrashLoopBackoff]
+	// Conditions are the conditions of the GameServerSet, such as CrashLoopBackOff.
+	// +optional
+	Conditions []GameServerSetCondition `json:"conditions,omitempty"`
+}
+
+// GameServerSetCondition is a condition of a GameServerSet, or of a Fleet, set by the Agones controllers.
+type GameServerSetCondition struct {
+	// Type of the condition, such as CrashLoopBackOff.
+	Type string `json:"type"`
+	// Status of the condition, one of True, False or Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// LastTransitionTime is the last time the condition changed Status.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Message is a human readable message with details about the condition.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // ValidateUpdate validates when updates occur. The argument
@@ -131,6 +162,7 @@ func (gsSet *GameServerSet) Validate(apiHooks APIHooks) field.ErrorList {
 	if gsSet.Spec.Priorities != nil && !runtime.FeatureEnabled(runtime.FeatureCountsAndLists) {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "priorities"), "FeatureCountsAndLists is not enabled"))
 	}
+	allErrs = append(allErrs, validatePriorityFeatures(gsSet.Spec.Priorities, field.NewPath("spec", "priorities"))...)
 
 	allErrs = append(allErrs, validateObjectMeta(&gsSet.Spec.Template.ObjectMeta, field.NewPath("spec", "template", "metadata"))...)
 	return allErrs
@@ -168,5 +200,59 @@ func (gsSet *GameServerSet) GameServer() *GameServer {
 
 	gs.ObjectMeta.Labels[GameServerSetGameServerLabel] = gsSet.ObjectMeta.Name
 	gs.ObjectMeta.Labels[FleetNameLabel] = gsSet.ObjectMeta.Labels[FleetNameLabel]
+
+	if revision, ok := gsSet.ObjectMeta.Annotations[FleetRevisionAnnotation]; ok {
+		if gs.ObjectMeta.Annotations == nil {
+			gs.ObjectMeta.Annotations = make(map[string]string, 1)
+		}
+		gs.ObjectMeta.Annotations[FleetRevisionAnnotation] = revision
+	}
 	return gs
 }
+
+// IsCrashLooping returns true if the GameServers of the GameServerSet keep failing before Ready, as recorded by its
+// CrashLoopBackOff condition.
+func (gsSet *GameServerSet) IsCrashLooping() bool {
+	c := findGameServerSetCondition(gsSet.Status.Conditions, GameServerSetCrashLoopBackOffCondition)
+	return c != nil && c.Status == corev1.ConditionTrue
+}
+
+// Condition returns the condition of the given type from the GameServerSet Status, or nil if it has not been set.
+func (s *GameServerSetStatus) Condition(conditionType string) *GameServerSetCondition {
+	return findGameServerSetCondition(s.Conditions, conditionType)
+}
+
+// SetCondition sets the Status and Message of the condition of the given type on the GameServerSet Status,
+// adding the condition if it has not been set yet. LastTransitionTime is only updated when the Status changes.
+func (s *GameServerSetStatus) SetCondition(conditionType string, status corev1.ConditionStatus, message string, now metav1.Time) {
+	s.Conditions = setGameServerSetCondition(s.Conditions, conditionType, status, message, now)
+}
+
+func findGameServerSetCondition(conditions []GameServerSetCondition, conditionType string) *GameServerSetCondition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// setGameServerSetCondition returns a copy of the conditions with the condition of the given type set, so that
+// conditions shared with an informer cache are never modified.
+func setGameServerSetCondition(conditions []GameServerSetCondition, conditionType string, status corev1.ConditionStatus, message string, now metav1.Time) []GameServerSetCondition {
+	result := append([]GameServerSetCondition(nil), conditions...)
+	if c := findGameServerSetCondition(result, conditionType); c != nil {
+		if c.Status != status {
+			c.LastTransitionTime = now
+		}
+		c.Status = status
+		c.Message = message
+		return result
+	}
+	return append(result, GameServerSetCondition{
+		Type:               conditionType,
+		Status:             status,
+		LastTransitionTime: now,
+		Message:            message,
+	})
+}
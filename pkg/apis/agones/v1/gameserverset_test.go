@@ -17,6 +17,7 @@ package v1
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -58,9 +59,51 @@ func TestGameServerSetGameServer(t *testing.T) {
 
 	assert.Equal(t, gs.Spec, gsSet.Spec.Template.Spec)
 	assert.True(t, metav1.IsControlledBy(gs, &gsSet))
+	assert.NotContains(t, gs.ObjectMeta.Annotations, FleetRevisionAnnotation)
+
+	gsSet.ObjectMeta.Annotations = map[string]string{FleetRevisionAnnotation: "3"}
+	gs = gsSet.GameServer()
+	assert.Equal(t, "3", gs.ObjectMeta.Annotations[FleetRevisionAnnotation])
 }
 
 // TestGameServerSetValidateUpdate test GameServerSet Validate() and ValidateUpdate()
+func TestGameServerSetStatusCondition(t *testing.T) {
+	t.Parallel()
+
+	gsSet := &GameServerSet{}
+	assert.False(t, gsSet.IsCrashLooping())
+	assert.Nil(t, gsSet.Status.Condition(GameServerSetCrashLoopBackOffCondition))
+
+	first := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+	gsSet.Status.SetCondition(GameServerSetCrashLoopBackOffCondition, corev1.ConditionTrue, "3 GameServers failed before Ready in a row", first)
+	assert.True(t, gsSet.IsCrashLooping())
+	c := gsSet.Status.Condition(GameServerSetCrashLoopBackOffCondition)
+	if assert.NotNil(t, c) {
+		assert.Equal(t, corev1.ConditionTrue, c.Status)
+		assert.Equal(t, first, c.LastTransitionTime)
+		assert.Equal(t, "3 GameServers failed before Ready in a row", c.Message)
+	}
+
+	// same status only updates the message
+	second := metav1.NewTime(first.Add(30 * time.Second))
+	copied := gsSet.DeepCopy()
+	gsSet.Status.SetCondition(GameServerSetCrashLoopBackOffCondition, corev1.ConditionTrue, "4 GameServers failed before Ready in a row", second)
+	c = gsSet.Status.Condition(GameServerSetCrashLoopBackOffCondition)
+	assert.Equal(t, first, c.LastTransitionTime)
+	assert.Equal(t, "4 GameServers failed before Ready in a row", c.Message)
+	assert.Equal(t, "3 GameServers failed before Ready in a row", copied.Status.Conditions[0].Message)
+
+	// a status change moves the transition time
+	gsSet.Status.SetCondition(GameServerSetCrashLoopBackOffCondition, corev1.ConditionFalse, "", second)
+	assert.False(t, gsSet.IsCrashLooping())
+	assert.Len(t, gsSet.Status.Conditions, 1)
+	assert.Equal(t, second, gsSet.Status.Condition(GameServerSetCrashLoopBackOffCondition).LastTransitionTime)
+
+	fleetStatus := FleetStatus{}
+	fleetStatus.SetCondition(GameServerSetCrashLoopBackOffCondition, corev1.ConditionTrue, "GameServerSet test: failing", first)
+	assert.Equal(t, corev1.ConditionTrue, fleetStatus.Condition(GameServerSetCrashLoopBackOffCondition).Status)
+}
+
 func TestGameServerSetValidateUpdate(t *testing.T) {
 	gsSpec := defaultGameServer().Spec
 	gsSet := GameServerSet{
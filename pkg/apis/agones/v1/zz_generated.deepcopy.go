@@ -247,6 +247,13 @@ func (in *FleetStatus) DeepCopyInto(out *FleetStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]GameServerSetCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -260,6 +267,22 @@ func (in *FleetStatus) DeepCopy() *FleetStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCHealthProbe) DeepCopyInto(out *GRPCHealthProbe) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCHealthProbe.
+func (in *GRPCHealthProbe) DeepCopy() *GRPCHealthProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCHealthProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GameServer) DeepCopyInto(out *GameServer) {
 	*out = *in
@@ -288,6 +311,40 @@ func (in *GameServer) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerCondition) DeepCopyInto(out *GameServerCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerCondition.
+func (in *GameServerCondition) DeepCopy() *GameServerCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerHealth) DeepCopyInto(out *GameServerHealth) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerHealth.
+func (in *GameServerHealth) DeepCopy() *GameServerHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GameServerList) DeepCopyInto(out *GameServerList) {
 	*out = *in
@@ -342,6 +399,22 @@ func (in *GameServerPort) DeepCopy() *GameServerPort {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerReadinessGate) DeepCopyInto(out *GameServerReadinessGate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerReadinessGate.
+func (in *GameServerReadinessGate) DeepCopy() *GameServerReadinessGate {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerReadinessGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GameServerSet) DeepCopyInto(out *GameServerSet) {
 	*out = *in
@@ -370,6 +443,23 @@ func (in *GameServerSet) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerSetCondition) DeepCopyInto(out *GameServerSetCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerSetCondition.
+func (in *GameServerSetCondition) DeepCopy() *GameServerSetCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerSetCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GameServerSetList) DeepCopyInto(out *GameServerSetList) {
 	*out = *in
@@ -452,6 +542,13 @@ func (in *GameServerSetStatus) DeepCopyInto(out *GameServerSetStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]GameServerSetCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -475,7 +572,7 @@ func (in *GameServerSpec) DeepCopyInto(out *GameServerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	out.Health = in.Health
+	in.Health.DeepCopyInto(&out.Health)
 	out.SdkServer = in.SdkServer
 	in.Template.DeepCopyInto(&out.Template)
 	if in.Players != nil {
@@ -502,6 +599,26 @@ func (in *GameServerSpec) DeepCopyInto(out *GameServerSpec) {
 		*out = new(Eviction)
 		**out = **in
 	}
+	if in.ReadinessGates != nil {
+		in, out := &in.ReadinessGates, &out.ReadinessGates
+		*out = make([]GameServerReadinessGate, len(*in))
+		copy(*out, *in)
+	}
+	if in.Lifetime != nil {
+		in, out := &in.Lifetime, &out.Lifetime
+		*out = new(Lifetime)
+		**out = **in
+	}
+	if in.Idle != nil {
+		in, out := &in.Idle, &out.Idle
+		*out = new(Idle)
+		**out = **in
+	}
+	if in.PostMortem != nil {
+		in, out := &in.PostMortem, &out.PostMortem
+		*out = new(PostMortem)
+		**out = **in
+	}
 	return
 }
 
@@ -515,6 +632,23 @@ func (in *GameServerSpec) DeepCopy() *GameServerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerStateTransition) DeepCopyInto(out *GameServerStateTransition) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerStateTransition.
+func (in *GameServerStateTransition) DeepCopy() *GameServerStateTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerStateTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GameServerStatus) DeepCopyInto(out *GameServerStatus) {
 	*out = *in
@@ -556,6 +690,38 @@ func (in *GameServerStatus) DeepCopyInto(out *GameServerStatus) {
 		*out = new(Eviction)
 		**out = **in
 	}
+	if in.AllocationPayload != nil {
+		in, out := &in.AllocationPayload, &out.AllocationPayload
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReadyTime != nil {
+		in, out := &in.ReadyTime, &out.ReadyTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]GameServerCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IdleSince != nil {
+		in, out := &in.IdleSince, &out.IdleSince
+		*out = (*in).DeepCopy()
+	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(GameServerHealth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StateHistory != nil {
+		in, out := &in.StateHistory, &out.StateHistory
+		*out = make([]GameServerStateTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -603,9 +769,30 @@ func (in *GameServerTemplateSpec) DeepCopy() *GameServerTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHealthProbe) DeepCopyInto(out *HTTPHealthProbe) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHealthProbe.
+func (in *HTTPHealthProbe) DeepCopy() *HTTPHealthProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHealthProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Health) DeepCopyInto(out *Health) {
 	*out = *in
+	if in.Probe != nil {
+		in, out := &in.Probe, &out.Probe
+		*out = new(HealthProbe)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -619,6 +806,74 @@ func (in *Health) DeepCopy() *Health {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthProbe) DeepCopyInto(out *HealthProbe) {
+	*out = *in
+	if in.UDP != nil {
+		in, out := &in.UDP, &out.UDP
+		*out = new(UDPHealthProbe)
+		**out = **in
+	}
+	if in.TCP != nil {
+		in, out := &in.TCP, &out.TCP
+		*out = new(TCPHealthProbe)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPHealthProbe)
+		**out = **in
+	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(GRPCHealthProbe)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthProbe.
+func (in *HealthProbe) DeepCopy() *HealthProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Idle) DeepCopyInto(out *Idle) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Idle.
+func (in *Idle) DeepCopy() *Idle {
+	if in == nil {
+		return nil
+	}
+	out := new(Idle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Lifetime) DeepCopyInto(out *Lifetime) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Lifetime.
+func (in *Lifetime) DeepCopy() *Lifetime {
+	if in == nil {
+		return nil
+	}
+	out := new(Lifetime)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ListStatus) DeepCopyInto(out *ListStatus) {
 	*out = *in
@@ -677,6 +932,22 @@ func (in *PlayersSpec) DeepCopy() *PlayersSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostMortem) DeepCopyInto(out *PostMortem) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostMortem.
+func (in *PostMortem) DeepCopy() *PostMortem {
+	if in == nil {
+		return nil
+	}
+	out := new(PostMortem)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Priority) DeepCopyInto(out *Priority) {
 	*out = *in
@@ -708,3 +979,35 @@ func (in *SdkServer) DeepCopy() *SdkServer {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPHealthProbe) DeepCopyInto(out *TCPHealthProbe) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPHealthProbe.
+func (in *TCPHealthProbe) DeepCopy() *TCPHealthProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPHealthProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UDPHealthProbe) DeepCopyInto(out *UDPHealthProbe) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UDPHealthProbe.
+func (in *UDPHealthProbe) DeepCopy() *UDPHealthProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(UDPHealthProbe)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -17,6 +17,14 @@ package v1
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"text/template"
+	"time"
 
 	"agones.dev/agones/pkg/apis"
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
@@ -27,6 +35,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -43,6 +52,47 @@ const (
 // GameServerAllocationState is the Allocation state
 type GameServerAllocationState string
 
+// defaultScoringFunction is the default exported function called in a WasmScoring module
+const defaultScoringFunction = "score"
+
+const (
+	// maxMetaPatchTemplateOutput is the maximum size of the output of a MetaPatch template
+	maxMetaPatchTemplateOutput = 16 * 1024
+	// metaPatchTemplateTimeout is the maximum time a MetaPatch template can take to execute
+	metaPatchTemplateTimeout = 100 * time.Millisecond
+)
+
+var (
+	allowedWasmScoringURLsMutex sync.RWMutex
+	// allowedWasmScoringURLs are the URLs of the Wasm scoring modules that GameServerAllocations can use
+	allowedWasmScoringURLs = map[string]bool{}
+)
+
+// SetAllowedWasmScoringURLs sets the URLs of the Wasm scoring modules that GameServerAllocations can use,
+// as configured by the operator. GameServerAllocations with the URL of any other module fail validation.
+func SetAllowedWasmScoringURLs(urls []string) {
+	allowed := make(map[string]bool, len(urls))
+	for _, u := range urls {
+		if u = strings.TrimSpace(u); u != "" {
+			allowed[u] = true
+		}
+	}
+
+	allowedWasmScoringURLsMutex.Lock()
+	defer allowedWasmScoringURLsMutex.Unlock()
+	allowedWasmScoringURLs = allowed
+}
+
+// IsAllowedWasmScoringURL returns true if GameServerAllocations can use the Wasm scoring module at the URL.
+func IsAllowedWasmScoringURL(u string) bool {
+	allowedWasmScoringURLsMutex.RLock()
+	defer allowedWasmScoringURLsMutex.RUnlock()
+	return allowedWasmScoringURLs[u]
+}
+
+// MaxPayloadBytes is the maximum size of the Payload of a GameServerAllocation
+const MaxPayloadBytes = 4 * 1024
+
 // +genclient
 // +genclient:onlyVerbs=create
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -72,6 +122,24 @@ type GameServerAllocationSpec struct {
 	// Otherwise, allocation will happen locally.
 	MultiClusterSetting MultiClusterSetting `json:"multiClusterSetting,omitempty" hash:"ignore"`
 
+	// [Stage: Dev]
+	// [FeatureFlag:CrossNamespaceAllocation]
+	// Namespaces is an ordered list of namespaces to search for a GameServer to allocate, instead of the
+	// namespace of the GameServerAllocation. The GameServers of all the namespaces are searched together with the
+	// same `scheduling` and `priorities`, and the earlier namespaces are preferred when GameServers are otherwise equal.
+	// Note: This field can only be set if neither NamespaceSelector or MultiClusterSetting is set.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty" hash:"ignore"`
+
+	// [Stage: Dev]
+	// [FeatureFlag:CrossNamespaceAllocation]
+	// NamespaceSelector selects the namespaces to search for a GameServer to allocate, instead of the
+	// namespace of the GameServerAllocation. The selected namespaces are searched as if they were set as
+	// Namespaces, in order of their names.
+	// Note: This field can only be set if neither Namespaces or MultiClusterSetting is set.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty" hash:"ignore"`
+
 	// Deprecated: use field Selectors instead. If Selectors is set, this field is ignored.
 	// Required is the GameServer selector from which to choose GameServers from.
 	// Defaults to all GameServers.
@@ -112,6 +180,25 @@ type GameServerAllocationSpec struct {
 	// You can use this to tell the server necessary session data
 	MetaPatch MetaPatch `json:"metadata,omitempty" hash:"ignore"`
 
+	// [Stage: Dev]
+	// [FeatureFlag:AllocationPayload]
+	// Payload is optional opaque data, such as a JSON match configuration, that is stored with the allocated
+	// GameServer, and that the game server can retrieve through the SDK. It can be at most 4KiB.
+	// +optional
+	Payload []byte `json:"payload,omitempty" hash:"ignore"`
+
+	// [Stage: Dev]
+	// [FeatureFlag:MetaPatchTemplates]
+	// RequestID is an optional identifier for this request, such as an idempotency key or session id,
+	// that MetaPatch templates can refer to as `.RequestID`. A unique id is generated if it is not set.
+	// +optional
+	RequestID string `json:"requestID,omitempty" hash:"ignore"`
+	// [Stage: Dev]
+	// [FeatureFlag:MetaPatchTemplates]
+	// TemplateParameters are caller supplied values that MetaPatch templates can refer to as `.Parameters`.
+	// +optional
+	TemplateParameters map[string]string `json:"templateParameters,omitempty" hash:"ignore"`
+
 	// [Stage: Beta]
 	// [FeatureFlag:CountsAndLists]
 	// Counter actions to perform during allocation.
@@ -122,6 +209,47 @@ type GameServerAllocationSpec struct {
 	// List actions to perform during allocation.
 	// +optional
 	Lists map[string]ListAction `json:"lists,omitempty" hash:"ignore"`
+	// [Stage: Dev]
+	// [FeatureFlag:WasmAllocationScoring]
+	// Scoring configures a WebAssembly module that scores the GameServers matching the first matched selector.
+	// The GameServer with the highest score is allocated, and ties are broken by the `scheduling` and `priorities` order.
+	// +optional
+	Scoring *WasmScoring `json:"scoring,omitempty" hash:"ignore"`
+	// [Stage: Dev]
+	// [FeatureFlag:ConnectionTokens]
+	// ConnectionToken requests a short lived signed token in the status of the allocation, that game clients
+	// can present to the allocated GameServer to prove they were allocated to it.
+	// +optional
+	ConnectionToken *ConnectionTokenRequest `json:"connectionToken,omitempty" hash:"ignore"`
+}
+
+// ConnectionTokenRequest is the content of a connection token, in addition to the name,
+// address and ports of the allocated GameServer.
+type ConnectionTokenRequest struct {
+	// PlayerIDs are the players that the token is for.
+	// +optional
+	PlayerIDs []string `json:"playerIDs,omitempty"`
+	// Claims are custom values to include in the token, such as a match or party id.
+	// +optional
+	Claims map[string]string `json:"claims,omitempty"`
+}
+
+// WasmScoring configures a WebAssembly module that scores candidate GameServers for an allocation.
+type WasmScoring struct {
+	// URL of the Wasm module to load. Must be one of the modules allowed by the Agones configuration.
+	URL string `json:"url"`
+	// Hash of the Wasm module, used to verify the integrity of the module
+	// +optional
+	Hash string `json:"hash,omitempty"`
+	// Function is the exported function to call in the Wasm module, defaults to 'score'
+	// +optional
+	Function string `json:"function,omitempty"`
+	// Config values to pass to the Wasm module on startup
+	// +optional
+	Config map[string]string `json:"config,omitempty"`
+	// Parameters are request specific values passed to the scoring function, such as the map a party voted for
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
 }
 
 // GameServerSelector contains all the filter options for selecting
@@ -263,6 +391,21 @@ func (s *GameServerSelector) Matches(gs *agonesv1.GameServer) bool {
 		return false
 	}
 
+	// Ready GameServers can't be allocated until they have warmed up
+	if gs.IsWarmingUp(time.Now()) {
+		return false
+	}
+
+	// GameServers that have exceeded a lifetime limit are waiting to be shut down
+	if gs.IsShuttingDown() {
+		return false
+	}
+
+	// Degraded GameServers keep running, but are not sent new players
+	if gs.IsDegraded() {
+		return false
+	}
+
 	// then if player count is being checked, check that
 	if runtime.FeatureEnabled(runtime.FeaturePlayerAllocationFilter) {
 		// 0 is unlimited number of players
@@ -361,6 +504,40 @@ func (la *ListAction) ListActions(list string, gs *agonesv1.GameServer) error {
 	return errs
 }
 
+// RevertCounterActions attempts to undo the Increment or Decrement action of the CounterAction on the
+// GameServer Counter. Capacity is not reverted, as the previous Capacity is not known.
+// Returns the errors of any actions that could not be performed.
+func (ca *CounterAction) RevertCounterActions(counter string, gs *agonesv1.GameServer) error {
+	if ca.Action == nil || ca.Amount == nil {
+		return nil
+	}
+	action := agonesv1.GameServerPriorityDecrement
+	if *ca.Action == agonesv1.GameServerPriorityDecrement {
+		action = agonesv1.GameServerPriorityIncrement
+	}
+	return gs.UpdateCount(counter, action, *ca.Amount)
+}
+
+// RevertListActions attempts to undo the AddValues and DeleteValues actions of the ListAction on the
+// GameServer List. Capacity is not reverted, as the previous Capacity is not known.
+// Returns the errors of any actions that could not be performed.
+func (la *ListAction) RevertListActions(list string, gs *agonesv1.GameServer) error {
+	var errs error
+	if len(la.AddValues) > 0 {
+		delErr := gs.DeleteListValues(list, la.AddValues)
+		if delErr != nil {
+			errs = errors.Join(errs, delErr)
+		}
+	}
+	if len(la.DeleteValues) > 0 {
+		addErr := gs.AppendListValues(list, la.DeleteValues)
+		if addErr != nil {
+			errs = errors.Join(errs, addErr)
+		}
+	}
+	return errs
+}
+
 // matchLists returns true if there is a match for the ListSelector in the GameServerStatus
 func (s *GameServerSelector) matchLists(gs *agonesv1.GameServer) bool {
 	if gs.Status.Lists == nil {
@@ -494,10 +671,11 @@ func validatePriorities(priorities []agonesv1.Priority, fldPath *field.Path) fie
 	var allErrs field.ErrorList
 	for index, priority := range priorities {
 		keyPath := fldPath.Index(index)
-		if priority.Type != agonesv1.GameServerPriorityCounter && priority.Type != agonesv1.GameServerPriorityList {
+		lifecycle := runtime.FeatureEnabled(runtime.FeatureLifecyclePriorities) && agonesv1.IsLifecyclePriority(priority.Type)
+		if priority.Type != agonesv1.GameServerPriorityCounter && priority.Type != agonesv1.GameServerPriorityList && !lifecycle {
 			allErrs = append(allErrs, field.Invalid(keyPath, priority.Type, "type must be \"Counter\" or \"List\""))
 		}
-		if priority.Key == "" {
+		if priority.Key == "" && !lifecycle {
 			allErrs = append(allErrs, field.Invalid(keyPath, priority.Type, "key must not be nil"))
 		}
 		if priority.Order != agonesv1.GameServerPriorityAscending && priority.Order != agonesv1.GameServerPriorityDescending {
@@ -549,20 +727,203 @@ type MultiClusterSetting struct {
 	PolicySelector metav1.LabelSelector `json:"policySelector,omitempty"`
 }
 
-// MetaPatch is the metadata used to patch the GameServer metadata on allocation
+// MetaPatch is the metadata used to patch the GameServer metadata on allocation.
+// When the MetaPatchTemplates feature is enabled, label and annotation values that contain `{{`
+// are Go templates, that are executed with a MetaPatchTemplateData at allocation time.
 type MetaPatch struct {
 	Labels      map[string]string `json:"labels,omitempty"`
 	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
+// +k8s:deepcopy-gen=false
+
+// MetaPatchTemplateData is the data that MetaPatch templates are executed with.
+type MetaPatchTemplateData struct {
+	// AllocationTime is the time of the allocation, in UTC.
+	// As label values cannot contain spaces or colons, use `{{ .AllocationTime.Unix }}` in labels.
+	AllocationTime time.Time
+	// RequestID is the RequestID of the GameServerAllocation.
+	RequestID string
+	// Source is the cluster name of the multi-cluster allocation policy that the allocation was made
+	// through, or "local" if it was not made through a multi-cluster allocation policy of this cluster.
+	Source string
+	// GameServer is a copy of the GameServer being allocated, before the MetaPatch is applied.
+	// Use the index function for labels and annotations, e.g. `{{ index .GameServer.Labels "agones.dev/fleet" }}`.
+	GameServer MetaPatchTemplateGameServer
+	// Parameters are the TemplateParameters of the GameServerAllocation.
+	// Parameters that are not set are empty.
+	Parameters map[string]string
+}
+
+// +k8s:deepcopy-gen=false
+
+// MetaPatchTemplateGameServer is the copy of a GameServer that MetaPatch templates are executed with.
+// It only has plain fields, so templates cannot change the GameServer being allocated.
+type MetaPatchTemplateGameServer struct {
+	Name        string
+	Namespace   string
+	Labels      map[string]string
+	Annotations map[string]string
+	Status      MetaPatchTemplateGameServerStatus
+}
+
+// +k8s:deepcopy-gen=false
+
+// MetaPatchTemplateGameServerStatus is the copy of a GameServer's status that MetaPatch templates are executed with.
+type MetaPatchTemplateGameServerStatus struct {
+	State    agonesv1.GameServerState
+	NodeName string
+	Address  string
+	Counters map[string]agonesv1.CounterStatus
+	Lists    map[string]agonesv1.ListStatus
+}
+
+// NewMetaPatchTemplateGameServer returns a MetaPatchTemplateGameServer that is a copy of gs.
+func NewMetaPatchTemplateGameServer(gs *agonesv1.GameServer) MetaPatchTemplateGameServer {
+	out := MetaPatchTemplateGameServer{
+		Name:        gs.ObjectMeta.Name,
+		Namespace:   gs.ObjectMeta.Namespace,
+		Labels:      maps.Clone(gs.ObjectMeta.Labels),
+		Annotations: maps.Clone(gs.ObjectMeta.Annotations),
+		Status: MetaPatchTemplateGameServerStatus{
+			State:    gs.Status.State,
+			NodeName: gs.Status.NodeName,
+			Address:  gs.Status.Address,
+			Counters: maps.Clone(gs.Status.Counters),
+		},
+	}
+	if gs.Status.Lists != nil {
+		out.Status.Lists = make(map[string]agonesv1.ListStatus, len(gs.Status.Lists))
+		for name, list := range gs.Status.Lists {
+			out.Status.Lists[name] = agonesv1.ListStatus{Capacity: list.Capacity, Values: slices.Clone(list.Values)}
+		}
+	}
+	return out
+}
+
+// IsMetaPatchTemplate returns true if a MetaPatch label or annotation value is a template.
+func IsMetaPatchTemplate(value string) bool {
+	return runtime.FeatureEnabled(runtime.FeatureMetaPatchTemplates) && strings.Contains(value, "{{")
+}
+
 // Validate returns if the labels and/or annotations that are to be applied to a `GameServer` post
 // allocation are valid.
 func (mp *MetaPatch) Validate(fldPath *field.Path) field.ErrorList {
-	allErrs := metav1validation.ValidateLabels(mp.Labels, fldPath.Child("labels"))
+	var allErrs field.ErrorList
+	labels := make(map[string]string, len(mp.Labels))
+	for key, value := range mp.Labels {
+		if IsMetaPatchTemplate(value) {
+			// only the key of a templated label can be validated before the template is executed
+			allErrs = append(allErrs, metav1validation.ValidateLabelName(key, fldPath.Child("labels"))...)
+			allErrs = append(allErrs, validateMetaPatchTemplate(key, value, fldPath.Child("labels").Key(key))...)
+			continue
+		}
+		labels[key] = value
+	}
+	for key, value := range mp.Annotations {
+		if IsMetaPatchTemplate(value) {
+			allErrs = append(allErrs, validateMetaPatchTemplate(key, value, fldPath.Child("annotations").Key(key))...)
+		}
+	}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabels(labels, fldPath.Child("labels"))...)
 	allErrs = append(allErrs, apivalidation.ValidateAnnotations(mp.Annotations, fldPath.Child("annotations"))...)
 	return allErrs
 }
 
+// Render returns a copy of the MetaPatch with the templates in its label and annotation values executed with data.
+// Returns an error if a template cannot be executed, or results in an invalid label or annotation.
+func (mp *MetaPatch) Render(data MetaPatchTemplateData) (MetaPatch, error) {
+	var out MetaPatch
+	var err error
+	if out.Labels, err = renderMetaPatchValues(mp.Labels, &data); err != nil {
+		return MetaPatch{}, err
+	}
+	if out.Annotations, err = renderMetaPatchValues(mp.Annotations, &data); err != nil {
+		return MetaPatch{}, err
+	}
+	if errs := out.Validate(field.NewPath("metadata")); len(errs) > 0 {
+		return MetaPatch{}, errs.ToAggregate()
+	}
+	return out, nil
+}
+
+// validateMetaPatchTemplate validates a MetaPatch template by executing it against an empty GameServer.
+func validateMetaPatchTemplate(key, value string, fldPath *field.Path) field.ErrorList {
+	if _, err := executeMetaPatchTemplate(key, value, &MetaPatchTemplateData{}); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, value, err.Error())}
+	}
+	return nil
+}
+
+// renderMetaPatchValues returns a copy of values, with the templates in them executed with data.
+func renderMetaPatchValues(values map[string]string, data *MetaPatchTemplateData) (map[string]string, error) {
+	if values == nil {
+		return nil, nil
+	}
+	out := make(map[string]string, len(values))
+	for key, value := range values {
+		if !IsMetaPatchTemplate(value) {
+			out[key] = value
+			continue
+		}
+		rendered, err := executeMetaPatchTemplate(key, value, data)
+		if err != nil {
+			return nil, err
+		}
+		out[key] = rendered
+	}
+	return out, nil
+}
+
+// executeMetaPatchTemplate parses and executes the template value of key with data.
+// Returns an error if the output is longer than maxMetaPatchTemplateOutput, or execution
+// takes longer than metaPatchTemplateTimeout.
+func executeMetaPatchTemplate(key, value string, data *MetaPatchTemplateData) (string, error) {
+	tmpl, err := template.New(key).Option("missingkey=zero").Parse(value)
+	if err != nil {
+		return "", err
+	}
+
+	// text/template cannot be cancelled, so a template that times out is left to finish in the
+	// background, and fails on its next write.
+	w := &metaPatchTemplateWriter{}
+	done := make(chan error, 1)
+	go func() {
+		done <- tmpl.Execute(w, data)
+	}()
+	timer := time.NewTimer(metaPatchTemplateTimeout)
+	defer timer.Stop()
+	select {
+	case err := <-done:
+		if err != nil {
+			return "", err
+		}
+		return w.b.String(), nil
+	case <-timer.C:
+		w.timedOut.Store(true)
+		return "", fmt.Errorf("template %s took longer than %s to execute", key, metaPatchTemplateTimeout)
+	}
+}
+
+// metaPatchTemplateWriter is the io.Writer that MetaPatch templates are executed into,
+// which limits their output to maxMetaPatchTemplateOutput.
+type metaPatchTemplateWriter struct {
+	b        strings.Builder
+	timedOut atomic.Bool
+}
+
+// Write implements io.Writer
+func (w *metaPatchTemplateWriter) Write(p []byte) (int, error) {
+	if w.timedOut.Load() {
+		return 0, errors.New("template timed out")
+	}
+	if w.b.Len()+len(p) > maxMetaPatchTemplateOutput {
+		return 0, fmt.Errorf("template output is longer than %d bytes", maxMetaPatchTemplateOutput)
+	}
+	return w.b.Write(p)
+}
+
 // GameServerAllocationStatus is the status for an GameServerAllocation resource
 type GameServerAllocationStatus struct {
 	// GameServerState is the current state of an GameServerAllocation, e.g. Allocated, or UnAllocated
@@ -578,6 +939,16 @@ type GameServerAllocationStatus struct {
 	Metadata *GameServerMetadata               `json:"metadata,omitempty"`
 	Counters map[string]agonesv1.CounterStatus `json:"counters,omitempty"`
 	Lists    map[string]agonesv1.ListStatus    `json:"lists,omitempty"`
+	// [Stage: Dev]
+	// [FeatureFlag:ConnectionTokens]
+	// ConnectionToken is a signed JSON Web Token that binds the allocated GameServer to the
+	// ConnectionToken request. Only set if a ConnectionToken was requested.
+	ConnectionToken string `json:"connectionToken,omitempty"`
+	// [Stage: Dev]
+	// [FeatureFlag:CrossNamespaceAllocation]
+	// Namespace of the allocated GameServer, which can differ from the namespace of the GameServerAllocation
+	// when it searches other Namespaces.
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // GameServerMetadata is the metadata from the allocated game server at allocation time
@@ -592,6 +963,10 @@ func (gsa *GameServerAllocation) ApplyDefaults() {
 		gsa.Spec.Scheduling = apis.Packed
 	}
 
+	if gsa.Spec.Scoring != nil && gsa.Spec.Scoring.Function == "" {
+		gsa.Spec.Scoring.Function = defaultScoringFunction
+	}
+
 	for i := range gsa.Spec.Priorities {
 		if len(gsa.Spec.Priorities[i].Order) == 0 {
 			gsa.Spec.Priorities[i].Order = agonesv1.GameServerPriorityAscending
@@ -652,10 +1027,116 @@ func (gsa *GameServerAllocation) Validate() field.ErrorList {
 		}
 	}
 
+	if gsa.Spec.Scoring != nil {
+		if !runtime.FeatureEnabled(runtime.FeatureWasmAllocationScoring) {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("scoring"), "Feature WasmAllocationScoring must be enabled if Scoring is specified"))
+		} else {
+			allErrs = append(allErrs, gsa.Spec.Scoring.Validate(specPath.Child("scoring"))...)
+		}
+	}
+
+	if gsa.Spec.ConnectionToken != nil && !runtime.FeatureEnabled(runtime.FeatureConnectionTokens) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("connectionToken"), "Feature ConnectionTokens must be enabled if ConnectionToken is specified"))
+	}
+
+	if gsa.Spec.Payload != nil {
+		if !runtime.FeatureEnabled(runtime.FeatureAllocationPayload) {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("payload"), "Feature AllocationPayload must be enabled if Payload is specified"))
+		} else if len(gsa.Spec.Payload) > MaxPayloadBytes {
+			allErrs = append(allErrs, field.TooLong(specPath.Child("payload"), "", MaxPayloadBytes))
+		}
+	}
+
+	if !runtime.FeatureEnabled(runtime.FeatureMetaPatchTemplates) {
+		if gsa.Spec.RequestID != "" {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("requestID"), "Feature MetaPatchTemplates must be enabled if RequestID is specified"))
+		}
+		if gsa.Spec.TemplateParameters != nil {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("templateParameters"), "Feature MetaPatchTemplates must be enabled if TemplateParameters is specified"))
+		}
+	}
+
+	allErrs = append(allErrs, gsa.validateNamespaces(specPath)...)
 	allErrs = append(allErrs, gsa.Spec.MetaPatch.Validate(specPath.Child("metadata"))...)
 	return allErrs
 }
 
+// validateNamespaces validates the Namespaces and NamespaceSelector of the GameServerAllocation
+func (gsa *GameServerAllocation) validateNamespaces(specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if gsa.Spec.Namespaces == nil && gsa.Spec.NamespaceSelector == nil {
+		return allErrs
+	}
+
+	if !runtime.FeatureEnabled(runtime.FeatureCrossNamespaceAllocation) {
+		if gsa.Spec.Namespaces != nil {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("namespaces"), "Feature CrossNamespaceAllocation must be enabled if Namespaces is specified"))
+		}
+		if gsa.Spec.NamespaceSelector != nil {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("namespaceSelector"), "Feature CrossNamespaceAllocation must be enabled if NamespaceSelector is specified"))
+		}
+		return allErrs
+	}
+
+	if gsa.Spec.MultiClusterSetting.Enabled {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("multiClusterSetting", "enabled"), "multi-cluster allocation cannot be combined with namespaces or namespaceSelector"))
+	}
+
+	if gsa.Spec.NamespaceSelector != nil {
+		if gsa.Spec.Namespaces != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("namespaceSelector"), gsa.Spec.NamespaceSelector, "namespaceSelector cannot be set if namespaces is set"))
+		}
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(gsa.Spec.NamespaceSelector, metav1validation.LabelSelectorValidationOptions{}, specPath.Child("namespaceSelector"))...)
+	}
+
+	seen := make(map[string]bool, len(gsa.Spec.Namespaces))
+	for i, ns := range gsa.Spec.Namespaces {
+		fldPath := specPath.Child("namespaces").Index(i)
+		for _, msg := range apivalidation.ValidateNamespaceName(ns, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath, ns, msg))
+		}
+		if seen[ns] {
+			allErrs = append(allErrs, field.Duplicate(fldPath, ns))
+		}
+		seen[ns] = true
+	}
+	return allErrs
+}
+
+// SearchNamespaces returns the namespaces to search for a GameServer to allocate, in order of preference.
+// This is the Namespaces of the spec if the CrossNamespaceAllocation feature is enabled and they are set,
+// otherwise the namespace of the GameServerAllocation. A NamespaceSelector must be resolved into Namespaces
+// before allocation, and no namespace is searched while it is not.
+func (gsa *GameServerAllocation) SearchNamespaces() []string {
+	if runtime.FeatureEnabled(runtime.FeatureCrossNamespaceAllocation) && (gsa.Spec.Namespaces != nil || gsa.Spec.NamespaceSelector != nil) {
+		return gsa.Spec.Namespaces
+	}
+	return []string{gsa.ObjectMeta.Namespace}
+}
+
+// SearchesNamespace returns true if namespace is one of the SearchNamespaces of the GameServerAllocation
+func (gsa *GameServerAllocation) SearchesNamespace(namespace string) bool {
+	for _, ns := range gsa.SearchNamespaces() {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns if the WasmScoring is valid
+func (ws *WasmScoring) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if ws.URL == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), "url is required"))
+	} else if u, err := url.Parse(ws.URL); err != nil || !u.IsAbs() {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), ws.URL, "url must be an absolute URL"))
+	} else if !IsAllowedWasmScoringURL(ws.URL) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("url"), "url must be one of the Wasm scoring modules allowed by the Agones configuration"))
+	}
+	return allErrs
+}
+
 // Converter converts game server allocation required and preferred fields to selectors field.
 func (gsa *GameServerAllocation) Converter() {
 	if len(gsa.Spec.Selectors) == 0 {
@@ -677,3 +1158,54 @@ func (gsa *GameServerAllocation) SortKey() (uint64, error) {
 	}
 	return hash, nil
 }
+
+// GameServerScoringCandidate is a GameServer that can be allocated, as passed to a Wasm scoring function
+type GameServerScoringCandidate struct {
+	// Name of the GameServer
+	Name string `json:"name"`
+	// Namespace of the GameServer, which can differ from the namespace of the allocation
+	// when it searches other namespaces
+	Namespace string `json:"namespace,omitempty"`
+	// Labels of the GameServer
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations of the GameServer
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// NodeName is the name of the Node the GameServer is running on
+	NodeName string `json:"nodeName,omitempty"`
+	// CreationTimestamp of the GameServer
+	CreationTimestamp metav1.Time `json:"creationTimestamp"`
+	// AgeSeconds is the number of seconds since the GameServer was created
+	AgeSeconds int64 `json:"ageSeconds"`
+	// Counters of the GameServer
+	Counters map[string]agonesv1.CounterStatus `json:"counters,omitempty"`
+	// Lists of the GameServer
+	Lists map[string]agonesv1.ListStatus `json:"lists,omitempty"`
+}
+
+// GameServerScoringRequest defines the request to a Wasm scoring function
+type GameServerScoringRequest struct {
+	// UID is an identifier for the individual request/response.
+	UID types.UID `json:"uid"`
+	// Namespace of the allocation
+	Namespace string `json:"namespace"`
+	// Parameters of the allocation's scoring configuration
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// GameServers are the candidates to score, all of which match the same selector
+	GameServers []GameServerScoringCandidate `json:"gameServers"`
+}
+
+// GameServerScoringResponse defines the response of a Wasm scoring function
+type GameServerScoringResponse struct {
+	// UID is an identifier for the individual request/response.
+	// This should be copied over from the corresponding GameServerScoringRequest.
+	UID types.UID `json:"uid"`
+	// Scores of each of the GameServers in the request, in the same order. Higher scores are preferred.
+	Scores []int64 `json:"scores"`
+}
+
+// GameServerScoringReview is passed to the Wasm scoring function with a populated Request value,
+// and then returned with a populated Response.
+type GameServerScoringReview struct {
+	Request  *GameServerScoringRequest  `json:"request"`
+	Response *GameServerScoringResponse `json:"response"`
+}
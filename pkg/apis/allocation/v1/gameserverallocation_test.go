@@ -17,7 +17,9 @@ package v1
 import (
 	"fmt"
 	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"agones.dev/agones/pkg/apis"
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
@@ -408,6 +410,13 @@ func TestGameServerSelectorMatches(t *testing.T) {
 	}
 
 	allocatedState := agonesv1.GameServerStateAllocated
+	readyTime := metav1.NewTime(time.Now().Add(-5 * time.Second))
+	warmingUp := func(minReadySeconds int32) *agonesv1.GameServer {
+		return &agonesv1.GameServer{
+			Spec:   agonesv1.GameServerSpec{MinReadySeconds: minReadySeconds},
+			Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady, ReadyTime: &readyTime},
+		}
+	}
 	fixtures := map[string]struct {
 		features   string
 		selector   *GameServerSelector
@@ -831,6 +840,64 @@ func TestGameServerSelectorMatches(t *testing.T) {
 			}},
 			matches: false,
 		},
+		"warming up, no match": {
+			features:   string(runtime.FeatureMinReadySeconds) + "=true",
+			selector:   &GameServerSelector{},
+			gameServer: warmingUp(60),
+			matches:    false,
+		},
+		"warmed up, match": {
+			features:   string(runtime.FeatureMinReadySeconds) + "=true",
+			selector:   &GameServerSelector{},
+			gameServer: warmingUp(1),
+			matches:    true,
+		},
+		"warming up, feature disabled, match": {
+			features:   string(runtime.FeatureMinReadySeconds) + "=false",
+			selector:   &GameServerSelector{},
+			gameServer: warmingUp(60),
+			matches:    true,
+		},
+		"shutting down, no match": {
+			features: string(runtime.FeatureGameServerLifetime) + "=true",
+			selector: &GameServerSelector{},
+			gameServer: &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{agonesv1.GameServerShutdownAtAnnotation: "2024-01-01T00:00:00Z"},
+			}},
+			matches: false,
+		},
+		"shutting down, feature disabled, match": {
+			features: string(runtime.FeatureGameServerLifetime) + "=false",
+			selector: &GameServerSelector{},
+			gameServer: &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{agonesv1.GameServerShutdownAtAnnotation: "2024-01-01T00:00:00Z"},
+			}},
+			matches: true,
+		},
+		"degraded, no match": {
+			features: string(runtime.FeatureHealthReports) + "=true",
+			selector: &GameServerSelector{},
+			gameServer: &agonesv1.GameServer{Status: agonesv1.GameServerStatus{
+				Health: &agonesv1.GameServerHealth{Status: agonesv1.GameServerHealthStatusDegraded, Reason: "tick loop stalled"},
+			}},
+			matches: false,
+		},
+		"reported healthy, match": {
+			features: string(runtime.FeatureHealthReports) + "=true",
+			selector: &GameServerSelector{},
+			gameServer: &agonesv1.GameServer{Status: agonesv1.GameServerStatus{
+				Health: &agonesv1.GameServerHealth{Status: agonesv1.GameServerHealthStatusHealthy},
+			}},
+			matches: true,
+		},
+		"degraded, feature disabled, match": {
+			features: string(runtime.FeatureHealthReports) + "=false",
+			selector: &GameServerSelector{},
+			gameServer: &agonesv1.GameServer{Status: agonesv1.GameServerStatus{
+				Health: &agonesv1.GameServerHealth{Status: agonesv1.GameServerHealthStatusDegraded, Reason: "tick loop stalled"},
+			}},
+			matches: true,
+		},
 	}
 
 	for k, v := range fixtures {
@@ -1073,6 +1140,159 @@ func TestGameServerListActions(t *testing.T) {
 	}
 }
 
+func TestGameServerRevertCounterActions(t *testing.T) {
+	t.Parallel()
+
+	DECREMENT := "Decrement"
+	INCREMENT := "Increment"
+
+	testScenarios := map[string]struct {
+		ca      CounterAction
+		counter string
+		gs      *agonesv1.GameServer
+		want    *agonesv1.GameServer
+		wantErr bool
+	}{
+		"revert increment": {
+			ca: CounterAction{
+				Action:   &INCREMENT,
+				Amount:   int64Pointer(10),
+				Capacity: int64Pointer(50),
+			},
+			counter: "baddies",
+			gs: &agonesv1.GameServer{Status: agonesv1.GameServerStatus{
+				Counters: map[string]agonesv1.CounterStatus{
+					"baddies": {
+						Count:    11,
+						Capacity: 50,
+					}}}},
+			want: &agonesv1.GameServer{Status: agonesv1.GameServerStatus{
+				Counters: map[string]agonesv1.CounterStatus{
+					"baddies": {
+						Count:    1,
+						Capacity: 50,
+					}}}},
+			wantErr: false,
+		},
+		"revert decrement": {
+			ca: CounterAction{
+				Action: &DECREMENT,
+				Amount: int64Pointer(2),
+			},
+			counter: "heroes",
+			gs: &agonesv1.GameServer{Status: agonesv1.GameServerStatus{
+				Counters: map[string]agonesv1.CounterStatus{
+					"heroes": {
+						Count:    3,
+						Capacity: 10,
+					}}}},
+			want: &agonesv1.GameServer{Status: agonesv1.GameServerStatus{
+				Counters: map[string]agonesv1.CounterStatus{
+					"heroes": {
+						Count:    5,
+						Capacity: 10,
+					}}}},
+			wantErr: false,
+		},
+		"capacity only is a no-op": {
+			ca: CounterAction{
+				Capacity: int64Pointer(0),
+			},
+			counter: "mages",
+			gs: &agonesv1.GameServer{Status: agonesv1.GameServerStatus{
+				Counters: map[string]agonesv1.CounterStatus{
+					"mages": {
+						Count:    0,
+						Capacity: 0,
+					}}}},
+			want: &agonesv1.GameServer{Status: agonesv1.GameServerStatus{
+				Counters: map[string]agonesv1.CounterStatus{
+					"mages": {
+						Count:    0,
+						Capacity: 0,
+					}}}},
+			wantErr: false,
+		},
+		"missing counter": {
+			ca: CounterAction{
+				Action: &INCREMENT,
+				Amount: int64Pointer(1),
+			},
+			counter: "sages",
+			gs:      &agonesv1.GameServer{Status: agonesv1.GameServerStatus{}},
+			want:    &agonesv1.GameServer{Status: agonesv1.GameServerStatus{}},
+			wantErr: true,
+		},
+	}
+
+	for test, testScenario := range testScenarios {
+		t.Run(test, func(t *testing.T) {
+			errs := testScenario.ca.RevertCounterActions(testScenario.counter, testScenario.gs)
+			if errs != nil {
+				assert.True(t, testScenario.wantErr)
+			} else {
+				assert.False(t, testScenario.wantErr)
+			}
+			assert.Equal(t, testScenario.want, testScenario.gs)
+		})
+	}
+}
+
+func TestGameServerRevertListActions(t *testing.T) {
+	t.Parallel()
+
+	testScenarios := map[string]struct {
+		la      ListAction
+		list    string
+		gs      *agonesv1.GameServer
+		want    *agonesv1.GameServer
+		wantErr bool
+	}{
+		"revert added and deleted values": {
+			la: ListAction{
+				AddValues:    []string{"magician1", "magician3"},
+				Capacity:     int64Pointer(42),
+				DeleteValues: []string{"magician2"},
+			},
+			list: "magicians",
+			gs: &agonesv1.GameServer{Status: agonesv1.GameServerStatus{
+				Lists: map[string]agonesv1.ListStatus{
+					"magicians": {
+						Values:   []string{"magician1", "magician4", "magician3"},
+						Capacity: 42,
+					}}}},
+			want: &agonesv1.GameServer{Status: agonesv1.GameServerStatus{
+				Lists: map[string]agonesv1.ListStatus{
+					"magicians": {
+						Values:   []string{"magician4", "magician2"},
+						Capacity: 42,
+					}}}},
+			wantErr: false,
+		},
+		"missing list": {
+			la: ListAction{
+				AddValues: []string{"sage1"},
+			},
+			list:    "sages",
+			gs:      &agonesv1.GameServer{Status: agonesv1.GameServerStatus{}},
+			want:    &agonesv1.GameServer{Status: agonesv1.GameServerStatus{}},
+			wantErr: true,
+		},
+	}
+
+	for test, testScenario := range testScenarios {
+		t.Run(test, func(t *testing.T) {
+			errs := testScenario.la.RevertListActions(testScenario.list, testScenario.gs)
+			if errs != nil {
+				assert.True(t, testScenario.wantErr)
+			} else {
+				assert.False(t, testScenario.wantErr)
+			}
+			assert.Equal(t, testScenario.want, testScenario.gs)
+		})
+	}
+}
+
 func TestValidatePriorities(t *testing.T) {
 	t.Parallel()
 
@@ -1160,6 +1380,16 @@ func TestValidatePriorities(t *testing.T) {
 			}
 		})
 	}
+
+	// lifecycle priorities have no key, and need their feature flag
+	lifecycle := []agonesv1.Priority{
+		{Type: agonesv1.GameServerPriorityRevision, Order: agonesv1.GameServerPriorityDescending},
+		{Type: agonesv1.GameServerPriorityCreationTime, Order: agonesv1.GameServerPriorityAscending},
+		{Type: agonesv1.GameServerPriorityReadyTime, Order: agonesv1.GameServerPriorityAscending},
+	}
+	assert.Len(t, validatePriorities(lifecycle, fieldPath), 6)
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true&%s=true", runtime.FeatureCountsAndLists, runtime.FeatureLifecyclePriorities)))
+	assert.Nil(t, validatePriorities(lifecycle, fieldPath))
 }
 
 func TestValidateCounterActions(t *testing.T) {
@@ -1350,6 +1580,364 @@ func TestGameServerAllocationValidate(t *testing.T) {
 	assert.Equal(t, "spec.counters", allErrs[6].Field)
 }
 
+func TestGameServerAllocationValidateScoring(t *testing.T) {
+	t.Parallel()
+
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	gsa := &GameServerAllocation{Spec: GameServerAllocationSpec{Scoring: &WasmScoring{URL: "https://example.com/score.wasm"}}}
+	gsa.ApplyDefaults()
+	assert.Equal(t, "score", gsa.Spec.Scoring.Function)
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=false", runtime.FeatureWasmAllocationScoring)))
+	allErrs := gsa.Validate()
+	require.Len(t, allErrs, 1)
+	assert.Equal(t, field.ErrorTypeForbidden, allErrs[0].Type)
+	assert.Equal(t, "spec.scoring", allErrs[0].Field)
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureWasmAllocationScoring)))
+	allErrs = gsa.Validate()
+	require.Len(t, allErrs, 1)
+	assert.Equal(t, field.ErrorTypeForbidden, allErrs[0].Type)
+	assert.Equal(t, "spec.scoring.url", allErrs[0].Field)
+
+	SetAllowedWasmScoringURLs([]string{"https://example.com/score.wasm"})
+	defer SetAllowedWasmScoringURLs(nil)
+	assert.Empty(t, gsa.Validate())
+
+	gsa.Spec.Scoring.URL = "https://example.com/other.wasm"
+	allErrs = gsa.Validate()
+	require.Len(t, allErrs, 1)
+	assert.Equal(t, field.ErrorTypeForbidden, allErrs[0].Type)
+	assert.Equal(t, "spec.scoring.url", allErrs[0].Field)
+
+	gsa.Spec.Scoring.URL = "score.wasm"
+	allErrs = gsa.Validate()
+	require.Len(t, allErrs, 1)
+	assert.Equal(t, field.ErrorTypeInvalid, allErrs[0].Type)
+	assert.Equal(t, "spec.scoring.url", allErrs[0].Field)
+
+	gsa.Spec.Scoring.URL = ""
+	allErrs = gsa.Validate()
+	require.Len(t, allErrs, 1)
+	assert.Equal(t, field.ErrorTypeRequired, allErrs[0].Type)
+}
+
+func TestGameServerAllocationValidateConnectionToken(t *testing.T) {
+	t.Parallel()
+
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	gsa := &GameServerAllocation{Spec: GameServerAllocationSpec{ConnectionToken: &ConnectionTokenRequest{PlayerIDs: []string{"player1"}}}}
+	gsa.ApplyDefaults()
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=false", runtime.FeatureConnectionTokens)))
+	allErrs := gsa.Validate()
+	require.Len(t, allErrs, 1)
+	assert.Equal(t, field.ErrorTypeForbidden, allErrs[0].Type)
+	assert.Equal(t, "spec.connectionToken", allErrs[0].Field)
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureConnectionTokens)))
+	assert.Empty(t, gsa.Validate())
+}
+
+func TestGameServerAllocationValidatePayload(t *testing.T) {
+	t.Parallel()
+
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	gsa := &GameServerAllocation{Spec: GameServerAllocationSpec{Payload: []byte(`{"teams":2}`)}}
+	gsa.ApplyDefaults()
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=false", runtime.FeatureAllocationPayload)))
+	allErrs := gsa.Validate()
+	require.Len(t, allErrs, 1)
+	assert.Equal(t, field.ErrorTypeForbidden, allErrs[0].Type)
+	assert.Equal(t, "spec.payload", allErrs[0].Field)
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureAllocationPayload)))
+	assert.Empty(t, gsa.Validate())
+
+	gsa.Spec.Payload = make([]byte, MaxPayloadBytes)
+	assert.Empty(t, gsa.Validate())
+
+	gsa.Spec.Payload = make([]byte, MaxPayloadBytes+1)
+	allErrs = gsa.Validate()
+	require.Len(t, allErrs, 1)
+	assert.Equal(t, field.ErrorTypeTooLong, allErrs[0].Type)
+	assert.Equal(t, "spec.payload", allErrs[0].Field)
+}
+
+func TestGameServerAllocationValidateNamespaces(t *testing.T) {
+	t.Parallel()
+
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "blue"}}
+	fixtures := map[string]struct {
+		features string
+		spec     GameServerAllocationSpec
+		want     []string
+	}{
+		"disabled namespaces": {
+			features: fmt.Sprintf("%s=false", runtime.FeatureCrossNamespaceAllocation),
+			spec:     GameServerAllocationSpec{Namespaces: []string{"eu", "us"}},
+			want:     []string{"spec.namespaces"},
+		},
+		"disabled namespace selector": {
+			features: fmt.Sprintf("%s=false", runtime.FeatureCrossNamespaceAllocation),
+			spec:     GameServerAllocationSpec{NamespaceSelector: selector},
+			want:     []string{"spec.namespaceSelector"},
+		},
+		"namespaces": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureCrossNamespaceAllocation),
+			spec:     GameServerAllocationSpec{Namespaces: []string{"eu", "us"}},
+		},
+		"namespace selector": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureCrossNamespaceAllocation),
+			spec:     GameServerAllocationSpec{NamespaceSelector: selector},
+		},
+		"both": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureCrossNamespaceAllocation),
+			spec:     GameServerAllocationSpec{Namespaces: []string{"eu"}, NamespaceSelector: selector},
+			want:     []string{"spec.namespaceSelector"},
+		},
+		"invalid and duplicate namespaces": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureCrossNamespaceAllocation),
+			spec:     GameServerAllocationSpec{Namespaces: []string{"eu", "Not_A_Namespace", "eu"}},
+			want:     []string{"spec.namespaces[1]", "spec.namespaces[2]"},
+		},
+		"invalid namespace selector": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureCrossNamespaceAllocation),
+			spec:     GameServerAllocationSpec{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "not a value"}}},
+			want:     []string{"spec.namespaceSelector.matchLabels"},
+		},
+		"multi-cluster": {
+			features: fmt.Sprintf("%s=true", runtime.FeatureCrossNamespaceAllocation),
+			spec:     GameServerAllocationSpec{Namespaces: []string{"eu"}, MultiClusterSetting: MultiClusterSetting{Enabled: true}},
+			want:     []string{"spec.multiClusterSetting.enabled"},
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			require.NoError(t, runtime.ParseFeatures(v.features))
+
+			gsa := &GameServerAllocation{ObjectMeta: metav1.ObjectMeta{Namespace: "default"}, Spec: v.spec}
+			gsa.ApplyDefaults()
+
+			var fields []string
+			for _, err := range gsa.Validate() {
+				fields = append(fields, err.Field)
+			}
+			assert.Equal(t, v.want, fields)
+		})
+	}
+}
+
+func TestGameServerAllocationSearchNamespaces(t *testing.T) {
+	t.Parallel()
+
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	gsa := &GameServerAllocation{ObjectMeta: metav1.ObjectMeta{Namespace: "default"}, Spec: GameServerAllocationSpec{Namespaces: []string{"us", "eu"}}}
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=false", runtime.FeatureCrossNamespaceAllocation)))
+	assert.Equal(t, []string{"default"}, gsa.SearchNamespaces())
+	assert.True(t, gsa.SearchesNamespace("default"))
+	assert.False(t, gsa.SearchesNamespace("eu"))
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureCrossNamespaceAllocation)))
+	assert.Equal(t, []string{"us", "eu"}, gsa.SearchNamespaces())
+	assert.False(t, gsa.SearchesNamespace("default"))
+	assert.True(t, gsa.SearchesNamespace("eu"))
+
+	// an unresolved selector searches nothing
+	gsa.Spec.Namespaces = nil
+	gsa.Spec.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "blue"}}
+	assert.Empty(t, gsa.SearchNamespaces())
+	assert.False(t, gsa.SearchesNamespace("default"))
+
+	gsa.Spec.NamespaceSelector = nil
+	assert.Equal(t, []string{"default"}, gsa.SearchNamespaces())
+}
+
+func TestGameServerAllocationValidateMetaPatchTemplates(t *testing.T) {
+	t.Parallel()
+
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	gsa := &GameServerAllocation{Spec: GameServerAllocationSpec{
+		RequestID:          "abc",
+		TemplateParameters: map[string]string{"session": "1234"},
+		MetaPatch: MetaPatch{
+			Labels:      map[string]string{"session": "{{ .Parameters.session }}", "fleet": "{{ index .GameServer.Labels \"agones.dev/fleet\" }}"},
+			Annotations: map[string]string{"allocated-at": "{{ .AllocationTime.Format \"2006-01-02T15:04:05Z07:00\" }}"},
+		},
+	}}
+	gsa.ApplyDefaults()
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=false", runtime.FeatureMetaPatchTemplates)))
+	allErrs := gsa.Validate()
+	var fields []string
+	for _, err := range allErrs {
+		fields = append(fields, err.Field)
+	}
+	// the templates are invalid label values when not enabled
+	assert.ElementsMatch(t, []string{"spec.requestID", "spec.templateParameters", "spec.metadata.labels", "spec.metadata.labels"}, fields)
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureMetaPatchTemplates)))
+	assert.Empty(t, gsa.Validate())
+
+	gsa.Spec.MetaPatch.Labels["$$$$"] = "{{ .RequestID }}"
+	gsa.Spec.MetaPatch.Annotations["broken"] = "{{ .RequestID "
+	gsa.Spec.MetaPatch.Annotations["unknown"] = "{{ .GameServer.Unknown }}"
+	allErrs = gsa.Validate()
+	fields = nil
+	for _, err := range allErrs {
+		fields = append(fields, err.Field)
+	}
+	assert.ElementsMatch(t, []string{"spec.metadata.labels", "spec.metadata.annotations[broken]", "spec.metadata.annotations[unknown]"}, fields)
+}
+
+func TestMetaPatchRender(t *testing.T) {
+	t.Parallel()
+
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	now := time.Date(2026, time.October, 19, 10, 30, 0, 0, time.UTC)
+	gs := &agonesv1.GameServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "gs1", Labels: map[string]string{agonesv1.FleetNameLabel: "blue"}},
+		Status: agonesv1.GameServerStatus{
+			NodeName: "node1",
+			Counters: map[string]agonesv1.CounterStatus{"players": {Count: 3, Capacity: 10}},
+		},
+	}
+	data := MetaPatchTemplateData{
+		AllocationTime: now,
+		RequestID:      "abc",
+		Source:         "local",
+		GameServer:     NewMetaPatchTemplateGameServer(gs),
+		Parameters:     map[string]string{"session": "1234"},
+	}
+	mp := MetaPatch{
+		Labels: map[string]string{
+			"static":       "value",
+			"allocated-at": "{{ .AllocationTime.Unix }}",
+			"session":      "{{ .Parameters.session }}",
+			"missing":      "{{ .Parameters.missing }}",
+			"fleet":        "{{ index .GameServer.Labels \"agones.dev/fleet\" }}",
+		},
+		Annotations: map[string]string{
+			"allocated-at": "{{ .AllocationTime.Format \"2006-01-02T15:04:05Z07:00\" }}",
+			"request":      "{{ .RequestID }}/{{ .Source }}",
+			"server":       "{{ .GameServer.Name }} on {{ .GameServer.Status.NodeName }}",
+			"players":      "{{ .GameServer.Status.Counters.players.Count }}",
+		},
+	}
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=false", runtime.FeatureMetaPatchTemplates)))
+	out, err := mp.Render(data)
+	require.Error(t, err)
+	assert.Equal(t, MetaPatch{}, out)
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureMetaPatchTemplates)))
+	out, err = mp.Render(data)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"static":       "value",
+		"allocated-at": "1792405800",
+		"session":      "1234",
+		"missing":      "",
+		"fleet":        "blue",
+	}, out.Labels)
+	assert.Equal(t, map[string]string{
+		"allocated-at": "2026-10-19T10:30:00Z",
+		"request":      "abc/local",
+		"server":       "gs1 on node1",
+		"players":      "3",
+	}, out.Annotations)
+	// the MetaPatch itself is not changed
+	assert.Equal(t, "{{ .RequestID }}/{{ .Source }}", mp.Annotations["request"])
+
+	// rendered labels must be valid label values
+	mp.Labels["allocated-at"] = "{{ .AllocationTime }}"
+	_, err = mp.Render(data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "metadata.labels")
+	delete(mp.Labels, "allocated-at")
+
+	// templates cannot change the GameServer
+	mp.Annotations["players"] = "{{ .GameServer.UpdateCount \"players\" \"Increment\" 7 }}"
+	_, err = mp.Render(data)
+	require.Error(t, err)
+	assert.Equal(t, int64(3), gs.Status.Counters["players"].Count)
+
+	// template output is limited
+	mp.Annotations["players"] = fmt.Sprintf("{{ range $i := .GameServer.Status.Lists.big.Values }}%s{{ end }}", strings.Repeat("x", 1024))
+	data.GameServer.Status.Lists = map[string]agonesv1.ListStatus{"big": {Values: make([]string, 100)}}
+	_, err = mp.Render(data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "longer than")
+
+	// template execution time is limited
+	mp.Annotations["players"] = "{{ $l := .GameServer.Status.Lists.big.Values }}{{ range $l }}x{{ range $l }}{{ end }}{{ end }}"
+	data.GameServer.Status.Lists = map[string]agonesv1.ListStatus{"big": {Values: make([]string, 5000)}}
+	_, err = mp.Render(data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "took longer than")
+}
+
+func TestNewMetaPatchTemplateGameServer(t *testing.T) {
+	t.Parallel()
+
+	gs := &agonesv1.GameServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "gs1",
+			Namespace:   "default",
+			Labels:      map[string]string{"a": "b"},
+			Annotations: map[string]string{"c": "d"},
+		},
+		Status: agonesv1.GameServerStatus{
+			State:    agonesv1.GameServerStateReady,
+			NodeName: "node1",
+			Address:  "1.2.3.4",
+			Counters: map[string]agonesv1.CounterStatus{"players": {Count: 1, Capacity: 10}},
+			Lists:    map[string]agonesv1.ListStatus{"rooms": {Values: []string{"r1"}, Capacity: 5}},
+		},
+	}
+
+	view := NewMetaPatchTemplateGameServer(gs)
+	assert.Equal(t, MetaPatchTemplateGameServer{
+		Name:        "gs1",
+		Namespace:   "default",
+		Labels:      map[string]string{"a": "b"},
+		Annotations: map[string]string{"c": "d"},
+		Status: MetaPatchTemplateGameServerStatus{
+			State:    agonesv1.GameServerStateReady,
+			NodeName: "node1",
+			Address:  "1.2.3.4",
+			Counters: map[string]agonesv1.CounterStatus{"players": {Count: 1, Capacity: 10}},
+			Lists:    map[string]agonesv1.ListStatus{"rooms": {Values: []string{"r1"}, Capacity: 5}},
+		},
+	}, view)
+
+	// changes to the copy do not change the GameServer
+	view.Labels["a"] = "x"
+	view.Status.Counters["players"] = agonesv1.CounterStatus{Count: 2}
+	view.Status.Lists["rooms"].Values[0] = "x"
+	assert.Equal(t, "b", gs.ObjectMeta.Labels["a"])
+	assert.Equal(t, int64(1), gs.Status.Counters["players"].Count)
+	assert.Equal(t, []string{"r1"}, gs.Status.Lists["rooms"].Values)
+}
+
 func TestGameServerAllocationConverter(t *testing.T) {
 	t.Parallel()
 
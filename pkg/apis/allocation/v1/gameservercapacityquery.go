@@ -0,0 +1,106 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// +genclient
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GameServerCapacityQuery counts the GameServers that currently match a set of
+// allocation selectors, without allocating any of them.
+type GameServerCapacityQuery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              GameServerCapacityQuerySpec   `json:"spec"`
+	Status            GameServerCapacityQueryStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GameServerCapacityQueryList is a list of GameServerCapacityQuery resources
+type GameServerCapacityQueryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []GameServerCapacityQuery `json:"items"`
+}
+
+// GameServerCapacityQuerySpec is the spec for a GameServerCapacityQuery
+type GameServerCapacityQuerySpec struct {
+	// Selectors to count the matching GameServers of, with the same semantics as the
+	// selectors of a GameServerAllocation.
+	Selectors []GameServerSelector `json:"selectors,omitempty"`
+	// GroupByLabel is a label key to additionally group the count of each selector by the value of.
+	// GameServers without the label are grouped under an empty value.
+	// Note: This field can only be set if GroupByNode is not set.
+	// +optional
+	GroupByLabel string `json:"groupByLabel,omitempty"`
+	// GroupByNode additionally groups the count of each selector by the node the GameServers are running on.
+	// +optional
+	GroupByNode bool `json:"groupByNode,omitempty"`
+}
+
+// GameServerCapacityQueryStatus is the status for a GameServerCapacityQuery
+type GameServerCapacityQueryStatus struct {
+	// Selectors has the count for each selector in the spec, in the same order.
+	Selectors []SelectorCapacity `json:"selectors,omitempty"`
+}
+
+// SelectorCapacity is the number of GameServers that match a selector
+type SelectorCapacity struct {
+	// Count is the number of GameServers that match the selector.
+	Count int64 `json:"count"`
+	// Groups is the number of GameServers that match the selector, by label value or node name.
+	// +optional
+	Groups map[string]int64 `json:"groups,omitempty"`
+}
+
+// ApplyDefaults applies the default values to this GameServerCapacityQuery
+func (q *GameServerCapacityQuery) ApplyDefaults() {
+	if len(q.Spec.Selectors) == 0 {
+		q.Spec.Selectors = []GameServerSelector{{}}
+	}
+	for i := range q.Spec.Selectors {
+		q.Spec.Selectors[i].ApplyDefaults()
+	}
+}
+
+// Validate validation for the GameServerCapacityQuery
+// Validate should be called before attempting to Match any of the GameServer selectors.
+func (q *GameServerCapacityQuery) Validate() field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	for i := range q.Spec.Selectors {
+		allErrs = append(allErrs, q.Spec.Selectors[i].Validate(specPath.Child("selectors").Index(i))...)
+	}
+
+	if q.Spec.GroupByLabel != "" {
+		if q.Spec.GroupByNode {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("groupByLabel"), q.Spec.GroupByLabel, "groupByLabel cannot be set if groupByNode is set"))
+		}
+		for _, msg := range validation.IsQualifiedName(q.Spec.GroupByLabel) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("groupByLabel"), q.Spec.GroupByLabel, msg))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGameServerCapacityQueryApplyDefaults(t *testing.T) {
+	t.Parallel()
+
+	query := &GameServerCapacityQuery{}
+	query.ApplyDefaults()
+
+	require.Len(t, query.Spec.Selectors, 1)
+	assert.Equal(t, agonesv1.GameServerStateReady, *query.Spec.Selectors[0].GameServerState)
+
+	allocated := agonesv1.GameServerStateAllocated
+	query = &GameServerCapacityQuery{Spec: GameServerCapacityQuerySpec{Selectors: []GameServerSelector{{}, {GameServerState: &allocated}}}}
+	query.ApplyDefaults()
+
+	require.Len(t, query.Spec.Selectors, 2)
+	assert.Equal(t, agonesv1.GameServerStateReady, *query.Spec.Selectors[0].GameServerState)
+	assert.Equal(t, agonesv1.GameServerStateAllocated, *query.Spec.Selectors[1].GameServerState)
+}
+
+func TestGameServerCapacityQueryValidate(t *testing.T) {
+	t.Parallel()
+
+	shutdown := agonesv1.GameServerStateShutdown
+	fixtures := map[string]struct {
+		spec GameServerCapacityQuerySpec
+		want []string
+	}{
+		"valid": {
+			spec: GameServerCapacityQuerySpec{
+				Selectors:    []GameServerSelector{{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{agonesv1.FleetNameLabel: "blue"}}}},
+				GroupByLabel: "agones.dev/fleet",
+			},
+		},
+		"group by node": {
+			spec: GameServerCapacityQuerySpec{GroupByNode: true},
+		},
+		"group by label and node": {
+			spec: GameServerCapacityQuerySpec{GroupByLabel: "region", GroupByNode: true},
+			want: []string{"spec.groupByLabel"},
+		},
+		"invalid label": {
+			spec: GameServerCapacityQuerySpec{GroupByLabel: "not a label"},
+			want: []string{"spec.groupByLabel"},
+		},
+		"invalid selector": {
+			spec: GameServerCapacityQuerySpec{Selectors: []GameServerSelector{{}, {GameServerState: &shutdown}}},
+			want: []string{"spec.selectors[1].gameServerState"},
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			query := &GameServerCapacityQuery{Spec: v.spec}
+			query.ApplyDefaults()
+
+			errs := query.Validate()
+			var fields []string
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			assert.Equal(t, v.want, fields)
+		})
+	}
+}
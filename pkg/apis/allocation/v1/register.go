@@ -53,6 +53,8 @@ func addKnownTypes(apiScheme *k8sruntime.Scheme) error {
 	apiScheme.AddKnownTypes(SchemeGroupVersion,
 		&GameServerAllocation{},
 		&GameServerAllocationList{},
+		&GameServerCapacityQuery{},
+		&GameServerCapacityQueryList{},
 	)
 	metav1.AddToGroupVersion(apiScheme, SchemeGroupVersion)
 	return nil
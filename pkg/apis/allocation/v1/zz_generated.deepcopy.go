@@ -24,9 +24,38 @@ package v1
 import (
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionTokenRequest) DeepCopyInto(out *ConnectionTokenRequest) {
+	*out = *in
+	if in.PlayerIDs != nil {
+		in, out := &in.PlayerIDs, &out.PlayerIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Claims != nil {
+		in, out := &in.Claims, &out.Claims
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionTokenRequest.
+func (in *ConnectionTokenRequest) DeepCopy() *ConnectionTokenRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionTokenRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CounterAction) DeepCopyInto(out *CounterAction) {
 	*out = *in
@@ -139,6 +168,16 @@ func (in *GameServerAllocationList) DeepCopyObject() runtime.Object {
 func (in *GameServerAllocationSpec) DeepCopyInto(out *GameServerAllocationSpec) {
 	*out = *in
 	in.MultiClusterSetting.DeepCopyInto(&out.MultiClusterSetting)
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Required.DeepCopyInto(&out.Required)
 	if in.Preferred != nil {
 		in, out := &in.Preferred, &out.Preferred
@@ -160,6 +199,18 @@ func (in *GameServerAllocationSpec) DeepCopyInto(out *GameServerAllocationSpec)
 		}
 	}
 	in.MetaPatch.DeepCopyInto(&out.MetaPatch)
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.TemplateParameters != nil {
+		in, out := &in.TemplateParameters, &out.TemplateParameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Counters != nil {
 		in, out := &in.Counters, &out.Counters
 		*out = make(map[string]CounterAction, len(*in))
@@ -174,6 +225,16 @@ func (in *GameServerAllocationSpec) DeepCopyInto(out *GameServerAllocationSpec)
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Scoring != nil {
+		in, out := &in.Scoring, &out.Scoring
+		*out = new(WasmScoring)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionToken != nil {
+		in, out := &in.ConnectionToken, &out.ConnectionToken
+		*out = new(ConnectionTokenRequest)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -232,6 +293,113 @@ func (in *GameServerAllocationStatus) DeepCopy() *GameServerAllocationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerCapacityQuery) DeepCopyInto(out *GameServerCapacityQuery) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerCapacityQuery.
+func (in *GameServerCapacityQuery) DeepCopy() *GameServerCapacityQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerCapacityQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GameServerCapacityQuery) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerCapacityQueryList) DeepCopyInto(out *GameServerCapacityQueryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GameServerCapacityQuery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerCapacityQueryList.
+func (in *GameServerCapacityQueryList) DeepCopy() *GameServerCapacityQueryList {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerCapacityQueryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GameServerCapacityQueryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerCapacityQuerySpec) DeepCopyInto(out *GameServerCapacityQuerySpec) {
+	*out = *in
+	if in.Selectors != nil {
+		in, out := &in.Selectors, &out.Selectors
+		*out = make([]GameServerSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerCapacityQuerySpec.
+func (in *GameServerCapacityQuerySpec) DeepCopy() *GameServerCapacityQuerySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerCapacityQuerySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerCapacityQueryStatus) DeepCopyInto(out *GameServerCapacityQueryStatus) {
+	*out = *in
+	if in.Selectors != nil {
+		in, out := &in.Selectors, &out.Selectors
+		*out = make([]SelectorCapacity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerCapacityQueryStatus.
+func (in *GameServerCapacityQueryStatus) DeepCopy() *GameServerCapacityQueryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerCapacityQueryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GameServerMetadata) DeepCopyInto(out *GameServerMetadata) {
 	*out = *in
@@ -262,6 +430,128 @@ func (in *GameServerMetadata) DeepCopy() *GameServerMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerScoringCandidate) DeepCopyInto(out *GameServerScoringCandidate) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.CreationTimestamp.DeepCopyInto(&out.CreationTimestamp)
+	if in.Counters != nil {
+		in, out := &in.Counters, &out.Counters
+		*out = make(map[string]agonesv1.CounterStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Lists != nil {
+		in, out := &in.Lists, &out.Lists
+		*out = make(map[string]agonesv1.ListStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerScoringCandidate.
+func (in *GameServerScoringCandidate) DeepCopy() *GameServerScoringCandidate {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerScoringCandidate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerScoringRequest) DeepCopyInto(out *GameServerScoringRequest) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.GameServers != nil {
+		in, out := &in.GameServers, &out.GameServers
+		*out = make([]GameServerScoringCandidate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerScoringRequest.
+func (in *GameServerScoringRequest) DeepCopy() *GameServerScoringRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerScoringRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerScoringResponse) DeepCopyInto(out *GameServerScoringResponse) {
+	*out = *in
+	if in.Scores != nil {
+		in, out := &in.Scores, &out.Scores
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerScoringResponse.
+func (in *GameServerScoringResponse) DeepCopy() *GameServerScoringResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerScoringResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerScoringReview) DeepCopyInto(out *GameServerScoringReview) {
+	*out = *in
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(GameServerScoringRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Response != nil {
+		in, out := &in.Response, &out.Response
+		*out = new(GameServerScoringResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerScoringReview.
+func (in *GameServerScoringReview) DeepCopy() *GameServerScoringReview {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerScoringReview)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GameServerSelector) DeepCopyInto(out *GameServerSelector) {
 	*out = *in
@@ -412,3 +702,56 @@ func (in *PlayerSelector) DeepCopy() *PlayerSelector {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectorCapacity) DeepCopyInto(out *SelectorCapacity) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorCapacity.
+func (in *SelectorCapacity) DeepCopy() *SelectorCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectorCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WasmScoring) DeepCopyInto(out *WasmScoring) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WasmScoring.
+func (in *WasmScoring) DeepCopy() *WasmScoring {
+	if in == nil {
+		return nil
+	}
+	out := new(WasmScoring)
+	in.DeepCopyInto(out)
+	return out
+}
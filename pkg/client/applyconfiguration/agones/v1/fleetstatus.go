@@ -28,6 +28,8 @@ type FleetStatusApplyConfiguration struct {
 	Players           *AggregatedPlayerStatusApplyConfiguration            `json:"players,omitempty"`
 	Counters          map[string]AggregatedCounterStatusApplyConfiguration `json:"counters,omitempty"`
 	Lists             map[string]AggregatedListStatusApplyConfiguration    `json:"lists,omitempty"`
+	WarmingReplicas   *int32                                               `json:"warmingReplicas,omitempty"`
+	Conditions        []GameServerSetConditionApplyConfiguration           `json:"conditions,omitempty"`
 }
 
 // FleetStatusApplyConfiguration constructs a declarative configuration of the FleetStatus type for use with
@@ -103,3 +105,24 @@ func (b *FleetStatusApplyConfiguration) WithLists(entries map[string]AggregatedL
 	}
 	return b
 }
+
+// WithWarmingReplicas sets the WarmingReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WarmingReplicas field is set to the value of the last call.
+func (b *FleetStatusApplyConfiguration) WithWarmingReplicas(value int32) *FleetStatusApplyConfiguration {
+	b.WarmingReplicas = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *FleetStatusApplyConfiguration) WithConditions(values ...*GameServerSetConditionApplyConfiguration) *FleetStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
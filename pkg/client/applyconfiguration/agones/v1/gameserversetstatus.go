@@ -29,6 +29,8 @@ type GameServerSetStatusApplyConfiguration struct {
 	Players           *AggregatedPlayerStatusApplyConfiguration            `json:"players,omitempty"`
 	Counters          map[string]AggregatedCounterStatusApplyConfiguration `json:"counters,omitempty"`
 	Lists             map[string]AggregatedListStatusApplyConfiguration    `json:"lists,omitempty"`
+	WarmingReplicas   *int32                                               `json:"warmingReplicas,omitempty"`
+	Conditions        []GameServerSetConditionApplyConfiguration           `json:"conditions,omitempty"`
 }
 
 // GameServerSetStatusApplyConfiguration constructs a declarative configuration of the GameServerSetStatus type for use with
@@ -112,3 +114,24 @@ func (b *GameServerSetStatusApplyConfiguration) WithLists(entries map[string]Agg
 	}
 	return b
 }
+
+// WithWarmingReplicas sets the WarmingReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WarmingReplicas field is set to the value of the last call.
+func (b *GameServerSetStatusApplyConfiguration) WithWarmingReplicas(value int32) *GameServerSetStatusApplyConfiguration {
+	b.WarmingReplicas = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *GameServerSetStatusApplyConfiguration) WithConditions(values ...*GameServerSetConditionApplyConfiguration) *GameServerSetStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
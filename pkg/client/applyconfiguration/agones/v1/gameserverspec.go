@@ -26,16 +26,21 @@ import (
 // GameServerSpecApplyConfiguration represents a declarative configuration of the GameServerSpec type for use
 // with apply.
 type GameServerSpecApplyConfiguration struct {
-	Container  *string                                    `json:"container,omitempty"`
-	Ports      []GameServerPortApplyConfiguration         `json:"ports,omitempty"`
-	Health     *HealthApplyConfiguration                  `json:"health,omitempty"`
-	Scheduling *apis.SchedulingStrategy                   `json:"scheduling,omitempty"`
-	SdkServer  *SdkServerApplyConfiguration               `json:"sdkServer,omitempty"`
-	Template   *corev1.PodTemplateSpec                    `json:"template,omitempty"`
-	Players    *PlayersSpecApplyConfiguration             `json:"players,omitempty"`
-	Counters   map[string]CounterStatusApplyConfiguration `json:"counters,omitempty"`
-	Lists      map[string]ListStatusApplyConfiguration    `json:"lists,omitempty"`
-	Eviction   *EvictionApplyConfiguration                `json:"eviction,omitempty"`
+	Container       *string                                     `json:"container,omitempty"`
+	Ports           []GameServerPortApplyConfiguration          `json:"ports,omitempty"`
+	Health          *HealthApplyConfiguration                   `json:"health,omitempty"`
+	Scheduling      *apis.SchedulingStrategy                    `json:"scheduling,omitempty"`
+	SdkServer       *SdkServerApplyConfiguration                `json:"sdkServer,omitempty"`
+	Template        *corev1.PodTemplateSpec                     `json:"template,omitempty"`
+	Players         *PlayersSpecApplyConfiguration              `json:"players,omitempty"`
+	Counters        map[string]CounterStatusApplyConfiguration  `json:"counters,omitempty"`
+	Lists           map[string]ListStatusApplyConfiguration     `json:"lists,omitempty"`
+	Eviction        *EvictionApplyConfiguration                 `json:"eviction,omitempty"`
+	MinReadySeconds *int32                                      `json:"minReadySeconds,omitempty"`
+	ReadinessGates  []GameServerReadinessGateApplyConfiguration `json:"readinessGates,omitempty"`
+	Lifetime        *LifetimeApplyConfiguration                 `json:"lifetime,omitempty"`
+	Idle            *IdleApplyConfiguration                     `json:"idle,omitempty"`
+	PostMortem      *PostMortemApplyConfiguration               `json:"postMortem,omitempty"`
 }
 
 // GameServerSpecApplyConfiguration constructs a declarative configuration of the GameServerSpec type for use with
@@ -140,3 +145,48 @@ func (b *GameServerSpecApplyConfiguration) WithEviction(value *EvictionApplyConf
 	b.Eviction = value
 	return b
 }
+
+// WithMinReadySeconds sets the MinReadySeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReadySeconds field is set to the value of the last call.
+func (b *GameServerSpecApplyConfiguration) WithMinReadySeconds(value int32) *GameServerSpecApplyConfiguration {
+	b.MinReadySeconds = &value
+	return b
+}
+
+// WithReadinessGates adds the given value to the ReadinessGates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ReadinessGates field.
+func (b *GameServerSpecApplyConfiguration) WithReadinessGates(values ...*GameServerReadinessGateApplyConfiguration) *GameServerSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithReadinessGates")
+		}
+		b.ReadinessGates = append(b.ReadinessGates, *values[i])
+	}
+	return b
+}
+
+// WithLifetime sets the Lifetime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Lifetime field is set to the value of the last call.
+func (b *GameServerSpecApplyConfiguration) WithLifetime(value *LifetimeApplyConfiguration) *GameServerSpecApplyConfiguration {
+	b.Lifetime = value
+	return b
+}
+
+// WithIdle sets the Idle field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Idle field is set to the value of the last call.
+func (b *GameServerSpecApplyConfiguration) WithIdle(value *IdleApplyConfiguration) *GameServerSpecApplyConfiguration {
+	b.Idle = value
+	return b
+}
+
+// WithPostMortem sets the PostMortem field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PostMortem field is set to the value of the last call.
+func (b *GameServerSpecApplyConfiguration) WithPostMortem(value *PostMortemApplyConfiguration) *GameServerSpecApplyConfiguration {
+	b.PostMortem = value
+	return b
+}
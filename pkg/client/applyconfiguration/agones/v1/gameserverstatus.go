@@ -27,16 +27,22 @@ import (
 // GameServerStatusApplyConfiguration represents a declarative configuration of the GameServerStatus type for use
 // with apply.
 type GameServerStatusApplyConfiguration struct {
-	State         *agonesv1.GameServerState                  `json:"state,omitempty"`
-	Ports         []GameServerStatusPortApplyConfiguration   `json:"ports,omitempty"`
-	Address       *string                                    `json:"address,omitempty"`
-	Addresses     []corev1.NodeAddress                       `json:"addresses,omitempty"`
-	NodeName      *string                                    `json:"nodeName,omitempty"`
-	ReservedUntil *metav1.Time                               `json:"reservedUntil,omitempty"`
-	Players       *PlayerStatusApplyConfiguration            `json:"players,omitempty"`
-	Counters      map[string]CounterStatusApplyConfiguration `json:"counters,omitempty"`
-	Lists         map[string]ListStatusApplyConfiguration    `json:"lists,omitempty"`
-	Eviction      *EvictionApplyConfiguration                `json:"eviction,omitempty"`
+	State             *agonesv1.GameServerState                     `json:"state,omitempty"`
+	Ports             []GameServerStatusPortApplyConfiguration      `json:"ports,omitempty"`
+	Address           *string                                       `json:"address,omitempty"`
+	Addresses         []corev1.NodeAddress                          `json:"addresses,omitempty"`
+	NodeName          *string                                       `json:"nodeName,omitempty"`
+	ReservedUntil     *metav1.Time                                  `json:"reservedUntil,omitempty"`
+	Players           *PlayerStatusApplyConfiguration               `json:"players,omitempty"`
+	Counters          map[string]CounterStatusApplyConfiguration    `json:"counters,omitempty"`
+	Lists             map[string]ListStatusApplyConfiguration       `json:"lists,omitempty"`
+	Eviction          *EvictionApplyConfiguration                   `json:"eviction,omitempty"`
+	AllocationPayload []byte                                        `json:"allocationPayload,omitempty"`
+	ReadyTime         *metav1.Time                                  `json:"readyTime,omitempty"`
+	Conditions        []GameServerConditionApplyConfiguration       `json:"conditions,omitempty"`
+	IdleSince         *metav1.Time                                  `json:"idleSince,omitempty"`
+	Health            *GameServerHealthApplyConfiguration           `json:"health,omitempty"`
+	StateHistory      []GameServerStateTransitionApplyConfiguration `json:"stateHistory,omitempty"`
 }
 
 // GameServerStatusApplyConfiguration constructs a declarative configuration of the GameServerStatus type for use with
@@ -143,3 +149,63 @@ func (b *GameServerStatusApplyConfiguration) WithEviction(value *EvictionApplyCo
 	b.Eviction = value
 	return b
 }
+
+// WithAllocationPayload adds the given value to the AllocationPayload field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllocationPayload field.
+func (b *GameServerStatusApplyConfiguration) WithAllocationPayload(values ...byte) *GameServerStatusApplyConfiguration {
+	for i := range values {
+		b.AllocationPayload = append(b.AllocationPayload, values[i])
+	}
+	return b
+}
+
+// WithReadyTime sets the ReadyTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyTime field is set to the value of the last call.
+func (b *GameServerStatusApplyConfiguration) WithReadyTime(value metav1.Time) *GameServerStatusApplyConfiguration {
+	b.ReadyTime = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *GameServerStatusApplyConfiguration) WithConditions(values ...*GameServerConditionApplyConfiguration) *GameServerStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithIdleSince sets the IdleSince field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IdleSince field is set to the value of the last call.
+func (b *GameServerStatusApplyConfiguration) WithIdleSince(value metav1.Time) *GameServerStatusApplyConfiguration {
+	b.IdleSince = &value
+	return b
+}
+
+// WithHealth sets the Health field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Health field is set to the value of the last call.
+func (b *GameServerStatusApplyConfiguration) WithHealth(value *GameServerHealthApplyConfiguration) *GameServerStatusApplyConfiguration {
+	b.Health = value
+	return b
+}
+
+// WithStateHistory adds the given value to the StateHistory field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the StateHistory field.
+func (b *GameServerStatusApplyConfiguration) WithStateHistory(values ...*GameServerStateTransitionApplyConfiguration) *GameServerStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithStateHistory")
+		}
+		b.StateHistory = append(b.StateHistory, *values[i])
+	}
+	return b
+}
@@ -21,10 +21,11 @@ package v1
 // HealthApplyConfiguration represents a declarative configuration of the Health type for use
 // with apply.
 type HealthApplyConfiguration struct {
-	Disabled            *bool  `json:"disabled,omitempty"`
-	PeriodSeconds       *int32 `json:"periodSeconds,omitempty"`
-	FailureThreshold    *int32 `json:"failureThreshold,omitempty"`
-	InitialDelaySeconds *int32 `json:"initialDelaySeconds,omitempty"`
+	Disabled            *bool                          `json:"disabled,omitempty"`
+	PeriodSeconds       *int32                         `json:"periodSeconds,omitempty"`
+	FailureThreshold    *int32                         `json:"failureThreshold,omitempty"`
+	InitialDelaySeconds *int32                         `json:"initialDelaySeconds,omitempty"`
+	Probe               *HealthProbeApplyConfiguration `json:"probe,omitempty"`
 }
 
 // HealthApplyConfiguration constructs a declarative configuration of the Health type for use with
@@ -64,3 +65,11 @@ func (b *HealthApplyConfiguration) WithInitialDelaySeconds(value int32) *HealthA
 	b.InitialDelaySeconds = &value
 	return b
 }
+
+// WithProbe sets the Probe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Probe field is set to the value of the last call.
+func (b *HealthApplyConfiguration) WithProbe(value *HealthProbeApplyConfiguration) *HealthApplyConfiguration {
+	b.Probe = value
+	return b
+}
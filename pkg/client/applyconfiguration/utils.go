@@ -54,36 +54,62 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &agonesv1.FleetSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("FleetStatus"):
 		return &agonesv1.FleetStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GRPCHealthProbe"):
+		return &agonesv1.GRPCHealthProbeApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GameServer"):
 		return &agonesv1.GameServerApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GameServerCondition"):
+		return &agonesv1.GameServerConditionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GameServerHealth"):
+		return &agonesv1.GameServerHealthApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GameServerPort"):
 		return &agonesv1.GameServerPortApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GameServerReadinessGate"):
+		return &agonesv1.GameServerReadinessGateApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GameServerSet"):
 		return &agonesv1.GameServerSetApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GameServerSetCondition"):
+		return &agonesv1.GameServerSetConditionApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GameServerSetSpec"):
 		return &agonesv1.GameServerSetSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GameServerSetStatus"):
 		return &agonesv1.GameServerSetStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GameServerSpec"):
 		return &agonesv1.GameServerSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GameServerStateTransition"):
+		return &agonesv1.GameServerStateTransitionApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GameServerStatus"):
 		return &agonesv1.GameServerStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GameServerStatusPort"):
 		return &agonesv1.GameServerStatusPortApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GameServerTemplateSpec"):
 		return &agonesv1.GameServerTemplateSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("HTTPHealthProbe"):
+		return &agonesv1.HTTPHealthProbeApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Health"):
 		return &agonesv1.HealthApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("HealthProbe"):
+		return &agonesv1.HealthProbeApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Idle"):
+		return &agonesv1.IdleApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Lifetime"):
+		return &agonesv1.LifetimeApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ListStatus"):
 		return &agonesv1.ListStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PlayersSpec"):
 		return &agonesv1.PlayersSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PlayerStatus"):
 		return &agonesv1.PlayerStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PostMortem"):
+		return &agonesv1.PostMortemApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Priority"):
 		return &agonesv1.PriorityApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SdkServer"):
 		return &agonesv1.SdkServerApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("TCPHealthProbe"):
+		return &agonesv1.TCPHealthProbeApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("UDPHealthProbe"):
+		return &agonesv1.UDPHealthProbeApplyConfiguration{}
 
 		// Group=autoscaling.agones.dev, Version=v1
 	case autoscalingv1.SchemeGroupVersion.WithKind("ActivePeriod"):
@@ -29,6 +29,7 @@ import (
 type AllocationV1Interface interface {
 	RESTClient() rest.Interface
 	GameServerAllocationsGetter
+	GameServerCapacityQueriesGetter
 }
 
 // AllocationV1Client is used to interact with features provided by the allocation.agones.dev group.
@@ -40,6 +41,10 @@ func (c *AllocationV1Client) GameServerAllocations(namespace string) GameServerA
 	return newGameServerAllocations(c, namespace)
 }
 
+func (c *AllocationV1Client) GameServerCapacityQueries(namespace string) GameServerCapacityQueryInterface {
+	return newGameServerCapacityQueries(c, namespace)
+}
+
 // NewForConfig creates a new AllocationV1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -32,6 +32,10 @@ func (c *FakeAllocationV1) GameServerAllocations(namespace string) v1.GameServer
 	return newFakeGameServerAllocations(c, namespace)
 }
 
+func (c *FakeAllocationV1) GameServerCapacityQueries(namespace string) v1.GameServerCapacityQueryInterface {
+	return newFakeGameServerCapacityQueries(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeAllocationV1) RESTClient() rest.Interface {
@@ -19,3 +19,5 @@
 package v1
 
 type GameServerAllocationExpansion interface{}
+
+type GameServerCapacityQueryExpansion interface{}
@@ -358,24 +358,21 @@ func applyWebhookPolicy(state *fasState, w *autoscalingv1.URLConfiguration, f *a
 }
 
 func applyBufferPolicy(_ *fasState, b *autoscalingv1.BufferPolicy, f *agonesv1.Fleet, fasLog *FasLogger) (int32, bool, error) {
-	var replicas int32
+	replicas, err := bufferReplicas(b, f.Status.AllocatedReplicas)
+	if err != nil {
+		return 0, false, err
+	}
 
-	if b.BufferSize.Type == intstr.Int {
-		replicas = f.Status.AllocatedReplicas + int32(b.BufferSize.IntValue())
-	} else {
-		// the percentage value is a little more complex, as we can't apply
-		// the desired percentage to any current value, but to the future one
-		// Example: we have 8 allocated replicas, 10 total replicas and bufferSize set to 30%.
-		// 30% means that we must have 30% ready instances in the fleet
-		// Right now there are 20%, so we must increase the fleet until we reach 30%
-		// To compute the new size, we start from the other end: if ready must be 30%
-		// it means that allocated must be 70% and adjust the fleet size to make that true.
-		bufferPercent, err := intstr.GetValueFromIntOrPercent(&b.BufferSize, 100, true)
+	// GameServers that are warming up are Ready, but can't be allocated yet. Don't scale in so far that
+	// the buffer would be short of allocatable GameServers once they have been deleted.
+	if f.Status.WarmingReplicas > 0 && replicas < f.Status.Replicas {
+		allocatable, err := bufferReplicas(b, f.Status.AllocatedReplicas+f.Status.WarmingReplicas)
 		if err != nil {
 			return 0, false, err
 		}
-		// use Math.Ceil to round the result up
-		replicas = int32(math.Ceil(float64(f.Status.AllocatedReplicas*100) / float64(100-bufferPercent)))
+		if allocatable > replicas {
+			replicas = min(allocatable, f.Status.Replicas)
+		}
 	}
 
 	scalingInLimited := false
@@ -396,6 +393,28 @@ func applyBufferPolicy(_ *fasState, b *autoscalingv1.BufferPolicy, f *agonesv1.F
 	return replicas, scalingInLimited || scalingOutLimited, nil
 }
 
+// bufferReplicas returns the number of replicas that leaves the buffer of the BufferPolicy on top of
+// the given number of unavailable replicas.
+func bufferReplicas(b *autoscalingv1.BufferPolicy, unavailable int32) (int32, error) {
+	if b.BufferSize.Type == intstr.Int {
+		return unavailable + int32(b.BufferSize.IntValue()), nil
+	}
+
+	// the percentage value is a little more complex, as we can't apply
+	// the desired percentage to any current value, but to the future one
+	// Example: we have 8 allocated replicas, 10 total replicas and bufferSize set to 30%.
+	// 30% means that we must have 30% ready instances in the fleet
+	// Right now there are 20%, so we must increase the fleet until we reach 30%
+	// To compute the new size, we start from the other end: if ready must be 30%
+	// it means that allocated must be 70% and adjust the fleet size to make that true.
+	bufferPercent, err := intstr.GetValueFromIntOrPercent(&b.BufferSize, 100, true)
+	if err != nil {
+		return 0, err
+	}
+	// use Math.Ceil to round the result up
+	return int32(math.Ceil(float64(unavailable*100) / float64(100-bufferPercent))), nil
+}
+
 // New function to call applyCounterOrListPolicy
 func applyCounterOrListPolicyWrapper(_ *fasState, c *autoscalingv1.CounterPolicy, l *autoscalingv1.ListPolicy,
 	f *agonesv1.Fleet, gameServerNamespacedLister listeragonesv1.GameServerNamespaceLister,
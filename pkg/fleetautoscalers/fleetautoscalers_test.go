@@ -240,6 +240,7 @@ func TestApplyBufferPolicy(t *testing.T) {
 		statusReplicas          int32
 		statusAllocatedReplicas int32
 		statusReadyReplicas     int32
+		statusWarmingReplicas   int32
 		buffer                  *autoscalingv1.BufferPolicy
 		expected                expected
 	}{
@@ -327,6 +328,74 @@ func TestApplyBufferPolicy(t *testing.T) {
 				err:      "",
 			},
 		},
+		{
+			description:             "Warming replicas limit scale in",
+			specReplicas:            60,
+			statusReplicas:          60,
+			statusAllocatedReplicas: 30,
+			statusReadyReplicas:     30,
+			statusWarmingReplicas:   5,
+			buffer: &autoscalingv1.BufferPolicy{
+				BufferSize:  intstr.FromInt(20),
+				MaxReplicas: 100,
+			},
+			expected: expected{
+				replicas: 55,
+				limited:  false,
+				err:      "",
+			},
+		},
+		{
+			description:             "Warming replicas do not scale out",
+			specReplicas:            52,
+			statusReplicas:          52,
+			statusAllocatedReplicas: 30,
+			statusReadyReplicas:     22,
+			statusWarmingReplicas:   5,
+			buffer: &autoscalingv1.BufferPolicy{
+				BufferSize:  intstr.FromInt(20),
+				MaxReplicas: 100,
+			},
+			expected: expected{
+				replicas: 52,
+				limited:  false,
+				err:      "",
+			},
+		},
+		{
+			description:             "Warming replicas do not cause scale up",
+			specReplicas:            40,
+			statusReplicas:          40,
+			statusAllocatedReplicas: 30,
+			statusReadyReplicas:     10,
+			statusWarmingReplicas:   5,
+			buffer: &autoscalingv1.BufferPolicy{
+				BufferSize:  intstr.FromInt(20),
+				MaxReplicas: 100,
+			},
+			expected: expected{
+				replicas: 50,
+				limited:  false,
+				err:      "",
+			},
+		},
+		{
+			description:             "Warming replicas limit scale in, percentage",
+			specReplicas:            20,
+			statusReplicas:          20,
+			statusAllocatedReplicas: 8,
+			statusReadyReplicas:     12,
+			statusWarmingReplicas:   2,
+			buffer: &autoscalingv1.BufferPolicy{
+				BufferSize:  intstr.FromString("50%"),
+				MaxReplicas: 100,
+			},
+			expected: expected{
+				replicas: 20,
+				limited:  false,
+				err:      "",
+			},
+		},
 		{
 			description:             "FromString buffer size is invalid, err received",
 			specReplicas:            1,
@@ -352,6 +421,7 @@ func TestApplyBufferPolicy(t *testing.T) {
 			f.Status.Replicas = tc.statusReplicas
 			f.Status.AllocatedReplicas = tc.statusAllocatedReplicas
 			f.Status.ReadyReplicas = tc.statusReadyReplicas
+			f.Status.WarmingReplicas = tc.statusWarmingReplicas
 
 			m := agtesting.NewMocks()
 			fasLog := FasLogger{
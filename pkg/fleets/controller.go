@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	"agones.dev/agones/pkg/apis/agones"
@@ -302,6 +303,12 @@ func (c *Controller) syncFleet(ctx context.Context, key string) error {
 	if active == nil {
 		loggerForFleet(fleet, c.baseLogger).Debug("could not find active GameServerSet, creating")
 		active = fleet.GameServerSet()
+		if runtime.FeatureEnabled(runtime.FeatureLifecyclePriorities) {
+			if active.ObjectMeta.Annotations == nil {
+				active.ObjectMeta.Annotations = make(map[string]string, 1)
+			}
+			active.ObjectMeta.Annotations[agonesv1.FleetRevisionAnnotation] = strconv.FormatInt(nextRevision(rest), 10)
+		}
 	}
 
 	replicas, err := c.applyDeploymentStrategy(ctx, fleet, active, rest)
@@ -453,6 +460,14 @@ func (c *Controller) recreateDeployment(ctx context.Context, fleet *agonesv1.Fle
 // through to the new ones, based on the fleet.Spec.Strategy.RollingUpdate configuration
 // and return the replica count for the active GameServerSet
 func (c *Controller) rollingUpdateDeployment(ctx context.Context, fleet *agonesv1.Fleet, active *agonesv1.GameServerSet, rest []*agonesv1.GameServerSet) (int32, error) {
+	// if the GameServers of the new GameServerSet keep failing before Ready, such as with a bad build,
+	// halt the rolling update, so the GameServers of the previous GameServerSets are not replaced by failing ones.
+	if runtime.FeatureEnabled(runtime.FeatureCrashLoopBackoff) && active.IsCrashLooping() {
+		c.recorder.Eventf(fleet, corev1.EventTypeWarning, "RollingUpdateHalted",
+			"Halting rolling update, as GameServerSet %s is in CrashLoopBackOff", active.ObjectMeta.Name)
+		return active.Spec.Replicas, nil
+	}
+
 	replicas, err := c.rollingUpdateActive(fleet, active, rest)
 	if err != nil {
 		return 0, err
@@ -688,6 +703,7 @@ func (c *Controller) updateFleetStatus(ctx context.Context, fleet *agonesv1.Flee
 	fCopy.Status.ReadyReplicas = 0
 	fCopy.Status.ReservedReplicas = 0
 	fCopy.Status.AllocatedReplicas = 0
+	fCopy.Status.WarmingReplicas = 0
 	if runtime.FeatureEnabled(runtime.FeatureCountsAndLists) {
 		fCopy.Status.Counters = make(map[string]agonesv1.AggregatedCounterStatus)
 		fCopy.Status.Lists = make(map[string]agonesv1.AggregatedListStatus)
@@ -705,11 +721,15 @@ func (c *Controller) updateFleetStatus(ctx context.Context, fleet *agonesv1.Flee
 		fCopy.Status.ReadyReplicas += gsSet.Status.ReadyReplicas
 		fCopy.Status.ReservedReplicas += gsSet.Status.ReservedReplicas
 		fCopy.Status.AllocatedReplicas += gsSet.Status.AllocatedReplicas
+		fCopy.Status.WarmingReplicas += gsSet.Status.WarmingReplicas
 		if runtime.FeatureEnabled(runtime.FeatureCountsAndLists) {
 			fCopy.Status.Counters = mergeCounters(fCopy.Status.Counters, gsSet.Status.Counters)
 			fCopy.Status.Lists = mergeLists(fCopy.Status.Lists, gsSet.Status.Lists)
 		}
 	}
+	if runtime.FeatureEnabled(runtime.FeatureCrashLoopBackoff) {
+		setCrashLoopCondition(&fCopy.Status, list)
+	}
 	if runtime.FeatureEnabled(runtime.FeaturePlayerTracking) {
 		// to make this code simpler, while the feature gate is in place,
 		// we will loop around the gsSet list twice.
@@ -727,6 +747,23 @@ func (c *Controller) updateFleetStatus(ctx context.Context, fleet *agonesv1.Flee
 	return errors.Wrapf(err, "error updating status of fleet %s", fCopy.ObjectMeta.Name)
 }
 
+// setCrashLoopCondition sets the CrashLoopBackOff condition of the Fleet status to whether any of its
+// GameServerSets is crash looping. The condition is only added once it is True.
+func setCrashLoopCondition(status *agonesv1.FleetStatus, list []*agonesv1.GameServerSet) {
+	now := metav1.Now()
+	for _, gsSet := range list {
+		if gsSet.IsCrashLooping() {
+			c := gsSet.Status.Condition(agonesv1.GameServerSetCrashLoopBackOffCondition)
+			status.SetCondition(agonesv1.GameServerSetCrashLoopBackOffCondition, corev1.ConditionTrue,
+				fmt.Sprintf("GameServerSet %s: %s", gsSet.ObjectMeta.Name, c.Message), now)
+			return
+		}
+	}
+	if status.Condition(agonesv1.GameServerSetCrashLoopBackOffCondition) != nil {
+		status.SetCondition(agonesv1.GameServerSetCrashLoopBackOffCondition, corev1.ConditionFalse, "", now)
+	}
+}
+
 // filterGameServerSetByActive returns the active GameServerSet (or nil if it
 // doesn't exist) and then the rest of the GameServerSets that are controlled
 // by this Fleet
@@ -745,6 +782,18 @@ func (c *Controller) filterGameServerSetByActive(fleet *agonesv1.Fleet, list []*
 	return active, rest
 }
 
+// nextRevision returns the revision for a new GameServerSet of a Fleet: one more than the highest
+// revision of its other GameServerSets.
+func nextRevision(list []*agonesv1.GameServerSet) int64 {
+	var revision int64
+	for _, gsSet := range list {
+		if r, err := strconv.ParseInt(gsSet.ObjectMeta.Annotations[agonesv1.FleetRevisionAnnotation], 10, 64); err == nil && r > revision {
+			revision = r
+		}
+	}
+	return revision + 1
+}
+
 // mergeCounters adds the contents of AggregatedCounterStatus c2 into c1.
 func mergeCounters(c1, c2 map[string]agonesv1.AggregatedCounterStatus) map[string]agonesv1.AggregatedCounterStatus {
 	if c1 == nil {
@@ -266,6 +266,45 @@ func TestControllerSyncFleet(t *testing.T) {
 		agtesting.AssertEventContains(t, m.FakeRecorder.Events, "CreatingGameServerSet")
 	})
 
+	t.Run("new gameserverset gets the next revision", func(t *testing.T) {
+		utilruntime.FeatureTestMutex.Lock()
+		defer utilruntime.FeatureTestMutex.Unlock()
+		require.NoError(t, utilruntime.ParseFeatures(string(utilruntime.FeatureLifecyclePriorities)+"=true"))
+
+		f := defaultFixture()
+		f.Spec.Template.Spec.Ports = []agonesv1.GameServerPort{{HostPort: 5555}}
+		c, m := newFakeController()
+		gsSet := f.GameServerSet()
+		gsSet.ObjectMeta.Name = "gsSet1"
+		gsSet.ObjectMeta.UID = "4321"
+		gsSet.ObjectMeta.Annotations = map[string]string{agonesv1.FleetRevisionAnnotation: "4"}
+		gsSet.Spec.Template.Spec.Ports = []agonesv1.GameServerPort{{HostPort: 7777}}
+		gsSet.Spec.Replicas = f.Spec.Replicas
+		created := false
+
+		m.AgonesClient.AddReactor("list", "fleets", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.FleetList{Items: []agonesv1.Fleet{*f}}, nil
+		})
+		m.AgonesClient.AddReactor("list", "gameserversets", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerSetList{Items: []agonesv1.GameServerSet{*gsSet}}, nil
+		})
+		m.AgonesClient.AddReactor("create", "gameserversets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			created = true
+			gsSet := action.(k8stesting.CreateAction).GetObject().(*agonesv1.GameServerSet)
+			assert.Equal(t, "5", gsSet.ObjectMeta.Annotations[agonesv1.FleetRevisionAnnotation])
+			return true, gsSet, nil
+		})
+		m.AgonesClient.AddReactor("update", "gameserversets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, action.(k8stesting.UpdateAction).GetObject(), nil
+		})
+
+		ctx, cancel := agtesting.StartInformers(m, c.fleetSynced, c.gameServerSetSynced)
+		defer cancel()
+
+		require.NoError(t, c.syncFleet(ctx, "default/fleet-1"))
+		assert.True(t, created, "gameserverset should have been created")
+	})
+
 	t.Run("fleet marked for deletion shouldn't take any action on gameserver sets", func(t *testing.T) {
 		f := defaultFixture()
 		f.Spec.Strategy.Type = appsv1.RollingUpdateDeploymentStrategyType
@@ -587,6 +626,7 @@ func TestControllerUpdateFleetStatus(t *testing.T) {
 		gsSet1.Status.ReadyReplicas = 2
 		gsSet1.Status.ReservedReplicas = 4
 		gsSet1.Status.AllocatedReplicas = 1
+		gsSet1.Status.WarmingReplicas = 2
 
 		gsSet2 := fleet.GameServerSet()
 		// nolint:goconst
@@ -595,6 +635,7 @@ func TestControllerUpdateFleetStatus(t *testing.T) {
 		gsSet2.Status.ReadyReplicas = 5
 		gsSet2.Status.ReservedReplicas = 3
 		gsSet2.Status.AllocatedReplicas = 2
+		gsSet2.Status.WarmingReplicas = 1
 
 		m.AgonesClient.AddReactor("list", "gameserversets",
 			func(_ k8stesting.Action) (bool, runtime.Object, error) {
@@ -612,6 +653,7 @@ func TestControllerUpdateFleetStatus(t *testing.T) {
 				assert.Equal(t, gsSet1.Status.ReadyReplicas+gsSet2.Status.ReadyReplicas, fleet.Status.ReadyReplicas)
 				assert.Equal(t, gsSet1.Status.ReservedReplicas+gsSet2.Status.ReservedReplicas, fleet.Status.ReservedReplicas)
 				assert.Equal(t, gsSet1.Status.AllocatedReplicas+gsSet2.Status.AllocatedReplicas, fleet.Status.AllocatedReplicas)
+				assert.Equal(t, gsSet1.Status.WarmingReplicas+gsSet2.Status.WarmingReplicas, fleet.Status.WarmingReplicas)
 				return true, fleet, nil
 			})
 
@@ -696,6 +738,65 @@ func TestControllerUpdateFleetPlayerStatus(t *testing.T) {
 	assert.True(t, updated)
 }
 
+func TestControllerUpdateFleetCrashLoopStatus(t *testing.T) {
+	t.Parallel()
+
+	utilruntime.FeatureTestMutex.Lock()
+	defer utilruntime.FeatureTestMutex.Unlock()
+
+	require.NoError(t, utilruntime.ParseFeatures(string(utilruntime.FeatureCrashLoopBackoff)+"=true"))
+
+	fleet := defaultFixture()
+	c, m := newFakeController()
+
+	gsSet1 := fleet.GameServerSet()
+	gsSet1.ObjectMeta.Name = "gsSet1"
+	gsSet1.Status.SetCondition(agonesv1.GameServerSetCrashLoopBackOffCondition, corev1.ConditionFalse, "", metav1.Now())
+
+	gsSet2 := fleet.GameServerSet()
+	gsSet2.ObjectMeta.Name = "gsSet2"
+	gsSet2.Status.SetCondition(agonesv1.GameServerSetCrashLoopBackOffCondition, corev1.ConditionTrue, "3 GameServers failed before Ready in a row", metav1.Now())
+
+	gsSets := []agonesv1.GameServerSet{*gsSet1, *gsSet2}
+	m.AgonesClient.AddReactor("list", "gameserversets",
+		func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerSetList{Items: gsSets}, nil
+		})
+	m.AgonesClient.AddReactor("get", "fleets",
+		func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, fleet.DeepCopy(), nil
+		})
+
+	var condition *agonesv1.GameServerSetCondition
+	m.AgonesClient.AddReactor("update", "fleets",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			ua := action.(k8stesting.UpdateAction)
+			fleet := ua.GetObject().(*agonesv1.Fleet)
+			condition = fleet.Status.Condition(agonesv1.GameServerSetCrashLoopBackOffCondition)
+			return true, fleet, nil
+		})
+
+	ctx, cancel := agtesting.StartInformers(m, c.fleetSynced, c.gameServerSetSynced)
+	defer cancel()
+
+	require.NoError(t, c.updateFleetStatus(ctx, fleet))
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "GameServerSet gsSet2: 3 GameServers failed before Ready in a row", condition.Message)
+
+	// once no GameServerSet is crash looping anymore, the condition is False
+	fleet.Status.Conditions = []agonesv1.GameServerSetCondition{*condition}
+	gsSet2.Status.SetCondition(agonesv1.GameServerSetCrashLoopBackOffCondition, corev1.ConditionFalse, "", metav1.Now())
+	setCrashLoopCondition(&fleet.Status, []*agonesv1.GameServerSet{gsSet1, gsSet2})
+	assert.Equal(t, corev1.ConditionFalse, fleet.Status.Condition(agonesv1.GameServerSetCrashLoopBackOffCondition).Status)
+	assert.Empty(t, fleet.Status.Condition(agonesv1.GameServerSetCrashLoopBackOffCondition).Message)
+
+	// the condition is not added until a GameServerSet is crash looping
+	status := agonesv1.FleetStatus{}
+	setCrashLoopCondition(&status, []*agonesv1.GameServerSet{gsSet1, gsSet2})
+	assert.Empty(t, status.Conditions)
+}
+
 // nolint:dupl // Linter errors on lines are duplicate of TestControllerUpdateFleetListStatus
 func TestControllerUpdateFleetCounterStatus(t *testing.T) {
 	t.Parallel()
@@ -1447,6 +1548,48 @@ func TestControllerRollingUpdateDeploymentGSSUpdateFailedErrExpected(t *testing.
 	assert.EqualError(t, err, "error updating gameserverset inactive: random-err")
 }
 
+func TestControllerRollingUpdateDeploymentCrashLooping(t *testing.T) {
+	t.Parallel()
+
+	utilruntime.FeatureTestMutex.Lock()
+	defer utilruntime.FeatureTestMutex.Unlock()
+
+	require.NoError(t, utilruntime.ParseFeatures(string(utilruntime.FeatureCrashLoopBackoff)+"=true"))
+
+	f := defaultFixture()
+	f.Spec.Replicas = 75
+
+	active := f.GameServerSet()
+	active.ObjectMeta.Name = "active"
+	active.Spec.Replicas = 10
+	active.Status.Replicas = 10
+	active.Status.SetCondition(agonesv1.GameServerSetCrashLoopBackOffCondition, corev1.ConditionTrue, "3 GameServers failed before Ready in a row", metav1.Now())
+
+	inactive := f.GameServerSet()
+	inactive.ObjectMeta.Name = "inactive"
+	inactive.Spec.Replicas = 65
+	inactive.Status.ReadyReplicas = 65
+	inactive.Status.Replicas = 65
+
+	c, m := newFakeController()
+	m.AgonesClient.AddReactor("update", "gameserversets", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+		assert.FailNow(t, "gameserversets should not be updated")
+		return true, nil, nil
+	})
+
+	// the rolling update is halted, neither scaling up the active GameServerSet, nor scaling down the inactive one
+	replicas, err := c.rollingUpdateDeployment(context.Background(), f, active, []*agonesv1.GameServerSet{inactive})
+	require.NoError(t, err)
+	assert.Equal(t, int32(10), replicas)
+	agtesting.AssertEventContains(t, m.FakeRecorder.Events, "RollingUpdateHalted")
+
+	// without the feature flag, the active GameServerSet is scaled up as usual
+	require.NoError(t, utilruntime.ParseFeatures(string(utilruntime.FeatureCrashLoopBackoff)+"=false"))
+	replicas, err = c.rollingUpdateActive(f, active, []*agonesv1.GameServerSet{inactive})
+	require.NoError(t, err)
+	assert.Greater(t, replicas, int32(10))
+}
+
 func TestRollingUpdateOnReady(t *testing.T) {
 	type expected struct {
 		inactiveSpecReplicas int32
@@ -1971,3 +2114,19 @@ func (ffnl *fakeFleetNamespaceListerWithErr) List(_ labels.Selector) (ret []*v1.
 func (ffnl *fakeFleetNamespaceListerWithErr) Get(_ string) (*v1.Fleet, error) {
 	return nil, errors.New("err-from-namespace-lister")
 }
+
+func TestNextRevision(t *testing.T) {
+	t.Parallel()
+
+	withRevision := func(revision string) *agonesv1.GameServerSet {
+		gsSet := &agonesv1.GameServerSet{}
+		if revision != "" {
+			gsSet.ObjectMeta.Annotations = map[string]string{agonesv1.FleetRevisionAnnotation: revision}
+		}
+		return gsSet
+	}
+
+	assert.Equal(t, int64(1), nextRevision(nil))
+	assert.Equal(t, int64(1), nextRevision([]*agonesv1.GameServerSet{withRevision(""), withRevision("invalid")}))
+	assert.Equal(t, int64(8), nextRevision([]*agonesv1.GameServerSet{withRevision("3"), withRevision(""), withRevision("7")}))
+}
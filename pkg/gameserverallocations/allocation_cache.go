@@ -18,6 +18,7 @@ import (
 	"context"
 	"sort"
 
+	"agones.dev/agones/pkg/apis"
 	"agones.dev/agones/pkg/apis/agones"
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
@@ -171,7 +172,52 @@ func (c *AllocationCache) ListSortedGameServers(gsa *allocationv1.GameServerAllo
 	if list == nil {
 		return []*agonesv1.GameServer{}
 	}
+	c.sortPacked(list, gsa)
+	return list
+}
+
+// ListSortedGameServersPriorities sorts and returns a list of game servers based on the
+// list of Priorities.
+func (c *AllocationCache) ListSortedGameServersPriorities(gsa *allocationv1.GameServerAllocation) []*agonesv1.GameServer {
+	list := c.getGameServers()
+	if list == nil {
+		return []*agonesv1.GameServer{}
+	}
+	sortPriorities(list, gsa)
+	return list
+}
+
+// ListSortedCandidates returns the cached gameservers that may match the selectors of the
+// GameServerAllocation, found from the cache indexes rather than a scan of every cached gameserver.
+// The candidates are sorted in the same order as ListSortedGameServers, or ListSortedGameServersPriorities
+// for Distributed scheduling with CountsAndLists, so searching them finds the same GameServer as
+// searching the full list.
+func (c *AllocationCache) ListSortedCandidates(gsa *allocationv1.GameServerAllocation) []*agonesv1.GameServer {
+	var list []*agonesv1.GameServer
+	for _, namespace := range gsa.SearchNamespaces() {
+		list = append(list, c.ListCandidates(namespace, gsa.Spec.Selectors)...)
+	}
+	if list == nil {
+		return []*agonesv1.GameServer{}
+	}
+	if !runtime.FeatureEnabled(runtime.FeatureCountsAndLists) || gsa.Spec.Scheduling == apis.Packed {
+		c.sortPacked(list, gsa)
+	} else {
+		sortPriorities(list, gsa)
+	}
+	return list
+}
+
+// ListCandidates returns the cached gameservers in namespace that may match any of the selectors,
+// in no particular order.
+func (c *AllocationCache) ListCandidates(namespace string, selectors []allocationv1.GameServerSelector) []*agonesv1.GameServer {
+	return c.cache.Candidates(namespace, selectors)
+}
+
+// sortPacked sorts the list by most allocated to least.
+func (c *AllocationCache) sortPacked(list []*agonesv1.GameServer, gsa *allocationv1.GameServerAllocation) {
 	counts := c.counter.Counts()
+	order := namespaceOrder(gsa)
 
 	sort.Slice(list, func(i, j int) bool {
 		gs1 := list[i]
@@ -230,21 +276,19 @@ func (c *AllocationCache) ListSortedGameServers(gsa *allocationv1.GameServerAllo
 			}
 		}
 
+		// then prefer the earlier namespaces of a cross namespace allocation
+		if order != nil && gs1.ObjectMeta.Namespace != gs2.ObjectMeta.Namespace {
+			return order[gs1.ObjectMeta.Namespace] < order[gs2.ObjectMeta.Namespace]
+		}
+
 		// finally sort lexicographically, so we have a stable order
 		return gs1.GetObjectMeta().GetName() < gs2.GetObjectMeta().GetName()
 	})
-
-	return list
 }
 
-// ListSortedGameServersPriorities sorts and returns a list of game servers based on the
-// list of Priorities.
-func (c *AllocationCache) ListSortedGameServersPriorities(gsa *allocationv1.GameServerAllocation) []*agonesv1.GameServer {
-	list := c.getGameServers()
-	if list == nil {
-		return []*agonesv1.GameServer{}
-	}
-
+// sortPriorities sorts the list by the Priorities of the GameServerAllocation.
+func sortPriorities(list []*agonesv1.GameServer, gsa *allocationv1.GameServerAllocation) {
+	order := namespaceOrder(gsa)
 	sort.Slice(list, func(i, j int) bool {
 		gs1 := list[i]
 		gs2 := list[j]
@@ -255,11 +299,14 @@ func (c *AllocationCache) ListSortedGameServersPriorities(gsa *allocationv1.Game
 			}
 		}
 
+		// then prefer the earlier namespaces of a cross namespace allocation
+		if order != nil && gs1.ObjectMeta.Namespace != gs2.ObjectMeta.Namespace {
+			return order[gs1.ObjectMeta.Namespace] < order[gs2.ObjectMeta.Namespace]
+		}
+
 		// finally sort lexicographically, so we have a stable order
 		return gs1.GetObjectMeta().GetName() < gs2.GetObjectMeta().GetName()
 	})
-
-	return list
 }
 
 // SyncGameServers synchronises the GameServers to Gameserver cache. This is called when a failure
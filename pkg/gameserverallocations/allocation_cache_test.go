@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"agones.dev/agones/pkg/apis"
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
 	"agones.dev/agones/pkg/gameservers"
@@ -31,6 +32,7 @@ import (
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	k8stesting "k8s.io/client-go/testing"
@@ -500,6 +502,67 @@ func TestListSortedGameServersPriorities(t *testing.T) {
 	}
 }
 
+func TestListSortedGameServersLifecyclePriorities(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true&%s=true", runtime.FeatureCountsAndLists, runtime.FeatureLifecyclePriorities)))
+
+	now := time.Now()
+	newGS := func(name, revision string, age time.Duration) agonesv1.GameServer {
+		return agonesv1.GameServer{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNs, UID: types.UID(name),
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+				Annotations:       map[string]string{agonesv1.FleetRevisionAnnotation: revision}},
+			Status: agonesv1.GameServerStatus{NodeName: "node1", State: agonesv1.GameServerStateReady},
+		}
+	}
+	gs1 := newGS("gs1", "1", 3*time.Hour)
+	gs2 := newGS("gs2", "2", time.Hour)
+	gs3 := newGS("gs3", "2", 2*time.Hour)
+	list := []agonesv1.GameServer{gs1, gs2, gs3}
+
+	testScenarios := map[string]struct {
+		priorities []agonesv1.Priority
+		want       []string
+	}{
+		"oldest first": {
+			priorities: []agonesv1.Priority{{Type: agonesv1.GameServerPriorityCreationTime, Order: agonesv1.GameServerPriorityAscending}},
+			want:       []string{"gs1", "gs3", "gs2"},
+		},
+		"newest revision, then oldest first": {
+			priorities: []agonesv1.Priority{
+				{Type: agonesv1.GameServerPriorityRevision, Order: agonesv1.GameServerPriorityDescending},
+				{Type: agonesv1.GameServerPriorityCreationTime, Order: agonesv1.GameServerPriorityAscending},
+			},
+			want: []string{"gs3", "gs2", "gs1"},
+		},
+	}
+
+	for testName, testScenario := range testScenarios {
+		t.Run(testName, func(t *testing.T) {
+			cache, m := newFakeAllocationCache()
+
+			m.AgonesClient.AddReactor("list", "gameservers", func(_ k8stesting.Action) (bool, k8sruntime.Object, error) {
+				return true, &agonesv1.GameServerList{Items: list}, nil
+			})
+
+			ctx, cancel := agtesting.StartInformers(m, cache.gameServerSynced)
+			defer cancel()
+
+			require.NoError(t, cache.syncCache())
+			require.NoError(t, cache.counter.Run(ctx, 0))
+
+			gsa := &allocationv1.GameServerAllocation{Spec: allocationv1.GameServerAllocationSpec{Priorities: testScenario.priorities}}
+			var got []string
+			for _, gs := range cache.ListSortedGameServersPriorities(gsa) {
+				got = append(got, gs.ObjectMeta.Name)
+			}
+			assert.Equal(t, testScenario.want, got)
+		})
+	}
+}
+
 func TestAllocatorRunCacheSync(t *testing.T) {
 	t.Parallel()
 	cache, m := newFakeAllocationCache()
@@ -602,6 +665,159 @@ func TestAllocatorRunCacheSync(t *testing.T) {
 	assertCacheEntries(0)
 }
 
+func TestAllocationCacheListSortedCandidates(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(string(runtime.FeatureCountsAndLists)+"=true"))
+
+	cache, _ := newFakeAllocationCache()
+	fillAllocationCache(cache, 500, 10)
+
+	for _, scheduling := range []apis.SchedulingStrategy{apis.Packed, apis.Distributed} {
+		gsa := &allocationv1.GameServerAllocation{
+			ObjectMeta: metav1.ObjectMeta{Namespace: defaultNs},
+			Spec: allocationv1.GameServerAllocationSpec{
+				Scheduling: scheduling,
+				Selectors: []allocationv1.GameServerSelector{
+					{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{agonesv1.FleetNameLabel: "fleet-3"}}},
+					{Counters: map[string]allocationv1.CounterSelector{"rooms": {MinAvailable: 9}}},
+				},
+				Priorities: []agonesv1.Priority{{Type: agonesv1.GameServerPriorityCounter, Key: "rooms", Order: agonesv1.GameServerPriorityDescending}},
+			},
+		}
+		gsa.ApplyDefaults()
+
+		var want []*agonesv1.GameServer
+		all := cache.ListSortedGameServers(gsa)
+		if scheduling == apis.Distributed {
+			all = cache.ListSortedGameServersPriorities(gsa)
+		}
+		for _, gs := range all {
+			for _, sel := range gsa.Spec.Selectors {
+				if sel.Matches(gs) {
+					want = append(want, gs)
+					break
+				}
+			}
+		}
+
+		var got []*agonesv1.GameServer
+		for _, gs := range cache.ListSortedCandidates(gsa) {
+			for _, sel := range gsa.Spec.Selectors {
+				if sel.Matches(gs) {
+					got = append(got, gs)
+					break
+				}
+			}
+		}
+		require.NotEmpty(t, want)
+		if scheduling == apis.Packed {
+			// without node counts, Packed sorting does not define an order
+			assert.ElementsMatch(t, want, got)
+		} else {
+			assert.Equal(t, want, got)
+		}
+	}
+}
+
+func TestAllocationCacheListSortedCandidatesNamespaces(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true&%s=true", runtime.FeatureCountsAndLists, runtime.FeatureCrossNamespaceAllocation)))
+
+	cache, _ := newFakeAllocationCache()
+	counts := []int64{0, 0, 0, 1, 1}
+	for i, ns := range []string{"eu", "us", "other", "eu", "us"} {
+		cache.AddGameServer(&agonesv1.GameServer{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("gs-%d", i), Namespace: ns, ResourceVersion: "1"},
+			Status: agonesv1.GameServerStatus{
+				State:    agonesv1.GameServerStateReady,
+				Counters: map[string]agonesv1.CounterStatus{"rooms": {Count: counts[i], Capacity: 10}},
+			},
+		})
+	}
+
+	gsa := &allocationv1.GameServerAllocation{
+		ObjectMeta: metav1.ObjectMeta{Namespace: defaultNs},
+		Spec: allocationv1.GameServerAllocationSpec{
+			Namespaces: []string{"us", "eu"},
+			Scheduling: apis.Distributed,
+			Priorities: []agonesv1.Priority{{Type: agonesv1.GameServerPriorityCounter, Key: "rooms", Order: agonesv1.GameServerPriorityAscending}},
+		},
+	}
+	gsa.ApplyDefaults()
+	gsa.Converter()
+
+	var got []string
+	list := cache.ListSortedCandidates(gsa)
+	for _, gs := range list {
+		got = append(got, gs.ObjectMeta.Namespace+"/"+gs.ObjectMeta.Name)
+	}
+	// sorted by the priorities across namespaces, then by the order of the namespaces
+	assert.Equal(t, []string{"us/gs-4", "eu/gs-3", "us/gs-1", "eu/gs-0"}, got)
+
+	gs, _, err := findGameServerForAllocation(gsa, list)
+	require.NoError(t, err)
+	assert.Equal(t, "us", gs.ObjectMeta.Namespace)
+	assert.Equal(t, "gs-4", gs.ObjectMeta.Name)
+
+	// GameServers outside the namespaces are never found
+	gsa.Spec.Namespaces = []string{"other"}
+	_, _, err = findGameServerForAllocation(gsa, list)
+	assert.Equal(t, ErrNoGameServer, err)
+}
+
+// fillAllocationCache adds count Ready GameServers spread across fleets to the cache
+func fillAllocationCache(cache *AllocationCache, count, fleets int) {
+	for i := 0; i < count; i++ {
+		cache.AddGameServer(&agonesv1.GameServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            fmt.Sprintf("gs-%d", i),
+				Namespace:       defaultNs,
+				ResourceVersion: "1",
+				Labels:          map[string]string{agonesv1.FleetNameLabel: fmt.Sprintf("fleet-%d", i%fleets)},
+			},
+			Status: agonesv1.GameServerStatus{
+				NodeName: fmt.Sprintf("node-%d", i%50),
+				State:    agonesv1.GameServerStateReady,
+				Counters: map[string]agonesv1.CounterStatus{"rooms": {Count: int64(i % 11), Capacity: 10}},
+			},
+		})
+	}
+}
+
+func benchmarkAllocationCacheList(b *testing.B, list func(*AllocationCache, *allocationv1.GameServerAllocation) []*agonesv1.GameServer) {
+	cache, _ := newFakeAllocationCache()
+	fillAllocationCache(cache, 20000, 100)
+	gsa := &allocationv1.GameServerAllocation{
+		ObjectMeta: metav1.ObjectMeta{Namespace: defaultNs},
+		Spec: allocationv1.GameServerAllocationSpec{
+			Scheduling: apis.Packed,
+			Selectors: []allocationv1.GameServerSelector{
+				{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{agonesv1.FleetNameLabel: "fleet-42"}}},
+			},
+		},
+	}
+	gsa.ApplyDefaults()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := findGameServerForAllocation(gsa, list(cache, gsa)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAllocationCacheListSortedGameServers(b *testing.B) {
+	benchmarkAllocationCacheList(b, (*AllocationCache).ListSortedGameServers)
+}
+
+func BenchmarkAllocationCacheListSortedCandidates(b *testing.B) {
+	benchmarkAllocationCacheList(b, (*AllocationCache).ListSortedCandidates)
+}
+
 func newFakeAllocationCache() (*AllocationCache, agtesting.Mocks) {
 	m := agtesting.NewMocks()
 	cache := NewAllocationCache(m.AgonesInformerFactory.Agones().V1().GameServers(), gameservers.NewPerNodeCounter(m.KubeInformerFactory, m.AgonesInformerFactory), healthcheck.NewHandler())
@@ -25,7 +25,6 @@ import (
 
 	"agones.dev/agones/pkg/allocation/converters"
 	pb "agones.dev/agones/pkg/allocation/go"
-	"agones.dev/agones/pkg/apis"
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
 	multiclusterv1 "agones.dev/agones/pkg/apis/multicluster/v1"
@@ -33,8 +32,10 @@ import (
 	multiclusterinformerv1 "agones.dev/agones/pkg/client/informers/externalversions/multicluster/v1"
 	multiclusterlisterv1 "agones.dev/agones/pkg/client/listers/multicluster/v1"
 	"agones.dev/agones/pkg/util/apiserver"
+	"agones.dev/agones/pkg/util/connectiontoken"
 	"agones.dev/agones/pkg/util/logfields"
 	"agones.dev/agones/pkg/util/runtime"
+	"github.com/mitchellh/hashstructure/v2"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/tag"
@@ -47,8 +48,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	runtimeschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/wait"
 	informercorev1 "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
@@ -69,12 +71,14 @@ var (
 	ErrTotalTimeoutExceeded = status.Errorf(codes.DeadlineExceeded, "remote allocation total timeout exceeded")
 	// ErrGameServerUpdateConflict is returned when the game server selected for applying the allocation cannot be updated
 	ErrGameServerUpdateConflict = errors.New("could not update the selected GameServer")
+	// ErrConnectionTokensNotConfigured is returned when a connection token is requested, but there is no key to sign it
+	ErrConnectionTokensNotConfigured = status.Errorf(codes.FailedPrecondition, "connection tokens are not configured")
 )
 
 const (
 	// LastAllocatedAnnotationKey is a GameServer annotation containing an RFC 3339 formatted
 	// timestamp of the most recent allocation.
-	LastAllocatedAnnotationKey = "agones.dev/last-allocated"
+	LastAllocatedAnnotationKey = agonesv1.GameServerLastAllocatedAnnotation
 
 	secretClientCertName  = "tls.crt"
 	secretClientKeyName   = "tls.key"
@@ -105,6 +109,8 @@ type Allocator struct {
 	allocationPolicySynced       cache.InformerSynced
 	secretLister                 corev1lister.SecretLister
 	secretSynced                 cache.InformerSynced
+	namespaceLister              corev1lister.NamespaceLister
+	namespaceSynced              cache.InformerSynced
 	gameServerGetter             getterv1.GameServersGetter
 	recorder                     record.EventRecorder
 	pendingRequests              chan request
@@ -113,11 +119,15 @@ type Allocator struct {
 	remoteAllocationTimeout      time.Duration
 	totalRemoteAllocationTimeout time.Duration
 	batchWaitTime                time.Duration
+	scorers                      *wasmScorers
+	tokenSigner                  *connectiontoken.Signer
 }
 
 // request is an async request for allocation
 type request struct {
 	gsa      *allocationv1.GameServerAllocation
+	scorer   *wasmScorer
+	source   string
 	response chan response
 }
 
@@ -129,8 +139,9 @@ type response struct {
 }
 
 // NewAllocator creates an instance of Allocator
-func NewAllocator(policyInformer multiclusterinformerv1.GameServerAllocationPolicyInformer, secretInformer informercorev1.SecretInformer, gameServerGetter getterv1.GameServersGetter,
-	kubeClient kubernetes.Interface, allocationCache *AllocationCache, remoteAllocationTimeout time.Duration, totalRemoteAllocationTimeout time.Duration, batchWaitTime time.Duration) *Allocator {
+func NewAllocator(policyInformer multiclusterinformerv1.GameServerAllocationPolicyInformer, secretInformer informercorev1.SecretInformer, namespaceInformer informercorev1.NamespaceInformer, gameServerGetter getterv1.GameServersGetter,
+	kubeClient kubernetes.Interface, allocationCache *AllocationCache, remoteAllocationTimeout time.Duration, totalRemoteAllocationTimeout time.Duration, batchWaitTime time.Duration,
+	tokenSigner *connectiontoken.Signer) *Allocator {
 	ah := &Allocator{
 		pendingRequests:              make(chan request, maxBatchQueue),
 		allocationPolicyLister:       policyInformer.Lister(),
@@ -142,6 +153,8 @@ func NewAllocator(policyInformer multiclusterinformerv1.GameServerAllocationPoli
 		batchWaitTime:                batchWaitTime,
 		remoteAllocationTimeout:      remoteAllocationTimeout,
 		totalRemoteAllocationTimeout: totalRemoteAllocationTimeout,
+		scorers:                      newWasmScorers(),
+		tokenSigner:                  tokenSigner,
 		remoteAllocationCallback: func(ctx context.Context, endpoint string, dialOpts grpc.DialOption, request *pb.AllocationRequest) (*pb.AllocationResponse, error) {
 			conn, err := grpc.NewClient(endpoint, dialOpts)
 			if err != nil {
@@ -156,6 +169,12 @@ func NewAllocator(policyInformer multiclusterinformerv1.GameServerAllocationPoli
 		},
 	}
 
+	// namespaces are only watched to resolve the namespace selectors of cross namespace allocations
+	if runtime.FeatureEnabled(runtime.FeatureCrossNamespaceAllocation) {
+		ah.namespaceLister = namespaceInformer.Lister()
+		ah.namespaceSynced = namespaceInformer.Informer().HasSynced
+	}
+
 	ah.baseLogger = runtime.NewLoggerWithType(ah)
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(ah.baseLogger.Debugf)
@@ -184,7 +203,11 @@ func (c *Allocator) Run(ctx context.Context) error {
 // Sync waits for cache to sync
 func (c *Allocator) Sync(ctx context.Context) error {
 	c.baseLogger.Debug("Wait for Allocator cache sync")
-	if !cache.WaitForCacheSync(ctx.Done(), c.secretSynced, c.allocationPolicySynced) {
+	synced := []cache.InformerSynced{c.secretSynced, c.allocationPolicySynced}
+	if c.namespaceSynced != nil {
+		synced = append(synced, c.namespaceSynced)
+	}
+	if !cache.WaitForCacheSync(ctx.Done(), synced...) {
 		return errors.New("failed to wait for caches to sync")
 	}
 	return nil
@@ -203,31 +226,30 @@ func (c *Allocator) Allocate(ctx context.Context, gsa *allocationv1.GameServerAl
 
 	// server side validation
 	if errs := gsa.Validate(); len(errs) > 0 {
-		kind := runtimeschema.GroupKind{
-			Group: allocationv1.SchemeGroupVersion.Group,
-			Kind:  "GameServerAllocation",
-		}
-		statusErr := k8serrors.NewInvalid(kind, gsa.Name, errs)
-		s := &statusErr.ErrStatus
-		var gvks []schema.GroupVersionKind
-		gvks, _, err := apiserver.Scheme.ObjectKinds(s)
-		if err != nil {
-			return nil, errors.Wrap(err, "could not find objectkinds for status")
-		}
-
 		c.loggerForGameServerAllocation(gsa).Debug("GameServerAllocation is invalid")
-		s.TypeMeta = metav1.TypeMeta{Kind: gvks[0].Kind, APIVersion: gvks[0].Version}
-		return s, nil
+		return invalidStatus("GameServerAllocation", gsa.Name, errs)
 	}
 
 	// Convert gsa required and preferred fields to selectors field
 	gsa.Converter()
 
+	// resolve the namespaces to search, so they do not change between retries
+	if c.namespaceLister != nil {
+		if err := ResolveNamespaceSelector(c.namespaceLister, gsa); err != nil {
+			return nil, err
+		}
+	}
+
+	// generate the request id before any multi-cluster forwarding, so all clusters see the same one
+	if runtime.FeatureEnabled(runtime.FeatureMetaPatchTemplates) && gsa.Spec.RequestID == "" {
+		gsa.Spec.RequestID = string(uuid.NewUUID())
+	}
+
 	// If multi-cluster setting is enabled, allocate base on the multicluster allocation policy.
 	if gsa.Spec.MultiClusterSetting.Enabled {
 		out, err = c.applyMultiClusterAllocation(ctx, gsa)
 	} else {
-		out, err = c.allocateFromLocalCluster(ctx, gsa)
+		out, err = c.allocateFromLocalCluster(ctx, gsa, localAllocationSource)
 	}
 
 	if err != nil {
@@ -251,15 +273,28 @@ func (c *Allocator) loggerForGameServerAllocation(gsa *allocationv1.GameServerAl
 	return c.loggerForGameServerAllocationKey(gsaName).WithField("gsa", gsa)
 }
 
-// allocateFromLocalCluster allocates gameservers from the local cluster.
-// Registers number of times we retried before getting a success allocation
-func (c *Allocator) allocateFromLocalCluster(ctx context.Context, gsa *allocationv1.GameServerAllocation) (*allocationv1.GameServerAllocation, error) {
+// allocateFromLocalCluster allocates gameservers from the local cluster, where source is the value of `.Source`
+// in the MetaPatch templates. Registers number of times we retried before getting a success allocation
+func (c *Allocator) allocateFromLocalCluster(ctx context.Context, gsa *allocationv1.GameServerAllocation, source string) (*allocationv1.GameServerAllocation, error) {
 	var gs *agonesv1.GameServer
 	retry := c.newMetrics(ctx)
 	retryCount := 0
+
+	connectionToken := gsa.Spec.ConnectionToken != nil && runtime.FeatureEnabled(runtime.FeatureConnectionTokens)
+	if connectionToken && c.tokenSigner == nil {
+		return nil, ErrConnectionTokensNotConfigured
+	}
+
+	// load the Wasm scoring module up front, so a module that cannot be loaded is not retried
+	if gsa.Spec.Scoring != nil && runtime.FeatureEnabled(runtime.FeatureWasmAllocationScoring) {
+		if _, err := c.scorers.get(ctx, gsa.Spec.Scoring); err != nil {
+			return nil, err
+		}
+	}
+
 	err := Retry(allocationRetry, func() error {
 		var err error
-		gs, err = c.allocate(ctx, gsa)
+		gs, err = c.allocate(ctx, gsa, source)
 		retryCount++
 
 		if err != nil {
@@ -270,6 +305,11 @@ func (c *Allocator) allocateFromLocalCluster(ctx context.Context, gsa *allocatio
 		return err
 	})
 
+	// the MetaPatch templates could not be applied, there is nothing wrong with the cache
+	if status.Code(err) == codes.InvalidArgument {
+		return nil, err
+	}
+
 	if err != nil && err != ErrNoGameServer && err != ErrConflictInGameServerSelection {
 		c.allocationCache.Resync()
 		return nil, err
@@ -289,6 +329,9 @@ func (c *Allocator) allocateFromLocalCluster(ctx context.Context, gsa *allocatio
 		gsa.Status.Addresses = append(gsa.Status.Addresses, gs.Status.Addresses...)
 		gsa.Status.NodeName = gs.Status.NodeName
 		gsa.Status.Source = localAllocationSource
+		if runtime.FeatureEnabled(runtime.FeatureCrossNamespaceAllocation) {
+			gsa.Status.Namespace = gs.ObjectMeta.Namespace
+		}
 		gsa.Status.Metadata = &allocationv1.GameServerMetadata{
 			Labels:      gs.ObjectMeta.Labels,
 			Annotations: gs.ObjectMeta.Annotations,
@@ -297,6 +340,13 @@ func (c *Allocator) allocateFromLocalCluster(ctx context.Context, gsa *allocatio
 			gsa.Status.Counters = gs.Status.Counters
 			gsa.Status.Lists = gs.Status.Lists
 		}
+		if connectionToken {
+			token, err := c.tokenSigner.Sign(gs, gsa.Spec.ConnectionToken)
+			if err != nil {
+				return nil, err
+			}
+			gsa.Status.ConnectionToken = token
+		}
 	}
 
 	c.loggerForGameServerAllocation(gsa).Debug("Game server allocation")
@@ -334,7 +384,7 @@ func (c *Allocator) applyMultiClusterAllocation(ctx context.Context, gsa *alloca
 				gsaCopy = gsa.DeepCopy()
 				gsaCopy.Namespace = connectionInfo.Namespace
 			}
-			result, err = c.allocateFromLocalCluster(ctx, gsaCopy)
+			result, err = c.allocateFromLocalCluster(ctx, gsaCopy, connectionInfo.ClusterName)
 			if err != nil {
 				c.loggerForGameServerAllocation(gsaCopy).WithError(err).Error("self-allocation failed")
 			}
@@ -456,10 +506,17 @@ func (c *Allocator) getClientCertificates(namespace, secretName string) (clientC
 
 // allocate allocated a GameServer from a given GameServerAllocation
 // this sets up allocation through a batch process.
-func (c *Allocator) allocate(ctx context.Context, gsa *allocationv1.GameServerAllocation) (*agonesv1.GameServer, error) {
+func (c *Allocator) allocate(ctx context.Context, gsa *allocationv1.GameServerAllocation, source string) (*agonesv1.GameServer, error) {
 	// creates an allocation request. This contains the requested GameServerAllocation, as well as the
 	// channel we expect the return values to come back for this GameServerAllocation
-	req := request{gsa: gsa, response: make(chan response)}
+	req := request{gsa: gsa, source: source, response: make(chan response)}
+	if gsa.Spec.Scoring != nil && runtime.FeatureEnabled(runtime.FeatureWasmAllocationScoring) {
+		scorer, err := c.scorers.get(ctx, gsa.Spec.Scoring)
+		if err != nil {
+			return nil, err
+		}
+		req.scorer = scorer
+	}
 
 	// this pushes the request into the batching process
 	c.pendingRequests <- req
@@ -501,69 +558,65 @@ func (c *Allocator) ListenAndAllocate(ctx context.Context, updateWorkerCount int
 	// waiting to concurrently attempt to move the GameServer into an Allocated state, and return the result to
 	// GameServerAllocation request's response channel
 
-	// Then we get the next item off the batch (c.pendingRequests), and do this all over again, but this time, we have
-	// an already sorted list of GameServers, so we only need to find one that matches our GameServerAllocation
-	// selectors, and put it into updateQueue
+	// Then we get the next item off the batch (c.pendingRequests), and do this all over again. Each list holds only
+	// the candidates from the AllocationCache indexes for the namespaces and selectors of a request, so requests in
+	// the batch with the same namespaces and GameServerAllocationSpec reuse an already sorted list, and only need to
+	// find one that matches their selectors, and put it into updateQueue. As the lists of a batch may overlap, a
+	// GameServer that has already been removed from the cache is skipped.
 
 	// The tracking of requestCount >= maxBatchBeforeRefresh is necessary, because without it, at high enough load
-	// the list of GameServers that we are using to allocate would never get refreshed (list = nil) with an updated
+	// the lists of GameServers that we are using to allocate would never get refreshed (lists = nil) with an updated
 	// list of Ready GameServers, and you would eventually never be able to Allocate anything as long as the load
 	// continued.
 
-	var list []*agonesv1.GameServer
-	var sortKey uint64
+	var lists map[uint64][]*agonesv1.GameServer
 	requestCount := 0
 
 	for {
 		select {
 		case req := <-c.pendingRequests:
-			// refresh the list after every 100 allocations made in a single batch
+			// refresh the lists after every 100 allocations made in a single batch
 			if requestCount >= maxBatchBeforeRefresh {
-				list = nil
+				lists = nil
 				requestCount = 0
 			}
-
-			if runtime.FeatureEnabled(runtime.FeatureCountsAndLists) {
-				// SortKey returns the sorting values (list of Priorities) as a determinstic key.
-				// In case gsa.Spec.Priorities is nil this will still return a sortKey.
-				// In case of error this will return 0 for the sortKey.
-				newSortKey, err := req.gsa.SortKey()
-				if err != nil {
-					c.baseLogger.WithError(err).Warn("error getting sortKey for GameServerAllocationSpec", err)
-				}
-				// Set sortKey if this is the first request, or the previous request errored on creating a sortKey.
-				if sortKey == uint64(0) {
-					sortKey = newSortKey
-				}
-
-				if newSortKey != sortKey {
-					sortKey = newSortKey
-					list = nil
-					requestCount = 0
-				}
+			if lists == nil {
+				lists = map[uint64][]*agonesv1.GameServer{}
 			}
 
 			requestCount++
 
-			if list == nil {
-				if !runtime.FeatureEnabled(runtime.FeatureCountsAndLists) || req.gsa.Spec.Scheduling == apis.Packed {
-					list = c.allocationCache.ListSortedGameServers(req.gsa)
+			// In case of error this will return 0 for the listKey, and the list is not reused.
+			listKey, err := candidatesKey(req.gsa)
+			if err != nil {
+				c.baseLogger.WithError(err).Warn("error getting candidates key for GameServerAllocation", err)
+			}
+			list, ok := lists[listKey]
+			if !ok || listKey == 0 {
+				list = c.allocationCache.ListSortedCandidates(req.gsa)
+			}
+
+			var gs *agonesv1.GameServer
+			for {
+				var index int
+				if req.scorer != nil {
+					gs, index, err = findScoredGameServerForAllocation(ctx, c.loggerForGameServerAllocation(req.gsa), req.scorer, req.gsa, list)
 				} else {
-					// If FeatureCountsAndLists and Scheduling == Distributed, sort game servers by Priorities
-					list = c.allocationCache.ListSortedGameServersPriorities(req.gsa)
+					gs, index, err = findGameServerForAllocation(req.gsa, list)
 				}
-			}
+				if err != nil {
+					break
+				}
+				// remove the game server that has been allocated
+				list = append(list[:index], list[index+1:]...)
 
-			gs, index, err := findGameServerForAllocation(req.gsa, list)
-			if err != nil {
-				req.response <- response{request: req, gs: nil, err: err}
-				continue
+				// if it was already removed through another list in this batch, keep looking
+				if err = c.allocationCache.RemoveGameServer(gs); err == nil {
+					break
+				}
 			}
-			// remove the game server that has been allocated
-			list = append(list[:index], list[index+1:]...)
-
-			if err := c.allocationCache.RemoveGameServer(gs); err != nil {
-				// this seems unlikely, but lets handle it just in case
+			lists[listKey] = list
+			if err != nil {
 				req.response <- response{request: req, gs: nil, err: err}
 				continue
 			}
@@ -573,7 +626,7 @@ func (c *Allocator) ListenAndAllocate(ctx context.Context, updateWorkerCount int
 		case <-ctx.Done():
 			return
 		default:
-			list = nil
+			lists = nil
 			requestCount = 0
 			// slow down cpu churn, and allow items to batch
 			time.Sleep(c.batchWaitTime)
@@ -581,6 +634,33 @@ func (c *Allocator) ListenAndAllocate(ctx context.Context, updateWorkerCount int
 	}
 }
 
+// invalidStatus returns the Status for an invalid allocation.agones.dev resource of the given kind
+func invalidStatus(kind, name string, errs field.ErrorList) (*metav1.Status, error) {
+	statusErr := k8serrors.NewInvalid(runtimeschema.GroupKind{Group: allocationv1.SchemeGroupVersion.Group, Kind: kind}, name, errs)
+	s := &statusErr.ErrStatus
+	gvks, _, err := apiserver.Scheme.ObjectKinds(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not find objectkinds for status")
+	}
+	s.TypeMeta = metav1.TypeMeta{Kind: gvks[0].Kind, APIVersion: gvks[0].Version}
+	return s, nil
+}
+
+// candidatesKey returns a deterministic key for the candidates of a GameServerAllocation, and the order they
+// are searched in, so that requests in a batch with the same key can share a sorted list of candidates.
+func candidatesKey(gsa *allocationv1.GameServerAllocation) (uint64, error) {
+	// SortKey covers the scheduling and Priorities, but not the selectors
+	sortKey, err := gsa.SortKey()
+	if err != nil {
+		return 0, err
+	}
+	return hashstructure.Hash(struct {
+		Namespaces []string
+		Selectors  []allocationv1.GameServerSelector
+		SortKey    uint64
+	}{gsa.SearchNamespaces(), gsa.Spec.Selectors, sortKey}, hashstructure.FormatV2, nil)
+}
+
 // allocationUpdateWorkers runs workerCount number of goroutines as workers to
 // process each GameServer passed into the returned updateQueue
 // Each worker will concurrently attempt to move the GameServer to an Allocated
@@ -594,7 +674,7 @@ func (c *Allocator) allocationUpdateWorkers(ctx context.Context, workerCount int
 			for {
 				select {
 				case res := <-updateQueue:
-					gs, err := c.applyAllocationToGameServer(ctx, res.request.gsa.Spec.MetaPatch, res.gs, res.request.gsa)
+					gs, err := c.applyAllocationToGameServer(ctx, res.request.gsa.Spec.MetaPatch, res.gs, res.request.gsa, res.request.source)
 					if err != nil {
 						if !k8serrors.IsConflict(errors.Cause(err)) {
 							// since we could not allocate, we should put it back
@@ -603,6 +683,9 @@ func (c *Allocator) allocationUpdateWorkers(ctx context.Context, workerCount int
 							c.allocationCache.AddGameServer(gs)
 						}
 						res.err = ErrGameServerUpdateConflict
+						if status.Code(err) == codes.InvalidArgument {
+							res.err = err
+						}
 					} else {
 						// put the GameServer back into the cache, so it's immediately around for re-allocation
 						c.allocationCache.AddGameServer(gs)
@@ -621,8 +704,26 @@ func (c *Allocator) allocationUpdateWorkers(ctx context.Context, workerCount int
 }
 
 // applyAllocationToGameServer patches the inputted GameServer with the allocation metadata changes, and updates it to the Allocated State.
-// Returns the updated GameServer.
-func (c *Allocator) applyAllocationToGameServer(ctx context.Context, mp allocationv1.MetaPatch, gs *agonesv1.GameServer, gsa *allocationv1.GameServerAllocation) (*agonesv1.GameServer, error) {
+// source is the value of `.Source` in the MetaPatch templates. Returns the updated GameServer.
+func (c *Allocator) applyAllocationToGameServer(ctx context.Context, mp allocationv1.MetaPatch, gs *agonesv1.GameServer, gsa *allocationv1.GameServerAllocation, source string) (*agonesv1.GameServer, error) {
+	now := time.Now()
+
+	// execute any MetaPatch templates before the GameServer is changed
+	templates := mp
+	if runtime.FeatureEnabled(runtime.FeatureMetaPatchTemplates) {
+		rendered, err := mp.Render(allocationv1.MetaPatchTemplateData{
+			AllocationTime: now.UTC(),
+			RequestID:      gsa.Spec.RequestID,
+			Source:         source,
+			GameServer:     allocationv1.NewMetaPatchTemplateGameServer(gs),
+			Parameters:     gsa.Spec.TemplateParameters,
+		})
+		if err != nil {
+			return gs, status.Errorf(codes.InvalidArgument, "could not apply metadata templates to GameServer %s: %v", gs.ObjectMeta.Name, err)
+		}
+		mp = rendered
+	}
+
 	// patch ObjectMeta labels
 	if mp.Labels != nil {
 		if gs.ObjectMeta.Labels == nil {
@@ -640,14 +741,25 @@ func (c *Allocator) applyAllocationToGameServer(ctx context.Context, mp allocati
 	for key, value := range mp.Annotations {
 		gs.ObjectMeta.Annotations[key] = value
 	}
+	if runtime.FeatureEnabled(runtime.FeatureMetaPatchTemplates) {
+		if err := recordMetaPatchTemplates(templates, mp, gs); err != nil {
+			return nil, err
+		}
+	}
 
 	// add last allocated, so it always gets updated, even if it is already Allocated
-	ts, err := time.Now().MarshalText()
+	ts, err := now.MarshalText()
 	if err != nil {
 		return nil, err
 	}
 	gs.ObjectMeta.Annotations[LastAllocatedAnnotationKey] = string(ts)
-	gs.Status.State = agonesv1.GameServerStateAllocated
+	gs.SetState(agonesv1.GameServerStateAllocated, "Allocated")
+
+	// the payload is always that of the latest allocation, so a payload from a previous allocation
+	// is cleared by an allocation without one
+	if runtime.FeatureEnabled(runtime.FeatureAllocationPayload) {
+		gs.Status.AllocationPayload = gsa.Spec.Payload
+	}
 
 	// perfom any Counter or List actions
 	var counterErrors error
@@ -702,6 +814,8 @@ func Retry(backoff wait.Backoff, fn func() error) error {
 			return true, err
 		case err == ErrTotalTimeoutExceeded:
 			return true, err
+		case status.Code(err) == codes.InvalidArgument:
+			return true, err
 		default:
 			lastConflictErr = err
 			return false, nil
@@ -16,6 +16,11 @@ package gameserverallocations
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"testing"
@@ -27,12 +32,15 @@ import (
 	multiclusterv1 "agones.dev/agones/pkg/apis/multicluster/v1"
 	"agones.dev/agones/pkg/gameservers"
 	agtesting "agones.dev/agones/pkg/testing"
+	"agones.dev/agones/pkg/util/connectiontoken"
 	"agones.dev/agones/pkg/util/runtime"
 	"agones.dev/agones/test/e2e/framework"
 	"github.com/heptiolabs/healthcheck"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
@@ -95,7 +103,7 @@ func TestAllocatorAllocate(t *testing.T) {
 	errs := gsa.Validate()
 	require.Len(t, errs, 0)
 
-	gs, err := a.allocate(ctx, &gsa)
+	gs, err := a.allocate(ctx, &gsa, localAllocationSource)
 	require.NoError(t, err)
 	assert.Equal(t, agonesv1.GameServerStateAllocated, gs.Status.State)
 	assert.True(t, updated)
@@ -111,24 +119,73 @@ func TestAllocatorAllocate(t *testing.T) {
 	}
 
 	updated = false
-	gs, err = a.allocate(ctx, &gsa)
+	gs, err = a.allocate(ctx, &gsa, localAllocationSource)
 	require.NoError(t, err)
 	assert.Equal(t, agonesv1.GameServerStateAllocated, gs.Status.State)
 	assert.True(t, updated)
 
 	updated = false
-	gs, err = a.allocate(ctx, &gsa)
+	gs, err = a.allocate(ctx, &gsa, localAllocationSource)
 	require.NoError(t, err)
 	assert.Equal(t, agonesv1.GameServerStateAllocated, gs.Status.State)
 	assert.True(t, updated)
 
 	updated = false
-	_, err = a.allocate(ctx, &gsa)
+	_, err = a.allocate(ctx, &gsa, localAllocationSource)
 	require.Error(t, err)
 	assert.Equal(t, ErrNoGameServer, err)
 	assert.False(t, updated)
 }
 
+func TestCandidatesKey(t *testing.T) {
+	t.Parallel()
+
+	newGSA := func(ns, fleet string) *allocationv1.GameServerAllocation {
+		gsa := &allocationv1.GameServerAllocation{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns},
+			Spec: allocationv1.GameServerAllocationSpec{
+				Selectors: []allocationv1.GameServerSelector{{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{agonesv1.FleetNameLabel: fleet}}}},
+			},
+		}
+		gsa.ApplyDefaults()
+		return gsa
+	}
+
+	key := func(gsa *allocationv1.GameServerAllocation) uint64 {
+		k, err := candidatesKey(gsa)
+		require.NoError(t, err)
+		return k
+	}
+
+	blue := key(newGSA(defaultNs, "blue"))
+	assert.Equal(t, blue, key(newGSA(defaultNs, "blue")))
+	assert.NotEqual(t, blue, key(newGSA(defaultNs, "green")))
+	assert.NotEqual(t, blue, key(newGSA("other", "blue")))
+
+	distributed := newGSA(defaultNs, "blue")
+	distributed.Spec.Scheduling = apis.Distributed
+	assert.NotEqual(t, blue, key(distributed))
+
+	// allocation metadata does not change the candidates
+	patched := newGSA(defaultNs, "blue")
+	patched.Spec.MetaPatch.Labels = map[string]string{"mode": "deathmatch"}
+	assert.Equal(t, blue, key(patched))
+
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureCrossNamespaceAllocation)))
+
+	// the namespaces searched, and their order, change the candidates
+	crossNamespace := newGSA(defaultNs, "blue")
+	crossNamespace.Spec.Namespaces = []string{defaultNs}
+	assert.Equal(t, blue, key(crossNamespace))
+	crossNamespace.Spec.Namespaces = []string{defaultNs, "other"}
+	assert.NotEqual(t, blue, key(crossNamespace))
+	reversed := crossNamespace.DeepCopy()
+	reversed.Spec.Namespaces = []string{"other", defaultNs}
+	assert.NotEqual(t, key(crossNamespace), key(reversed))
+}
+
 func TestAllocatorAllocatePriority(t *testing.T) {
 	t.Parallel()
 
@@ -185,27 +242,27 @@ func TestAllocatorAllocatePriority(t *testing.T) {
 	run(t, "packed", func(t *testing.T, a *Allocator, gas *allocationv1.GameServerAllocation) {
 		ctx := context.Background()
 		// priority should be node1, then node2
-		gs1, err := a.allocate(ctx, gas)
+		gs1, err := a.allocate(ctx, gas, localAllocationSource)
 		assert.NoError(t, err)
 		assert.Equal(t, n1, gs1.Status.NodeName)
 
-		gs2, err := a.allocate(ctx, gas)
+		gs2, err := a.allocate(ctx, gas, localAllocationSource)
 		assert.NoError(t, err)
 		assert.Equal(t, n1, gs2.Status.NodeName)
 		assert.NotEqual(t, gs1.ObjectMeta.Name, gs2.ObjectMeta.Name)
 
-		gs3, err := a.allocate(ctx, gas)
+		gs3, err := a.allocate(ctx, gas, localAllocationSource)
 		assert.NoError(t, err)
 		assert.Equal(t, n1, gs3.Status.NodeName)
 		assert.NotContains(t, []string{gs1.ObjectMeta.Name, gs2.ObjectMeta.Name}, gs3.ObjectMeta.Name)
 
-		gs4, err := a.allocate(ctx, gas)
+		gs4, err := a.allocate(ctx, gas, localAllocationSource)
 		assert.NoError(t, err)
 		assert.Equal(t, n2, gs4.Status.NodeName)
 		assert.NotContains(t, []string{gs1.ObjectMeta.Name, gs2.ObjectMeta.Name, gs3.ObjectMeta.Name}, gs4.ObjectMeta.Name)
 
 		// should have none left
-		_, err = a.allocate(ctx, gas)
+		_, err = a.allocate(ctx, gas, localAllocationSource)
 		assert.Equal(t, err, ErrNoGameServer)
 	})
 
@@ -217,23 +274,23 @@ func TestAllocatorAllocatePriority(t *testing.T) {
 		// distributed is randomised, so no set pattern
 		ctx := context.Background()
 
-		gs1, err := a.allocate(ctx, gas)
+		gs1, err := a.allocate(ctx, gas, localAllocationSource)
 		assert.NoError(t, err)
 
-		gs2, err := a.allocate(ctx, gas)
+		gs2, err := a.allocate(ctx, gas, localAllocationSource)
 		assert.NoError(t, err)
 		assert.NotEqual(t, gs1.ObjectMeta.Name, gs2.ObjectMeta.Name)
 
-		gs3, err := a.allocate(ctx, gas)
+		gs3, err := a.allocate(ctx, gas, localAllocationSource)
 		assert.NoError(t, err)
 		assert.NotContains(t, []string{gs1.ObjectMeta.Name, gs2.ObjectMeta.Name}, gs3.ObjectMeta.Name)
 
-		gs4, err := a.allocate(ctx, gas)
+		gs4, err := a.allocate(ctx, gas, localAllocationSource)
 		assert.NoError(t, err)
 		assert.NotContains(t, []string{gs1.ObjectMeta.Name, gs2.ObjectMeta.Name, gs3.ObjectMeta.Name}, gs4.ObjectMeta.Name)
 
 		// should have none left
-		_, err = a.allocate(ctx, gas)
+		_, err = a.allocate(ctx, gas, localAllocationSource)
 		assert.Equal(t, err, ErrNoGameServer)
 	})
 }
@@ -251,19 +308,20 @@ func TestAllocatorApplyAllocationToGameServer(t *testing.T) {
 
 	allocator := NewAllocator(m.AgonesInformerFactory.Multicluster().V1().GameServerAllocationPolicies(),
 		m.KubeInformerFactory.Core().V1().Secrets(),
+		m.KubeInformerFactory.Core().V1().Namespaces(),
 		m.AgonesClient.AgonesV1(), m.KubeClient,
 		NewAllocationCache(m.AgonesInformerFactory.Agones().V1().GameServers(), gameservers.NewPerNodeCounter(m.KubeInformerFactory, m.AgonesInformerFactory), healthcheck.NewHandler()),
-		time.Second, 5*time.Second, 500*time.Millisecond,
+		time.Second, 5*time.Second, 500*time.Millisecond, nil,
 	)
 
-	gs, err := allocator.applyAllocationToGameServer(ctx, allocationv1.MetaPatch{}, &agonesv1.GameServer{}, &allocationv1.GameServerAllocation{})
+	gs, err := allocator.applyAllocationToGameServer(ctx, allocationv1.MetaPatch{}, &agonesv1.GameServer{}, &allocationv1.GameServerAllocation{}, localAllocationSource)
 	assert.NoError(t, err)
 	assert.Equal(t, agonesv1.GameServerStateAllocated, gs.Status.State)
 	assert.NotNil(t, gs.ObjectMeta.Annotations["agones.dev/last-allocated"])
 	var ts time.Time
 	assert.NoError(t, ts.UnmarshalText([]byte(gs.ObjectMeta.Annotations[LastAllocatedAnnotationKey])))
 
-	gs, err = allocator.applyAllocationToGameServer(ctx, allocationv1.MetaPatch{Labels: map[string]string{"foo": "bar"}}, &agonesv1.GameServer{}, &allocationv1.GameServerAllocation{})
+	gs, err = allocator.applyAllocationToGameServer(ctx, allocationv1.MetaPatch{Labels: map[string]string{"foo": "bar"}}, &agonesv1.GameServer{}, &allocationv1.GameServerAllocation{}, localAllocationSource)
 	assert.NoError(t, err)
 	assert.Equal(t, agonesv1.GameServerStateAllocated, gs.Status.State)
 	assert.Equal(t, "bar", gs.ObjectMeta.Labels["foo"])
@@ -271,7 +329,7 @@ func TestAllocatorApplyAllocationToGameServer(t *testing.T) {
 
 	gs, err = allocator.applyAllocationToGameServer(ctx,
 		allocationv1.MetaPatch{Labels: map[string]string{"foo": "bar"}, Annotations: map[string]string{"bar": "foo"}},
-		&agonesv1.GameServer{}, &allocationv1.GameServerAllocation{})
+		&agonesv1.GameServer{}, &allocationv1.GameServerAllocation{}, localAllocationSource)
 	assert.NoError(t, err)
 	assert.Equal(t, agonesv1.GameServerStateAllocated, gs.Status.State)
 	assert.Equal(t, "bar", gs.ObjectMeta.Labels["foo"])
@@ -279,6 +337,109 @@ func TestAllocatorApplyAllocationToGameServer(t *testing.T) {
 	assert.NotNil(t, gs.ObjectMeta.Annotations[LastAllocatedAnnotationKey])
 }
 
+func TestAllocatorApplyAllocationToGameServerMetaPatchTemplates(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureMetaPatchTemplates)))
+
+	a, m := newFakeAllocator()
+	ctx := context.Background()
+	updated := false
+	m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		updated = true
+		ua := action.(k8stesting.UpdateAction)
+		gs := ua.GetObject().(*agonesv1.GameServer)
+		return true, gs, nil
+	})
+
+	gsa := &allocationv1.GameServerAllocation{Spec: allocationv1.GameServerAllocationSpec{
+		RequestID:          "abc",
+		TemplateParameters: map[string]string{"session": "1234"},
+	}}
+	mp := allocationv1.MetaPatch{
+		Labels: map[string]string{"session": "{{ .Parameters.session }}", "allocated-at": "{{ .AllocationTime.Unix }}"},
+		Annotations: map[string]string{
+			"request": "{{ .RequestID }}",
+			"source":  "{{ .Source }}",
+			"node":    "{{ .GameServer.Status.NodeName }}",
+			"state":   "{{ .GameServer.Status.State }}",
+		},
+	}
+
+	gs, err := a.applyAllocationToGameServer(ctx, mp, &agonesv1.GameServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "gs1", Namespace: defaultNs},
+		Status:     agonesv1.GameServerStatus{NodeName: "node1", State: agonesv1.GameServerStateReady},
+	}, gsa, "cluster1")
+	require.NoError(t, err)
+	assert.True(t, updated)
+	assert.Equal(t, "1234", gs.ObjectMeta.Labels["session"])
+	assert.Equal(t, "abc", gs.ObjectMeta.Annotations["request"])
+	assert.Equal(t, "cluster1", gs.ObjectMeta.Annotations["source"])
+	assert.Equal(t, "node1", gs.ObjectMeta.Annotations["node"])
+	// templates see the GameServer before it is allocated
+	assert.Equal(t, string(agonesv1.GameServerStateReady), gs.ObjectMeta.Annotations["state"])
+
+	// the allocation time is the same as the last allocated time
+	var ts time.Time
+	require.NoError(t, ts.UnmarshalText([]byte(gs.ObjectMeta.Annotations[LastAllocatedAnnotationKey])))
+	assert.Equal(t, fmt.Sprint(ts.Unix()), gs.ObjectMeta.Labels["allocated-at"])
+
+	// the rendered values are recorded, so that a release only reverts them
+	assert.JSONEq(t, fmt.Sprintf(`{"labels":{"session":"1234","allocated-at":"%d"},"annotations":{"request":"abc","source":"cluster1","node":"node1","state":"Ready"}}`, ts.Unix()),
+		gs.ObjectMeta.Annotations[MetaPatchTemplatesAnnotationKey])
+
+	// and the record is removed by an allocation without templates
+	gs, err = a.applyAllocationToGameServer(ctx, allocationv1.MetaPatch{Labels: map[string]string{"session": "5678"}}, gs, gsa, localAllocationSource)
+	require.NoError(t, err)
+	assert.NotContains(t, gs.ObjectMeta.Annotations, MetaPatchTemplatesAnnotationKey)
+
+	// a template that results in an invalid label is not applied
+	updated = false
+	mp.Labels["allocated-at"] = "{{ .AllocationTime }}"
+	gs, err = a.applyAllocationToGameServer(ctx, mp, &agonesv1.GameServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "gs2", Namespace: defaultNs},
+		Status:     agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady},
+	}, gsa, localAllocationSource)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.False(t, updated)
+	assert.Equal(t, agonesv1.GameServerStateReady, gs.Status.State)
+	assert.Empty(t, gs.ObjectMeta.Labels)
+}
+
+func TestAllocatorApplyAllocationToGameServerPayload(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	a, m := newFakeAllocator()
+	ctx := context.Background()
+	m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		ua := action.(k8stesting.UpdateAction)
+		gs := ua.GetObject().(*agonesv1.GameServer)
+		return true, gs, nil
+	})
+
+	payload := []byte(`{"teams":2,"seed":1234}`)
+	gsa := &allocationv1.GameServerAllocation{Spec: allocationv1.GameServerAllocationSpec{Payload: payload}}
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=false", runtime.FeatureAllocationPayload)))
+	gs, err := a.applyAllocationToGameServer(ctx, allocationv1.MetaPatch{}, &agonesv1.GameServer{}, gsa, localAllocationSource)
+	require.NoError(t, err)
+	assert.Nil(t, gs.Status.AllocationPayload)
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureAllocationPayload)))
+	gs, err = a.applyAllocationToGameServer(ctx, allocationv1.MetaPatch{}, &agonesv1.GameServer{}, gsa, localAllocationSource)
+	require.NoError(t, err)
+	assert.Equal(t, payload, gs.Status.AllocationPayload)
+
+	// re-allocating without a payload clears the payload of the previous allocation
+	gs, err = a.applyAllocationToGameServer(ctx, allocationv1.MetaPatch{}, gs, &allocationv1.GameServerAllocation{}, localAllocationSource)
+	require.NoError(t, err)
+	assert.Nil(t, gs.Status.AllocationPayload)
+}
+
 func TestAllocatorApplyAllocationToGameServerCountsListsActions(t *testing.T) {
 	t.Parallel()
 
@@ -294,9 +455,10 @@ func TestAllocatorApplyAllocationToGameServerCountsListsActions(t *testing.T) {
 
 	allocator := NewAllocator(m.AgonesInformerFactory.Multicluster().V1().GameServerAllocationPolicies(),
 		m.KubeInformerFactory.Core().V1().Secrets(),
+		m.KubeInformerFactory.Core().V1().Namespaces(),
 		m.AgonesClient.AgonesV1(), m.KubeClient,
 		NewAllocationCache(m.AgonesInformerFactory.Agones().V1().GameServers(), gameservers.NewPerNodeCounter(m.KubeInformerFactory, m.AgonesInformerFactory), healthcheck.NewHandler()),
-		time.Second, 5*time.Second, 500*time.Millisecond,
+		time.Second, 5*time.Second, 500*time.Millisecond, nil,
 	)
 
 	ONE := int64(1)
@@ -411,7 +573,7 @@ func TestAllocatorApplyAllocationToGameServerCountsListsActions(t *testing.T) {
 			// we always set the feature flag in all these tests, so always process it.
 			require.NoError(t, runtime.ParseFeatures(testScenario.features))
 
-			foundGs, err := allocator.applyAllocationToGameServer(ctx, mp, testScenario.gs, testScenario.gsa)
+			foundGs, err := allocator.applyAllocationToGameServer(ctx, mp, testScenario.gs, testScenario.gsa, localAllocationSource)
 			assert.NoError(t, err)
 			for counter, counterStatus := range testScenario.wantCounters {
 				if gsCounter, ok := foundGs.Status.Counters[counter]; ok {
@@ -438,12 +600,13 @@ func TestAllocationApplyAllocationError(t *testing.T) {
 
 	allocator := NewAllocator(m.AgonesInformerFactory.Multicluster().V1().GameServerAllocationPolicies(),
 		m.KubeInformerFactory.Core().V1().Secrets(),
+		m.KubeInformerFactory.Core().V1().Namespaces(),
 		m.AgonesClient.AgonesV1(), m.KubeClient,
 		NewAllocationCache(m.AgonesInformerFactory.Agones().V1().GameServers(), gameservers.NewPerNodeCounter(m.KubeInformerFactory, m.AgonesInformerFactory), healthcheck.NewHandler()),
-		time.Second, 5*time.Second, 500*time.Millisecond,
+		time.Second, 5*time.Second, 500*time.Millisecond, nil,
 	)
 
-	gsa, err := allocator.applyAllocationToGameServer(ctx, allocationv1.MetaPatch{}, &agonesv1.GameServer{}, &allocationv1.GameServerAllocation{})
+	gsa, err := allocator.applyAllocationToGameServer(ctx, allocationv1.MetaPatch{}, &agonesv1.GameServer{}, &allocationv1.GameServerAllocation{}, localAllocationSource)
 	logrus.WithError(err).WithField("gsa", gsa).WithField("test", t.Name()).Info("Allocation should fail")
 	assert.Error(t, err)
 }
@@ -493,7 +656,7 @@ func TestAllocatorAllocateOnGameServerUpdateError(t *testing.T) {
 	require.Len(t, gsa.Spec.Selectors, 1)
 
 	// try the private method
-	_, err := a.allocate(ctx, gsa.DeepCopy())
+	_, err := a.allocate(ctx, gsa.DeepCopy(), localAllocationSource)
 	log.WithError(err).Info("allocate (private): failed allocation")
 	require.NotEqual(t, ErrNoGameServer, err)
 	require.EqualError(t, err, ErrGameServerUpdateConflict.Error())
@@ -514,6 +677,70 @@ func TestAllocatorAllocateOnGameServerUpdateError(t *testing.T) {
 	require.EqualError(t, err, ErrGameServerUpdateConflict.Error())
 }
 
+func TestAllocatorAllocateConnectionToken(t *testing.T) {
+	t.Parallel()
+
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureConnectionTokens)))
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	signer, err := connectiontoken.NewSigner(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes}), time.Minute)
+	require.NoError(t, err)
+	jwks, err := signer.JWKS()
+	require.NoError(t, err)
+
+	a, m := newFakeAllocator()
+	f, gsList := defaultFixtures(2)
+	gsWatch := watch.NewFake()
+	m.AgonesClient.AddWatchReactor("gameservers", k8stesting.DefaultWatchReactor(gsWatch, nil))
+	m.AgonesClient.AddReactor("list", "gameservers", func(_ k8stesting.Action) (bool, k8sruntime.Object, error) {
+		return true, &agonesv1.GameServerList{Items: gsList}, nil
+	})
+	m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+		gsWatch.Modify(gs)
+		return true, gs, nil
+	})
+
+	ctx, cancel := agtesting.StartInformers(m, a.allocationCache.gameServerSynced)
+	defer cancel()
+
+	require.NoError(t, a.Run(ctx))
+	require.Eventuallyf(t, func() bool {
+		return a.allocationCache.cache.Len() == len(gsList)
+	}, 10*time.Second, time.Second, fmt.Sprintf("should be %d items in the cache", len(gsList)))
+
+	gsa := allocationv1.GameServerAllocation{ObjectMeta: metav1.ObjectMeta{Name: "gsa-1", Namespace: defaultNs},
+		Spec: allocationv1.GameServerAllocationSpec{
+			Selectors:       []allocationv1.GameServerSelector{{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{agonesv1.FleetNameLabel: f.ObjectMeta.Name}}}},
+			ConnectionToken: &allocationv1.ConnectionTokenRequest{PlayerIDs: []string{"player1"}},
+		}}
+	gsa.ApplyDefaults()
+	require.Len(t, gsa.Validate(), 0)
+
+	// no signing key
+	_, err = a.Allocate(ctx, gsa.DeepCopy())
+	require.Equal(t, ErrConnectionTokensNotConfigured, err)
+
+	a.tokenSigner = signer
+	result, err := a.Allocate(ctx, gsa.DeepCopy())
+	require.NoError(t, err)
+	out := result.(*allocationv1.GameServerAllocation)
+	require.Equal(t, allocationv1.GameServerAllocationAllocated, out.Status.State)
+	require.NotEmpty(t, out.Status.ConnectionToken)
+
+	v, err := connectiontoken.NewVerifier(defaultNs, out.Status.GameServerName, jwks)
+	require.NoError(t, err)
+	claims, err := v.Verify(out.Status.ConnectionToken)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"player1"}, claims.PlayerIDs)
+	assert.Equal(t, out.Status.Address, claims.Address)
+}
+
 func TestAllocatorRunLocalAllocations(t *testing.T) {
 	t.Parallel()
 
@@ -1090,12 +1317,14 @@ func newFakeAllocator() (*Allocator, agtesting.Mocks) {
 	a := NewAllocator(
 		m.AgonesInformerFactory.Multicluster().V1().GameServerAllocationPolicies(),
 		m.KubeInformerFactory.Core().V1().Secrets(),
+		m.KubeInformerFactory.Core().V1().Namespaces(),
 		m.AgonesClient.AgonesV1(),
 		m.KubeClient,
 		NewAllocationCache(m.AgonesInformerFactory.Agones().V1().GameServers(), counter, healthcheck.NewHandler()),
 		time.Second,
 		5*time.Second,
-		500*time.Millisecond)
+		500*time.Millisecond,
+		nil)
 	a.recorder = m.FakeRecorder
 
 	return a, m
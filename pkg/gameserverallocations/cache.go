@@ -15,17 +15,147 @@
 package gameserverallocations
 
 import (
+	"math/bits"
 	"sync"
 
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"agones.dev/agones/pkg/util/runtime"
 )
 
+// keySet is a set of cache keys
+type keySet map[string]struct{}
+
 // gameserver cache to keep the Ready state gameserver.
+// Alongside the GameServers, the cache maintains secondary indexes of their keys by namespace, state,
+// label and Counter / List available capacity, so that the candidates for a selector can be found
+// without scanning every cached GameServer.
 //
 //nolint:govet // ignore fieldalignment, singleton embedded in AllocationCache
 type gameServerCache struct {
 	mu    sync.RWMutex
 	cache map[string]*agonesv1.GameServer
+
+	byNamespace map[string]keySet
+	byState     map[agonesv1.GameServerState]keySet
+	byLabel     map[string]keySet
+	// byCounter and byList index keys by name, then by available capacity bucket
+	byCounter map[string]map[int]keySet
+	byList    map[string]map[int]keySet
+}
+
+// capacityBucket returns the power of two bucket of an available capacity, so that every
+// available capacity of at least n is in a bucket of at least capacityBucket(n)
+func capacityBucket(available int64) int {
+	if available <= 0 {
+		return 0
+	}
+	return bits.Len64(uint64(available))
+}
+
+// labelIndexKey returns the byLabel key of a label
+func labelIndexKey(k, v string) string {
+	return k + "=" + v
+}
+
+func addKey(m map[string]keySet, index, key string) {
+	set, ok := m[index]
+	if !ok {
+		set = keySet{}
+		m[index] = set
+	}
+	set[key] = struct{}{}
+}
+
+func removeKey(m map[string]keySet, index, key string) {
+	if set, ok := m[index]; ok {
+		delete(set, key)
+		if len(set) == 0 {
+			delete(m, index)
+		}
+	}
+}
+
+func addBucketKey(m map[string]map[int]keySet, name string, bucket int, key string) {
+	buckets, ok := m[name]
+	if !ok {
+		buckets = map[int]keySet{}
+		m[name] = buckets
+	}
+	set, ok := buckets[bucket]
+	if !ok {
+		set = keySet{}
+		buckets[bucket] = set
+	}
+	set[key] = struct{}{}
+}
+
+func removeBucketKey(m map[string]map[int]keySet, name string, bucket int, key string) {
+	buckets, ok := m[name]
+	if !ok {
+		return
+	}
+	if set, ok := buckets[bucket]; ok {
+		delete(set, key)
+		if len(set) == 0 {
+			delete(buckets, bucket)
+		}
+	}
+	if len(buckets) == 0 {
+		delete(m, name)
+	}
+}
+
+// init lazily creates the cache and its indexes. Must be called with the write lock held.
+func (e *gameServerCache) init() {
+	if e.cache == nil {
+		e.cache = map[string]*agonesv1.GameServer{}
+		e.byNamespace = map[string]keySet{}
+		e.byState = map[agonesv1.GameServerState]keySet{}
+		e.byLabel = map[string]keySet{}
+		e.byCounter = map[string]map[int]keySet{}
+		e.byList = map[string]map[int]keySet{}
+	}
+}
+
+// index adds the key of gs to every index. Must be called with the write lock held.
+func (e *gameServerCache) index(key string, gs *agonesv1.GameServer) {
+	addKey(e.byNamespace, gs.ObjectMeta.Namespace, key)
+	state, ok := e.byState[gs.Status.State]
+	if !ok {
+		state = keySet{}
+		e.byState[gs.Status.State] = state
+	}
+	state[key] = struct{}{}
+	for k, v := range gs.ObjectMeta.Labels {
+		addKey(e.byLabel, labelIndexKey(k, v), key)
+	}
+	for name, c := range gs.Status.Counters {
+		addBucketKey(e.byCounter, name, capacityBucket(c.Capacity-c.Count), key)
+	}
+	for name, l := range gs.Status.Lists {
+		addBucketKey(e.byList, name, capacityBucket(l.Capacity-int64(len(l.Values))), key)
+	}
+}
+
+// unindex removes the key of gs from every index. Must be called with the write lock held.
+func (e *gameServerCache) unindex(key string, gs *agonesv1.GameServer) {
+	removeKey(e.byNamespace, gs.ObjectMeta.Namespace, key)
+	if state, ok := e.byState[gs.Status.State]; ok {
+		delete(state, key)
+		if len(state) == 0 {
+			delete(e.byState, gs.Status.State)
+		}
+	}
+	for k, v := range gs.ObjectMeta.Labels {
+		removeKey(e.byLabel, labelIndexKey(k, v), key)
+	}
+	for name, c := range gs.Status.Counters {
+		removeBucketKey(e.byCounter, name, capacityBucket(c.Capacity-c.Count), key)
+	}
+	for name, l := range gs.Status.Lists {
+		removeBucketKey(e.byList, name, capacityBucket(l.Capacity-int64(len(l.Values))), key)
+	}
 }
 
 // Store saves the data in the cache.
@@ -36,20 +166,22 @@ type gameServerCache struct {
 func (e *gameServerCache) Store(key string, gs *agonesv1.GameServer) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
-	if e.cache == nil {
-		e.cache = map[string]*agonesv1.GameServer{}
-	}
+	e.init()
 
 	// Check if there's already a value for this key
-	if existingGS, ok := e.cache[key]; ok {
+	existingGS, ok := e.cache[key]
+	if ok {
 		// If the incoming gs object's Generation is less than the existing one, ignore it, but also check the ResourceVersion
 		// saves us a deep copy if we don't need to
 		if gs.ObjectMeta.Generation < existingGS.ObjectMeta.Generation || gs.ObjectMeta.ResourceVersion == existingGS.ObjectMeta.ResourceVersion {
 			return
 		}
+		e.unindex(key, existingGS)
 	}
 	// If the incoming gs object's Generation is greater, store it
-	e.cache[key] = gs.DeepCopy()
+	gs = gs.DeepCopy()
+	e.cache[key] = gs
+	e.index(key, gs)
 }
 
 // Delete deletes the data. If it exists returns true.
@@ -58,8 +190,9 @@ func (e *gameServerCache) Delete(key string) bool {
 	defer e.mu.Unlock()
 	ret := false
 	if e.cache != nil {
-		if _, ok := e.cache[key]; ok {
+		if gs, ok := e.cache[key]; ok {
 			delete(e.cache, key)
+			e.unindex(key, gs)
 			ret = true
 		}
 	}
@@ -93,3 +226,102 @@ func (e *gameServerCache) Len() int {
 	defer e.mu.RUnlock()
 	return len(e.cache)
 }
+
+// candidateSource is a union of index sets that every match of a selector is a member of
+type candidateSource []keySet
+
+func (c candidateSource) len() int {
+	n := 0
+	for _, set := range c {
+		n += len(set)
+	}
+	return n
+}
+
+func (c candidateSource) has(key string) bool {
+	for _, set := range c {
+		if _, ok := set[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// sources returns the index sets that the GameServers in namespace matching sel must be members of.
+// Returns false if no GameServer can match. Must be called with the read lock held.
+func (e *gameServerCache) sources(namespace string, sel *allocationv1.GameServerSelector) ([]candidateSource, bool) {
+	sources := []candidateSource{{e.byNamespace[namespace]}}
+	if sel.GameServerState != nil {
+		sources = append(sources, candidateSource{e.byState[*sel.GameServerState]})
+	}
+	for k, v := range sel.LabelSelector.MatchLabels {
+		sources = append(sources, candidateSource{e.byLabel[labelIndexKey(k, v)]})
+	}
+	if runtime.FeatureEnabled(runtime.FeatureCountsAndLists) {
+		for name, cs := range sel.Counters {
+			sources = append(sources, bucketSource(e.byCounter[name], cs.MinAvailable))
+		}
+		for name, ls := range sel.Lists {
+			sources = append(sources, bucketSource(e.byList[name], ls.MinAvailable))
+		}
+	}
+	for _, s := range sources {
+		if s.len() == 0 {
+			return nil, false
+		}
+	}
+	return sources, true
+}
+
+// bucketSource returns the capacity buckets that hold every available capacity of at least minAvailable
+func bucketSource(buckets map[int]keySet, minAvailable int64) candidateSource {
+	var source candidateSource
+	lowest := capacityBucket(minAvailable)
+	for bucket, set := range buckets {
+		if bucket >= lowest {
+			source = append(source, set)
+		}
+	}
+	return source
+}
+
+// Candidates returns the cached GameServers in namespace that may match at least one of the selectors,
+// found from the intersection of the indexes each selector constrains. Every GameServer that matches
+// a selector is returned, but the result is a superset of the matches, so selectors must still be
+// checked against each candidate.
+func (e *gameServerCache) Candidates(namespace string, selectors []allocationv1.GameServerSelector) []*agonesv1.GameServer {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	seen := keySet{}
+	var list []*agonesv1.GameServer
+	for i := range selectors {
+		sources, ok := e.sources(namespace, &selectors[i])
+		if !ok {
+			continue
+		}
+		// walk the smallest source, and check membership of the rest
+		smallest := 0
+		for j := range sources {
+			if sources[j].len() < sources[smallest].len() {
+				smallest = j
+			}
+		}
+		for _, set := range sources[smallest] {
+		keys:
+			for key := range set {
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				for j, s := range sources {
+					if j != smallest && !s.has(key) {
+						continue keys
+					}
+				}
+				seen[key] = struct{}{}
+				list = append(list, e.cache[key])
+			}
+		}
+	}
+	return list
+}
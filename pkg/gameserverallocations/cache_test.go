@@ -15,10 +15,14 @@
 package gameserverallocations
 
 import (
+	"sort"
 	"testing"
 
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"agones.dev/agones/pkg/util/runtime"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -96,3 +100,72 @@ func TestGameServerCacheEntryGeneration(t *testing.T) {
 	assert.Equal(t, int64(2), gs.Generation, "Generation should remain the same")
 	assert.Equal(t, "4", gs.ResourceVersion, "Should replace with different ResourceVersion")
 }
+
+func TestGameServerCacheCandidates(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(string(runtime.FeatureCountsAndLists)+"=true"))
+
+	ready := agonesv1.GameServerStateReady
+	allocated := agonesv1.GameServerStateAllocated
+	newGS := func(name, ns, fleet string, state agonesv1.GameServerState, available int64) *agonesv1.GameServer {
+		return &agonesv1.GameServer{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns, ResourceVersion: "1", Labels: map[string]string{agonesv1.FleetNameLabel: fleet}},
+			Status: agonesv1.GameServerStatus{
+				State:    state,
+				Counters: map[string]agonesv1.CounterStatus{"rooms": {Count: 10 - available, Capacity: 10}},
+				Lists:    map[string]agonesv1.ListStatus{"players": {Capacity: available}},
+			},
+		}
+	}
+
+	cache := gameServerCache{}
+	for _, gs := range []*agonesv1.GameServer{
+		newGS("gs1", defaultNs, "blue", ready, 0),
+		newGS("gs2", defaultNs, "blue", ready, 3),
+		newGS("gs3", defaultNs, "blue", allocated, 8),
+		newGS("gs4", defaultNs, "green", ready, 10),
+		newGS("gs5", "other", "blue", ready, 10),
+	} {
+		cache.Store(gs.ObjectMeta.Namespace+"/"+gs.ObjectMeta.Name, gs)
+	}
+
+	names := func(selectors ...allocationv1.GameServerSelector) []string {
+		result := []string{}
+		for _, gs := range cache.Candidates(defaultNs, selectors) {
+			result = append(result, gs.ObjectMeta.Name)
+		}
+		sort.Strings(result)
+		return result
+	}
+	fleet := func(name string) metav1.LabelSelector {
+		return metav1.LabelSelector{MatchLabels: map[string]string{agonesv1.FleetNameLabel: name}}
+	}
+
+	assert.Equal(t, []string{"gs1", "gs2", "gs3", "gs4"}, names(allocationv1.GameServerSelector{}))
+	assert.Equal(t, []string{"gs1", "gs2"}, names(allocationv1.GameServerSelector{LabelSelector: fleet("blue"), GameServerState: &ready}))
+	assert.Equal(t, []string{"gs3"}, names(allocationv1.GameServerSelector{LabelSelector: fleet("blue"), GameServerState: &allocated}))
+	assert.Equal(t, []string{}, names(allocationv1.GameServerSelector{LabelSelector: fleet("red")}))
+	assert.Equal(t, []string{"gs3", "gs4"}, names(allocationv1.GameServerSelector{LabelSelector: fleet("green")}, allocationv1.GameServerSelector{GameServerState: &allocated}))
+
+	// capacity buckets include every available capacity of at least MinAvailable, and may include some less
+	counters := names(allocationv1.GameServerSelector{Counters: map[string]allocationv1.CounterSelector{"rooms": {MinAvailable: 8}}})
+	assert.Subset(t, counters, []string{"gs3", "gs4"})
+	assert.NotContains(t, counters, "gs1")
+	assert.NotContains(t, counters, "gs2")
+	lists := names(allocationv1.GameServerSelector{Lists: map[string]allocationv1.ListSelector{"players": {MinAvailable: 1}}})
+	assert.Equal(t, []string{"gs2", "gs3", "gs4"}, lists)
+	assert.Equal(t, []string{}, names(allocationv1.GameServerSelector{Counters: map[string]allocationv1.CounterSelector{"missing": {}}}))
+
+	// indexes follow updates and deletes
+	moved := newGS("gs2", defaultNs, "green", allocated, 3)
+	moved.ObjectMeta.ResourceVersion = "2"
+	cache.Store(defaultNs+"/gs2", moved)
+	assert.Equal(t, []string{"gs1"}, names(allocationv1.GameServerSelector{LabelSelector: fleet("blue"), GameServerState: &ready}))
+	assert.Equal(t, []string{"gs2"}, names(allocationv1.GameServerSelector{LabelSelector: fleet("green"), GameServerState: &allocated}))
+
+	cache.Delete(defaultNs + "/gs1")
+	assert.Equal(t, []string{}, names(allocationv1.GameServerSelector{LabelSelector: fleet("blue"), GameServerState: &ready}))
+	assert.Empty(t, cache.byState[ready][defaultNs+"/gs1"])
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserverallocations
+
+import (
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	listerv1 "agones.dev/agones/pkg/client/listers/agones/v1"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// QueryCapacity counts the GameServers in the allocation cache that match each selector of the
+// GameServerCapacityQuery, without allocating any of them. Returns a Status if the query is invalid.
+func (c *Allocator) QueryCapacity(query *allocationv1.GameServerCapacityQuery) (k8sruntime.Object, error) {
+	if errs := query.Validate(); len(errs) > 0 {
+		return invalidStatus("GameServerCapacityQuery", query.Name, errs)
+	}
+
+	countCapacity(query, func(sel *allocationv1.GameServerSelector) []*agonesv1.GameServer {
+		return c.allocationCache.ListCandidates(query.ObjectMeta.Namespace, []allocationv1.GameServerSelector{*sel})
+	})
+	return query, nil
+}
+
+// QueryGameServerCapacity counts the GameServers that match each selector of the GameServerCapacityQuery
+// from the GameServer informer cache of its namespace, for when there is no allocation cache to count from.
+// Returns a Status if the query is invalid.
+func QueryGameServerCapacity(gameServerLister listerv1.GameServerLister, query *allocationv1.GameServerCapacityQuery) (k8sruntime.Object, error) {
+	if errs := query.Validate(); len(errs) > 0 {
+		return invalidStatus("GameServerCapacityQuery", query.Name, errs)
+	}
+
+	list, err := gameServerLister.GameServers(query.ObjectMeta.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not list GameServers in namespace %s", query.ObjectMeta.Namespace)
+	}
+	gameServers := make([]*agonesv1.GameServer, 0, len(list))
+	for _, gs := range list {
+		if !gs.IsBeingDeleted() {
+			gameServers = append(gameServers, gs)
+		}
+	}
+
+	countCapacity(query, func(_ *allocationv1.GameServerSelector) []*agonesv1.GameServer {
+		return gameServers
+	})
+	return query, nil
+}
+
+// countCapacity sets the status of the query to the number of GameServers that match each of its selectors,
+// where candidates returns the GameServers that may match a selector.
+func countCapacity(query *allocationv1.GameServerCapacityQuery, candidates func(*allocationv1.GameServerSelector) []*agonesv1.GameServer) {
+	grouped := query.Spec.GroupByNode || query.Spec.GroupByLabel != ""
+	query.Status.Selectors = make([]allocationv1.SelectorCapacity, len(query.Spec.Selectors))
+
+	for i := range query.Spec.Selectors {
+		sel := &query.Spec.Selectors[i]
+		capacity := &query.Status.Selectors[i]
+		if grouped {
+			capacity.Groups = map[string]int64{}
+		}
+
+		for _, gs := range candidates(sel) {
+			if gs.ObjectMeta.Namespace != query.ObjectMeta.Namespace || !sel.Matches(gs) {
+				continue
+			}
+			capacity.Count++
+			switch {
+			case query.Spec.GroupByNode:
+				capacity.Groups[gs.Status.NodeName]++
+			case query.Spec.GroupByLabel != "":
+				capacity.Groups[gs.ObjectMeta.Labels[query.Spec.GroupByLabel]]++
+			}
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserverallocations
+
+import (
+	"net/http"
+	"testing"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	agtesting "agones.dev/agones/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func capacityFixtures() []agonesv1.GameServer {
+	newGS := func(name, ns, fleet, node string, state agonesv1.GameServerState) agonesv1.GameServer {
+		return agonesv1.GameServer{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns, ResourceVersion: "1", Labels: map[string]string{agonesv1.FleetNameLabel: fleet}},
+			Status:     agonesv1.GameServerStatus{NodeName: node, State: state},
+		}
+	}
+	return []agonesv1.GameServer{
+		newGS("gs1", defaultNs, "blue", "node1", agonesv1.GameServerStateReady),
+		newGS("gs2", defaultNs, "blue", "node2", agonesv1.GameServerStateReady),
+		newGS("gs3", defaultNs, "blue", "node2", agonesv1.GameServerStateAllocated),
+		newGS("gs4", defaultNs, "green", "node1", agonesv1.GameServerStateReady),
+		newGS("gs5", "other", "blue", "node1", agonesv1.GameServerStateReady),
+	}
+}
+
+func TestCountCapacity(t *testing.T) {
+	t.Parallel()
+
+	allocated := agonesv1.GameServerStateAllocated
+	fleet := func(name string) allocationv1.GameServerSelector {
+		return allocationv1.GameServerSelector{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{agonesv1.FleetNameLabel: name}}}
+	}
+
+	fixtures := map[string]struct {
+		spec allocationv1.GameServerCapacityQuerySpec
+		want []allocationv1.SelectorCapacity
+	}{
+		"default selector": {
+			want: []allocationv1.SelectorCapacity{{Count: 3}},
+		},
+		"fleet selectors": {
+			spec: allocationv1.GameServerCapacityQuerySpec{
+				Selectors: []allocationv1.GameServerSelector{fleet("blue"), fleet("green"), fleet("red")},
+			},
+			want: []allocationv1.SelectorCapacity{{Count: 2}, {Count: 1}, {Count: 0}},
+		},
+		"allocated state": {
+			spec: allocationv1.GameServerCapacityQuerySpec{
+				Selectors: []allocationv1.GameServerSelector{{GameServerState: &allocated}},
+			},
+			want: []allocationv1.SelectorCapacity{{Count: 1}},
+		},
+		"group by label": {
+			spec: allocationv1.GameServerCapacityQuerySpec{GroupByLabel: agonesv1.FleetNameLabel},
+			want: []allocationv1.SelectorCapacity{{Count: 3, Groups: map[string]int64{"blue": 2, "green": 1}}},
+		},
+		"group by node": {
+			spec: allocationv1.GameServerCapacityQuerySpec{
+				Selectors:   []allocationv1.GameServerSelector{fleet("blue"), fleet("red")},
+				GroupByNode: true,
+			},
+			want: []allocationv1.SelectorCapacity{{Count: 2, Groups: map[string]int64{"node1": 1, "node2": 1}}, {Count: 0, Groups: map[string]int64{}}},
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			list := capacityFixtures()
+			gameServers := make([]*agonesv1.GameServer, len(list))
+			for i := range list {
+				gameServers[i] = &list[i]
+			}
+
+			query := &allocationv1.GameServerCapacityQuery{ObjectMeta: metav1.ObjectMeta{Namespace: defaultNs}, Spec: v.spec}
+			query.ApplyDefaults()
+			countCapacity(query, func(_ *allocationv1.GameServerSelector) []*agonesv1.GameServer {
+				return gameServers
+			})
+			assert.Equal(t, v.want, query.Status.Selectors)
+		})
+	}
+}
+
+func TestAllocatorQueryCapacity(t *testing.T) {
+	t.Parallel()
+
+	a, _ := newFakeAllocator()
+	for _, gs := range capacityFixtures() {
+		a.allocationCache.AddGameServer(&gs)
+	}
+
+	query := &allocationv1.GameServerCapacityQuery{
+		ObjectMeta: metav1.ObjectMeta{Namespace: defaultNs},
+		Spec: allocationv1.GameServerCapacityQuerySpec{
+			Selectors:    []allocationv1.GameServerSelector{{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{agonesv1.FleetNameLabel: "blue"}}}},
+			GroupByLabel: agonesv1.FleetNameLabel,
+		},
+	}
+	query.ApplyDefaults()
+
+	result, err := a.QueryCapacity(query)
+	require.NoError(t, err)
+	require.IsType(t, &allocationv1.GameServerCapacityQuery{}, result)
+	assert.Equal(t, []allocationv1.SelectorCapacity{{Count: 2, Groups: map[string]int64{"blue": 2}}}, result.(*allocationv1.GameServerCapacityQuery).Status.Selectors)
+
+	// counting does not remove anything from the cache
+	assert.Equal(t, 5, a.allocationCache.cache.Len())
+
+	query.Spec.GroupByNode = true
+	result, err = a.QueryCapacity(query)
+	require.NoError(t, err)
+	require.IsType(t, &metav1.Status{}, result)
+	assert.Equal(t, int32(http.StatusUnprocessableEntity), result.(*metav1.Status).Code)
+}
+
+func TestQueryGameServerCapacity(t *testing.T) {
+	t.Parallel()
+
+	_, m := newFakeAllocator()
+	list := capacityFixtures()
+	deleted := list[0].DeepCopy()
+	deleted.ObjectMeta.Name = "deleted"
+	now := metav1.Now()
+	deleted.ObjectMeta.DeletionTimestamp = &now
+	list = append(list, *deleted)
+
+	m.AgonesClient.AddReactor("list", "gameservers", func(_ k8stesting.Action) (bool, k8sruntime.Object, error) {
+		return true, &agonesv1.GameServerList{Items: list}, nil
+	})
+	gameServers := m.AgonesInformerFactory.Agones().V1().GameServers()
+	_, cancel := agtesting.StartInformers(m, gameServers.Informer().HasSynced)
+	defer cancel()
+
+	query := &allocationv1.GameServerCapacityQuery{
+		ObjectMeta: metav1.ObjectMeta{Namespace: defaultNs},
+		Spec:       allocationv1.GameServerCapacityQuerySpec{GroupByNode: true},
+	}
+	query.ApplyDefaults()
+
+	result, err := QueryGameServerCapacity(gameServers.Lister(), query)
+	require.NoError(t, err)
+	require.IsType(t, &allocationv1.GameServerCapacityQuery{}, result)
+	assert.Equal(t, []allocationv1.SelectorCapacity{{Count: 3, Groups: map[string]int64{"node1": 2, "node2": 1}}}, result.(*allocationv1.GameServerCapacityQuery).Status.Selectors)
+}
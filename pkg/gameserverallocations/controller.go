@@ -19,34 +19,58 @@ import (
 	"io"
 	"mime"
 	"net/http"
+	"sort"
+	"strings"
 	"time"
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/heptiolabs/healthcheck"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	corev1lister "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 
 	"agones.dev/agones/pkg/allocation/converters"
+	pb "agones.dev/agones/pkg/allocation/go"
 	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
 	"agones.dev/agones/pkg/client/clientset/versioned"
 	"agones.dev/agones/pkg/client/informers/externalversions"
+	listerv1 "agones.dev/agones/pkg/client/listers/agones/v1"
 	"agones.dev/agones/pkg/gameservers"
 	"agones.dev/agones/pkg/processor"
 	"agones.dev/agones/pkg/util/apiserver"
+	"agones.dev/agones/pkg/util/connectiontoken"
 	"agones.dev/agones/pkg/util/https"
 	"agones.dev/agones/pkg/util/runtime"
 )
 
+const (
+	// headers of the user that the Kubernetes API server has authenticated, when it proxies a request
+	remoteUserHeader        = "X-Remote-User"
+	remoteGroupHeader       = "X-Remote-Group"
+	remoteExtraHeaderPrefix = "X-Remote-Extra-"
+
+	// accessReviewTTL is how long the result of a SubjectAccessReview of a cross namespace allocation is cached for
+	accessReviewTTL = 10 * time.Second
+	// maxAccessReviews is the maximum number of SubjectAccessReview results that are cached
+	maxAccessReviews = 1024
+)
+
 func init() {
 	registerViews()
 }
@@ -58,6 +82,34 @@ type Extensions struct {
 	recorder        record.EventRecorder
 	allocator       *Allocator
 	processorClient processor.Client
+	// gameServerLister counts capacity when there is no allocator, and so no allocation cache
+	gameServerLister listerv1.GameServerLister
+	gameServerSynced cache.InformerSynced
+	// processorFallback is set when allocations fall back to the local allocator while the processor is unhealthy
+	processorFallback *processor.Fallback
+	kubeClient        kubernetes.Interface
+	// namespaceLister is set when the namespace selectors of cross namespace allocations need to be resolved
+	namespaceLister corev1lister.NamespaceLister
+	namespaceSynced cache.InformerSynced
+	// requestHeader checks that the remote user headers of cross namespace allocations were set by the API server
+	requestHeader *requestHeaderVerifier
+	// accessReviews caches the accessReview results of cross namespace allocations for accessReviewTTL
+	accessReviews *lru.Cache
+	clock         clock.Clock
+}
+
+// accessReviewKey is the user and namespace that a SubjectAccessReview result is cached for
+type accessReviewKey struct {
+	user      string
+	groups    string
+	extra     string
+	namespace string
+}
+
+// accessReview is a cached SubjectAccessReview result
+type accessReview struct {
+	allowed bool
+	expires time.Time
 }
 
 // NewExtensions returns the extensions controller for a GameServerAllocation
@@ -71,20 +123,25 @@ func NewExtensions(apiServer *apiserver.APIServer,
 	remoteAllocationTimeout time.Duration,
 	totalAllocationTimeout time.Duration,
 	allocationBatchWaitTime time.Duration,
+	tokenSigner *connectiontoken.Signer,
 ) *Extensions {
 	c := &Extensions{
-		api: apiServer,
+		api:        apiServer,
+		kubeClient: kubeClient,
 	}
+	c.watchNamespaces(kubeClient, kubeInformerFactory)
 
 	c.allocator = NewAllocator(
 		agonesInformerFactory.Multicluster().V1().GameServerAllocationPolicies(),
 		kubeInformerFactory.Core().V1().Secrets(),
+		kubeInformerFactory.Core().V1().Namespaces(),
 		agonesClient.AgonesV1(),
 		kubeClient,
 		NewAllocationCache(agonesInformerFactory.Agones().V1().GameServers(), counter, health),
 		remoteAllocationTimeout,
 		totalAllocationTimeout,
-		allocationBatchWaitTime)
+		allocationBatchWaitTime,
+		tokenSigner)
 
 	c.baseLogger = runtime.NewLoggerWithType(c)
 
@@ -97,10 +154,17 @@ func NewExtensions(apiServer *apiserver.APIServer,
 }
 
 // NewProcessorExtensions returns the extensions controller for a GameServerAllocation
-func NewProcessorExtensions(apiServer *apiserver.APIServer, kubeClient kubernetes.Interface, processorClient processor.Client) *Extensions {
+func NewProcessorExtensions(apiServer *apiserver.APIServer, kubeClient kubernetes.Interface, kubeInformerFactory informers.SharedInformerFactory, agonesInformerFactory externalversions.SharedInformerFactory, processorClient processor.Client) *Extensions {
 	c := &Extensions{
 		api:             apiServer,
 		processorClient: processorClient,
+		kubeClient:      kubeClient,
+	}
+	c.watchNamespaces(kubeClient, kubeInformerFactory)
+	if runtime.FeatureEnabled(runtime.FeatureCapacityQuery) {
+		gameServers := agonesInformerFactory.Agones().V1().GameServers()
+		c.gameServerLister = gameServers.Lister()
+		c.gameServerSynced = gameServers.Informer().HasSynced
 	}
 
 	c.baseLogger = runtime.NewLoggerWithType(c)
@@ -113,6 +177,32 @@ func NewProcessorExtensions(apiServer *apiserver.APIServer, kubeClient kubernete
 	return c
 }
 
+// watchNamespaces sets up the namespace lister to resolve the namespace selectors of cross namespace
+// allocations with, and what authorizes their other namespaces, if the CrossNamespaceAllocation feature is enabled
+func (c *Extensions) watchNamespaces(kubeClient kubernetes.Interface, kubeInformerFactory informers.SharedInformerFactory) {
+	if runtime.FeatureEnabled(runtime.FeatureCrossNamespaceAllocation) {
+		namespaces := kubeInformerFactory.Core().V1().Namespaces()
+		c.namespaceLister = namespaces.Lister()
+		c.namespaceSynced = namespaces.Informer().HasSynced
+		c.requestHeader = newRequestHeaderVerifier(kubeClient)
+
+		accessReviews, err := lru.New(maxAccessReviews)
+		if err != nil {
+			// only returned for a non positive size
+			panic(err)
+		}
+		c.accessReviews = accessReviews
+		c.clock = clock.RealClock{}
+	}
+}
+
+// SetProcessorFallback sends allocations through the processor client, falling back to
+// the local allocator while the processor is unhealthy
+func (c *Extensions) SetProcessorFallback(processorClient processor.Client) {
+	c.processorClient = processorClient
+	c.processorFallback = processor.NewFallback(processorClient, c.baseLogger.WithField("component", "processor-fallback"))
+}
+
 // registers the api resource for gameserverallocation
 func (c *Extensions) registerAPIResource(ctx context.Context) {
 	resource := metav1.APIResource{
@@ -128,12 +218,40 @@ func (c *Extensions) registerAPIResource(ctx context.Context) {
 	c.api.AddAPIResource(allocationv1.SchemeGroupVersion.String(), resource, func(w http.ResponseWriter, r *http.Request, n string) error {
 		return c.processAllocationRequest(ctx, w, r, n)
 	})
+
+	if runtime.FeatureEnabled(runtime.FeatureCapacityQuery) {
+		resource := metav1.APIResource{
+			Name:         "gameservercapacityqueries",
+			SingularName: "gameservercapacityquery",
+			Namespaced:   true,
+			Kind:         "GameServerCapacityQuery",
+			Verbs: []string{
+				"create",
+			},
+		}
+		c.api.AddAPIResource(allocationv1.SchemeGroupVersion.String(), resource, func(w http.ResponseWriter, r *http.Request, n string) error {
+			return c.processCapacityQuery(w, r, n)
+		})
+	}
 }
 
 // Run runs this extensions controller. Will block until stop is closed.
 // Ignores threadiness, as we only needs 1 worker for cache sync
 func (c *Extensions) Run(ctx context.Context, _ int) error {
-	if !runtime.FeatureEnabled(runtime.FeatureProcessorAllocator) {
+	if c.namespaceSynced != nil && !cache.WaitForCacheSync(ctx.Done(), c.namespaceSynced) {
+		return errors.New("failed to wait for caches to sync")
+	}
+	if c.requestHeader != nil {
+		go c.requestHeader.informer.Run(ctx.Done())
+		if !cache.WaitForCacheSync(ctx.Done(), c.requestHeader.configMapSynced) {
+			return errors.New("failed to wait for caches to sync")
+		}
+	}
+	if c.gameServerSynced != nil && !cache.WaitForCacheSync(ctx.Done(), c.gameServerSynced) {
+		return errors.New("failed to wait for caches to sync")
+	}
+
+	if c.allocator != nil {
 		if err := c.allocator.Run(ctx); err != nil {
 			return err
 		}
@@ -162,11 +280,29 @@ func (c *Extensions) processAllocationRequest(ctx context.Context, w http.Respon
 		return err
 	}
 
+	if c.namespaceLister != nil {
+		result, err := c.authorizeNamespaces(ctx, r, gsa)
+		if err != nil {
+			return err
+		}
+		if result != nil {
+			code := http.StatusCreated
+			if s, ok := result.(*metav1.Status); ok {
+				code = int(s.Code)
+			}
+			return c.serialisation(r, w, result, code, scheme.Codecs)
+		}
+	}
+
+	var req *pb.AllocationRequest
 	if runtime.FeatureEnabled(runtime.FeatureProcessorAllocator) {
+		req = converters.ConvertGSAToAllocationRequest(gsa)
+	}
+
+	if req != nil && !c.processorFallback.Degraded(req) {
 		var result k8sruntime.Object
 		var code int
 
-		req := converters.ConvertGSAToAllocationRequest(gsa)
 		resp, err := c.processorClient.Allocate(ctx, req)
 		if err != nil {
 			if st, ok := status.FromError(err); ok {
@@ -210,45 +346,213 @@ func (c *Extensions) processAllocationRequest(ctx context.Context, w http.Respon
 	return err
 }
 
+// authorizeNamespaces resolves the namespace selector of the GameServerAllocation, and checks that the user that made
+// the request can create GameServerAllocations in every other namespace it searches, as the Kubernetes API server has
+// only authorized the namespace of the request. The user is only trusted if the request was proxied by the API server.
+// Returns the response to send instead of allocating, if any: a Forbidden Status if the user cannot, or the UnAllocated
+// GameServerAllocation if the selector selects no namespace. Invalid GameServerAllocations are left to be rejected by
+// the allocator.
+func (c *Extensions) authorizeNamespaces(ctx context.Context, r *http.Request, gsa *allocationv1.GameServerAllocation) (k8sruntime.Object, error) {
+	if errs := gsa.Validate(); len(errs) > 0 {
+		return nil, nil
+	}
+	if err := ResolveNamespaceSelector(c.namespaceLister, gsa); err != nil {
+		return nil, err
+	}
+	// the selector is only kept if it selects no namespace, and is not sent on to be allocated, as only its
+	// labels can be sent to the processor
+	if gsa.Spec.NamespaceSelector != nil {
+		gsa.Status.State = allocationv1.GameServerAllocationUnAllocated
+		return gsa, nil
+	}
+
+	var namespaces []string
+	for _, ns := range gsa.SearchNamespaces() {
+		if ns != gsa.ObjectMeta.Namespace {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	if len(namespaces) == 0 {
+		return nil, nil
+	}
+
+	if err := c.requestHeader.verify(r); err != nil {
+		c.baseLogger.WithError(err).Warn("Rejecting cross namespace allocation that was not proxied by the Kubernetes API server")
+		return forbiddenStatus(gsa, errors.New("cross namespace allocations must be made through the Kubernetes API server")), nil
+	}
+
+	extra := map[string]authorizationv1.ExtraValue{}
+	for k, v := range r.Header {
+		if strings.HasPrefix(k, remoteExtraHeaderPrefix) {
+			extra[strings.ToLower(strings.TrimPrefix(k, remoteExtraHeaderPrefix))] = v
+		}
+	}
+	user := r.Header.Get(remoteUserHeader)
+	groups := r.Header.Values(remoteGroupHeader)
+
+	for _, ns := range namespaces {
+		key := newAccessReviewKey(user, groups, extra, ns)
+		allowed, ok := c.cachedAccessReview(key)
+		if !ok {
+			review := &authorizationv1.SubjectAccessReview{
+				Spec: authorizationv1.SubjectAccessReviewSpec{
+					ResourceAttributes: &authorizationv1.ResourceAttributes{
+						Namespace: ns,
+						Verb:      "create",
+						Group:     allocationv1.SchemeGroupVersion.Group,
+						Resource:  "gameserverallocations",
+					},
+					User:   user,
+					Groups: groups,
+					Extra:  extra,
+				},
+			}
+			result, err := c.kubeClient.AuthorizationV1().SubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not review access to namespace %s", ns)
+			}
+			allowed = result.Status.Allowed
+			c.accessReviews.Add(key, accessReview{allowed: allowed, expires: c.clock.Now().Add(accessReviewTTL)})
+		}
+		if !allowed {
+			return forbiddenStatus(gsa, errors.Errorf("user %q cannot allocate from namespace %q", user, ns)), nil
+		}
+	}
+	return nil, nil
+}
+
+// newAccessReviewKey returns the key that the SubjectAccessReview result of the user in the namespace is cached under
+func newAccessReviewKey(user string, groups []string, extra map[string]authorizationv1.ExtraValue, namespace string) accessReviewKey {
+	keys := make([]string, 0, len(extra))
+	for k := range extra {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var values []string
+	for _, k := range keys {
+		values = append(values, k+"="+strings.Join(extra[k], "\x00"))
+	}
+	// joined with a NUL, as that cannot be in a header
+	return accessReviewKey{user: user, groups: strings.Join(groups, "\x00"), extra: strings.Join(values, "\x00\x00"), namespace: namespace}
+}
+
+// cachedAccessReview returns the cached SubjectAccessReview result for the key, if it has not expired
+func (c *Extensions) cachedAccessReview(key accessReviewKey) (allowed bool, ok bool) {
+	value, ok := c.accessReviews.Get(key)
+	if !ok {
+		return false, false
+	}
+	review := value.(accessReview)
+	if !c.clock.Now().Before(review.expires) {
+		c.accessReviews.Remove(key)
+		return false, false
+	}
+	return review.allowed, true
+}
+
+// forbiddenStatus returns the Forbidden Status to respond to the GameServerAllocation with
+func forbiddenStatus(gsa *allocationv1.GameServerAllocation, err error) *metav1.Status {
+	forbidden := k8serrors.NewForbidden(allocationv1.SchemeGroupVersion.WithResource("gameserverallocations").GroupResource(), gsa.ObjectMeta.Name, err)
+	s := &forbidden.ErrStatus
+	s.TypeMeta = metav1.TypeMeta{Kind: "Status", APIVersion: "v1"}
+	return s
+}
+
+// processCapacityQuery counts the GameServers that match the selectors of a GameServerCapacityQuery
+func (c *Extensions) processCapacityQuery(w http.ResponseWriter, r *http.Request, namespace string) error {
+	if r.Body != nil {
+		defer r.Body.Close() // nolint: errcheck
+	}
+
+	log := https.LogRequest(c.baseLogger, r)
+
+	if r.Method != http.MethodPost {
+		log.Warn("capacity query handler only supports POST")
+		http.Error(w, "Method not supported", http.StatusMethodNotAllowed)
+		return nil
+	}
+
+	query, err := c.capacityQueryDeserialization(r, namespace)
+	if err != nil {
+		return err
+	}
+
+	var result k8sruntime.Object
+	if c.allocator != nil {
+		result, err = c.allocator.QueryCapacity(query)
+	} else {
+		result, err = QueryGameServerCapacity(c.gameServerLister, query)
+	}
+	if err != nil {
+		return err
+	}
+
+	code := http.StatusCreated
+	if s, ok := result.(*metav1.Status); ok {
+		code = int(s.Code)
+	}
+	return c.serialisation(r, w, result, code, scheme.Codecs)
+}
+
 // allocationDeserialization processes the request and namespace, and attempts to deserialise its values
 // into a GameServerAllocation. Returns an error if it fails for whatever reason.
 func (c *Extensions) allocationDeserialization(r *http.Request, namespace string) (*allocationv1.GameServerAllocation, error) {
 	gsa := &allocationv1.GameServerAllocation{}
+	if err := c.deserialisation(r, namespace, gsa, &gsa.ObjectMeta); err != nil {
+		return gsa, err
+	}
+	gsa.ApplyDefaults()
+
+	return gsa, nil
+}
+
+// capacityQueryDeserialization processes the request and namespace, and attempts to deserialise its values
+// into a GameServerCapacityQuery. Returns an error if it fails for whatever reason.
+func (c *Extensions) capacityQueryDeserialization(r *http.Request, namespace string) (*allocationv1.GameServerCapacityQuery, error) {
+	query := &allocationv1.GameServerCapacityQuery{}
+	if err := c.deserialisation(r, namespace, query, &query.ObjectMeta); err != nil {
+		return query, err
+	}
+	query.ApplyDefaults()
+
+	return query, nil
+}
 
-	gvks, _, err := scheme.Scheme.ObjectKinds(gsa)
+// deserialisation decodes the body of the request into obj, and sets its namespace and creation timestamp
+func (c *Extensions) deserialisation(r *http.Request, namespace string, obj k8sruntime.Object, objectMeta *metav1.ObjectMeta) error {
+	gvks, _, err := scheme.Scheme.ObjectKinds(obj)
 	if err != nil {
-		return gsa, errors.Wrap(err, "error getting objectkinds for gameserverallocation")
+		return errors.Wrapf(err, "error getting objectkinds for %T", obj)
 	}
 
-	gsa.TypeMeta = metav1.TypeMeta{Kind: gvks[0].Kind, APIVersion: gvks[0].Version}
+	obj.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{Kind: gvks[0].Kind, Version: gvks[0].Version})
 
 	mediaTypes := scheme.Codecs.SupportedMediaTypes()
 	mt, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
-		return gsa, errors.Wrap(err, "error parsing mediatype from a request header")
+		return errors.Wrap(err, "error parsing mediatype from a request header")
 	}
 	info, ok := k8sruntime.SerializerInfoForMediaType(mediaTypes, mt)
 	if !ok {
-		return gsa, errors.New("Could not find deserializer")
+		return errors.New("Could not find deserializer")
 	}
 
 	b, err := io.ReadAll(r.Body)
 	if err != nil {
-		return gsa, errors.Wrap(err, "could not read body")
+		return errors.Wrap(err, "could not read body")
 	}
 
-	gvk := allocationv1.SchemeGroupVersion.WithKind("GameServerAllocation")
-	_, _, err = info.Serializer.Decode(b, &gvk, gsa)
+	gvk := allocationv1.SchemeGroupVersion.WithKind(gvks[0].Kind)
+	_, _, err = info.Serializer.Decode(b, &gvk, obj)
 	if err != nil {
 		c.baseLogger.WithField("body", string(b)).Error("error decoding body")
-		return gsa, errors.Wrap(err, "error decoding body")
+		return errors.Wrap(err, "error decoding body")
 	}
 
-	gsa.ObjectMeta.Namespace = namespace
-	gsa.ObjectMeta.CreationTimestamp = metav1.Now()
-	gsa.ApplyDefaults()
+	objectMeta.Namespace = namespace
+	objectMeta.CreationTimestamp = metav1.Now()
 
-	return gsa, nil
+	return nil
 }
 
 // serialisation takes a runtime.Object, and serialise it to the ResponseWriter in the requested format
@@ -17,6 +17,8 @@ package gameserverallocations
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -40,13 +42,17 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
+	corev1lister "k8s.io/client-go/listers/core/v1"
 	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	testclocks "k8s.io/utils/clock/testing"
 )
 
 const (
@@ -178,8 +184,182 @@ func TestControllerAllocator(t *testing.T) {
 	})
 }
 
+func TestControllerAuthorizeNamespaces(t *testing.T) {
+	t.Parallel()
+
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureCrossNamespaceAllocation)))
+
+	c, m := newFakeController()
+	require.NotNil(t, c.namespaceLister)
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, name := range []string{"default", "eu", "us"} {
+		require.NoError(t, indexer.Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"region": name}}}))
+	}
+	c.namespaceLister = corev1lister.NewNamespaceLister(indexer)
+
+	ca, caKey := newTestCA(t)
+	c.requestHeader = newTestRequestHeaderVerifier(t, ca, `["front-proxy-client"]`, false)
+	fc := testclocks.NewFakeClock(time.Now())
+	c.clock = fc
+
+	var reviewed []string
+	m.KubeClient.AddReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		assert.Equal(t, "player-service", review.Spec.User)
+		assert.Equal(t, []string{"matchmakers"}, review.Spec.Groups)
+		assert.Equal(t, authorizationv1.ExtraValue{"value"}, review.Spec.Extra["scopes"])
+		assert.Equal(t, "create", review.Spec.ResourceAttributes.Verb)
+		assert.Equal(t, "gameserverallocations", review.Spec.ResourceAttributes.Resource)
+
+		reviewed = append(reviewed, review.Spec.ResourceAttributes.Namespace)
+		review.Status.Allowed = review.Spec.ResourceAttributes.Namespace != "us"
+		return true, review, nil
+	})
+
+	r, err := http.NewRequest(http.MethodPost, "/", nil)
+	require.NoError(t, err)
+	r.Header.Set(remoteUserHeader, "player-service")
+	r.Header.Set(remoteGroupHeader, "matchmakers")
+	r.Header.Set(remoteExtraHeaderPrefix+"Scopes", "value")
+	r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{newTestClientCert(t, ca, caKey, "front-proxy-client")}}
+
+	newGSA := func(spec allocationv1.GameServerAllocationSpec) *allocationv1.GameServerAllocation {
+		gsa := &allocationv1.GameServerAllocation{ObjectMeta: metav1.ObjectMeta{Namespace: defaultNs}, Spec: spec}
+		gsa.ApplyDefaults()
+		return gsa
+	}
+
+	// the namespace of the request is not reviewed again
+	denied, err := c.authorizeNamespaces(context.Background(), r, newGSA(allocationv1.GameServerAllocationSpec{Namespaces: []string{defaultNs, "eu"}}))
+	require.NoError(t, err)
+	assert.Nil(t, denied)
+	assert.Equal(t, []string{"eu"}, reviewed)
+
+	reviewed = nil
+	gsa := newGSA(allocationv1.GameServerAllocationSpec{NamespaceSelector: &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "region", Operator: metav1.LabelSelectorOpIn, Values: []string{"eu", "us"}}},
+	}})
+	denied, err = c.authorizeNamespaces(context.Background(), r, gsa)
+	require.NoError(t, err)
+	require.IsType(t, &metav1.Status{}, denied)
+	assert.Equal(t, int32(http.StatusForbidden), denied.(*metav1.Status).Code)
+	assert.Equal(t, metav1.StatusReasonForbidden, denied.(*metav1.Status).Reason)
+	assert.Equal(t, []string{"eu", "us"}, gsa.Spec.Namespaces)
+	// the review of eu is cached
+	assert.Equal(t, []string{"us"}, reviewed)
+
+	// the cached reviews expire
+	reviewed = nil
+	fc.Step(accessReviewTTL)
+	denied, err = c.authorizeNamespaces(context.Background(), r, newGSA(allocationv1.GameServerAllocationSpec{Namespaces: []string{"eu", "us"}}))
+	require.NoError(t, err)
+	require.IsType(t, &metav1.Status{}, denied)
+	assert.Equal(t, []string{"eu", "us"}, reviewed)
+
+	// the remote user headers are not trusted if the request was not proxied by the API server
+	reviewed = nil
+	other, otherKey := newTestCA(t)
+	untrusted := r.Clone(context.Background())
+	untrusted.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{newTestClientCert(t, other, otherKey, "front-proxy-client")}}
+	denied, err = c.authorizeNamespaces(context.Background(), untrusted, newGSA(allocationv1.GameServerAllocationSpec{Namespaces: []string{defaultNs, "eu"}}))
+	require.NoError(t, err)
+	require.IsType(t, &metav1.Status{}, denied)
+	assert.Equal(t, int32(http.StatusForbidden), denied.(*metav1.Status).Code)
+	assert.Empty(t, reviewed)
+
+	// a request for only its own namespace does not need to be proxied
+	untrusted.TLS = nil
+	denied, err = c.authorizeNamespaces(context.Background(), untrusted, newGSA(allocationv1.GameServerAllocationSpec{Namespaces: []string{defaultNs}}))
+	require.NoError(t, err)
+	assert.Nil(t, denied)
+	assert.Empty(t, reviewed)
+
+	// no namespace is selected
+	reviewed = nil
+	gsa = newGSA(allocationv1.GameServerAllocationSpec{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "asia"}}})
+	result, err := c.authorizeNamespaces(context.Background(), r, gsa)
+	require.NoError(t, err)
+	require.IsType(t, &allocationv1.GameServerAllocation{}, result)
+	assert.Equal(t, allocationv1.GameServerAllocationUnAllocated, result.(*allocationv1.GameServerAllocation).Status.State)
+	assert.Empty(t, reviewed)
+
+	// invalid allocations are left for the allocator to reject
+	reviewed = nil
+	denied, err = c.authorizeNamespaces(context.Background(), r, newGSA(allocationv1.GameServerAllocationSpec{Namespaces: []string{"us", "us"}}))
+	require.NoError(t, err)
+	assert.Nil(t, denied)
+	assert.Empty(t, reviewed)
+}
+
+// fakeProcessorClient is a processor client that allocates a fixed GameServer while healthy
+type fakeProcessorClient struct {
+	healthy bool
+}
+
+func (f *fakeProcessorClient) Run(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (f *fakeProcessorClient) Allocate(_ context.Context, _ *pb.AllocationRequest) (*pb.AllocationResponse, error) {
+	return &pb.AllocationResponse{GameServerName: "from-processor"}, nil
+}
+
+func (f *fakeProcessorClient) Healthy() bool {
+	return f.healthy
+}
+
+func TestControllerAllocatorProcessorFallback(t *testing.T) {
+	t.Parallel()
+
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(string(runtime.FeatureProcessorAllocator)+"=true"))
+	defer runtime.ParseFeatures("") // nolint: errcheck
+
+	c, m := newFakeController()
+	m.AgonesClient.AddReactor("list", "gameservers", func(_ k8stesting.Action) (bool, k8sruntime.Object, error) {
+		return true, &agonesv1.GameServerList{}, nil
+	})
+	processorClient := &fakeProcessorClient{}
+	c.SetProcessorFallback(processorClient)
+
+	ctx, cancel := agtesting.StartInformers(m, c.allocator.allocationPolicySynced, c.allocator.secretSynced, c.allocator.allocationCache.gameServerSynced)
+	defer cancel()
+	require.NoError(t, c.Run(ctx, 1))
+
+	allocate := func() *allocationv1.GameServerAllocation {
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, json.NewEncoder(buf).Encode(&allocationv1.GameServerAllocation{}))
+		r, err := http.NewRequest(http.MethodPost, "/", buf)
+		require.NoError(t, err)
+		r.Header.Set("Content-Type", k8sruntime.ContentTypeJSON)
+		rec := httptest.NewRecorder()
+		require.NoError(t, c.processAllocationRequest(ctx, rec, r, defaultNs))
+		require.Equal(t, http.StatusCreated, rec.Code, "Response body: %s", rec.Body.String())
+
+		ret := &allocationv1.GameServerAllocation{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), ret))
+		return ret
+	}
+
+	// the processor is unhealthy, so the local allocator is used, which has no GameServers
+	gsa := allocate()
+	assert.Equal(t, allocationv1.GameServerAllocationUnAllocated, gsa.Status.State)
+
+	processorClient.healthy = true
+	gsa = allocate()
+	assert.Equal(t, "from-processor", gsa.Status.GameServerName)
+}
+
 func TestAllocationApiResource(t *testing.T) {
 	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(""))
 
 	c, m := newFakeController()
 	c.registerAPIResource(context.Background())
@@ -204,6 +384,54 @@ func TestAllocationApiResource(t *testing.T) {
 	}
 }
 
+func TestCapacityQueryApiResource(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(string(runtime.FeatureCapacityQuery)+"=true"))
+
+	m := agtesting.NewMocks()
+	m.Mux = http.NewServeMux()
+	c := NewProcessorExtensions(apiserver.NewAPIServer(m.Mux), m.KubeClient, m.KubeInformerFactory, m.AgonesInformerFactory, nil)
+	m.AgonesClient.AddReactor("list", "gameservers", func(_ k8stesting.Action) (bool, k8sruntime.Object, error) {
+		return true, &agonesv1.GameServerList{Items: capacityFixtures()}, nil
+	})
+	_, cancel := agtesting.StartInformers(m, c.gameServerSynced)
+	defer cancel()
+	c.registerAPIResource(context.Background())
+
+	ts := httptest.NewServer(m.Mux)
+	defer ts.Close()
+	client := ts.Client()
+
+	resp, err := client.Get(ts.URL + "/apis/" + allocationv1.SchemeGroupVersion.String())
+	require.NoError(t, err)
+	defer resp.Body.Close() // nolint: errcheck
+	list := &metav1.APIResourceList{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(list))
+	require.Len(t, list.APIResources, 2)
+	assert.Equal(t, "gameservercapacityquery", list.APIResources[1].SingularName)
+
+	post := func(query *allocationv1.GameServerCapacityQuery) *http.Response {
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, json.NewEncoder(buf).Encode(query))
+		resp, err := client.Post(ts.URL+"/apis/"+allocationv1.SchemeGroupVersion.String()+"/namespaces/default/gameservercapacityqueries", k8sruntime.ContentTypeJSON, buf)
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp = post(&allocationv1.GameServerCapacityQuery{Spec: allocationv1.GameServerCapacityQuerySpec{GroupByLabel: agonesv1.FleetNameLabel}})
+	defer resp.Body.Close() // nolint: errcheck
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	result := &allocationv1.GameServerCapacityQuery{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(result))
+	assert.Equal(t, []allocationv1.SelectorCapacity{{Count: 3, Groups: map[string]int64{"blue": 2, "green": 1}}}, result.Status.Selectors)
+
+	resp = post(&allocationv1.GameServerCapacityQuery{Spec: allocationv1.GameServerCapacityQuerySpec{GroupByLabel: agonesv1.FleetNameLabel, GroupByNode: true}})
+	defer resp.Body.Close() // nolint: errcheck
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+}
+
 func TestMultiClusterAllocationFromLocal(t *testing.T) {
 	t.Parallel()
 	t.Run("Handle allocation request locally", func(t *testing.T) {
@@ -856,7 +1084,7 @@ func newFakeControllerWithTimeout(remoteAllocationTimeout time.Duration, totalRe
 	m.Mux = http.NewServeMux()
 	counter := gameservers.NewPerNodeCounter(m.KubeInformerFactory, m.AgonesInformerFactory)
 	api := apiserver.NewAPIServer(m.Mux)
-	c := NewExtensions(api, healthcheck.NewHandler(), counter, m.KubeClient, m.KubeInformerFactory, m.AgonesClient, m.AgonesInformerFactory, remoteAllocationTimeout, totalRemoteAllocationTimeout, 500*time.Millisecond)
+	c := NewExtensions(api, healthcheck.NewHandler(), counter, m.KubeClient, m.KubeInformerFactory, m.AgonesClient, m.AgonesInformerFactory, remoteAllocationTimeout, totalRemoteAllocationTimeout, 500*time.Millisecond, nil)
 	c.recorder = m.FakeRecorder
 	c.allocator.recorder = m.FakeRecorder
 	return c, m
@@ -38,16 +38,44 @@ func findGameServerForAllocation(gsa *allocationv1.GameServerAllocation, list []
 
 	selectors := make([]*result, len(gsa.Spec.Selectors))
 
-	var loop func(list []*agonesv1.GameServer, f func(i int, gs *agonesv1.GameServer))
+	loop, err := allocationLoop(gsa, list)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	loop(list, func(i int, gs *agonesv1.GameServer) {
+		// only search the namespaces of the allocation
+		if !gsa.SearchesNamespace(gs.ObjectMeta.Namespace) {
+			return
+		}
+
+		for j, sel := range gsa.Spec.Selectors {
+			if selectors[j] == nil && sel.Matches(gs) {
+				selectors[j] = &result{gs: gs, index: i}
+			}
+		}
+	})
 
+	for _, r := range selectors {
+		if r != nil {
+			return r.gs, r.index, nil
+		}
+	}
+
+	return nil, 0, ErrNoGameServer
+}
+
+// allocationLoop returns a function that loops through list in the order that the scheduling strategy of the
+// GameServerAllocation should search it for matches.
+func allocationLoop(gsa *allocationv1.GameServerAllocation, list []*agonesv1.GameServer) (func(list []*agonesv1.GameServer, f func(i int, gs *agonesv1.GameServer)), error) {
 	// packed is forward looping, distributed is random looping
 	switch gsa.Spec.Scheduling {
 	case apis.Packed:
-		loop = func(list []*agonesv1.GameServer, f func(i int, gs *agonesv1.GameServer)) {
+		return func(list []*agonesv1.GameServer, f func(i int, gs *agonesv1.GameServer)) {
 			for i, gs := range list {
 				f(i, gs)
 			}
-		}
+		}, nil
 	case apis.Distributed:
 		// randomised looping - make a list of indices, and then randomise them
 		// as we don't want to change the order of the gameserver slice
@@ -61,43 +89,21 @@ func findGameServerForAllocation(gsa *allocationv1.GameServerAllocation, list []
 				indices[i], indices[j] = indices[j], indices[i]
 			})
 
-			loop = func(list []*agonesv1.GameServer, f func(i int, gs *agonesv1.GameServer)) {
+			return func(list []*agonesv1.GameServer, f func(i int, gs *agonesv1.GameServer)) {
 				for _, i := range indices {
 					f(i, list[i])
 				}
-			}
-		} else {
-			// For FeatureCountsAndLists we do not do randomized looping -- instead choose the game
-			// server based on the list of Priorities. (The order in which the game servers were sorted
-			// in ListSortedGameServersPriorities.)
-			loop = func(list []*agonesv1.GameServer, f func(i int, gs *agonesv1.GameServer)) {
-				for i, gs := range list {
-					f(i, gs)
-				}
-			}
-		}
-	default:
-		return nil, -1, errors.Errorf("scheduling strategy of '%s' is not supported", gsa.Spec.Scheduling)
-	}
-
-	loop(list, func(i int, gs *agonesv1.GameServer) {
-		// only search the same namespace
-		if gs.ObjectMeta.Namespace != gsa.ObjectMeta.Namespace {
-			return
+			}, nil
 		}
-
-		for j, sel := range gsa.Spec.Selectors {
-			if selectors[j] == nil && sel.Matches(gs) {
-				selectors[j] = &result{gs: gs, index: i}
+		// For FeatureCountsAndLists we do not do randomized looping -- instead choose the game
+		// server based on the list of Priorities. (The order in which the game servers were sorted
+		// in ListSortedGameServersPriorities.)
+		return func(list []*agonesv1.GameServer, f func(i int, gs *agonesv1.GameServer)) {
+			for i, gs := range list {
+				f(i, gs)
 			}
-		}
-	})
-
-	for _, r := range selectors {
-		if r != nil {
-			return r.gs, r.index, nil
-		}
+		}, nil
+	default:
+		return nil, errors.Errorf("scheduling strategy of '%s' is not supported", gsa.Spec.Scheduling)
 	}
-
-	return nil, 0, ErrNoGameServer
 }
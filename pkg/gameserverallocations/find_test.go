@@ -233,6 +233,29 @@ func TestFindGameServerForAllocationPacked(t *testing.T) {
 			},
 			features: fmt.Sprintf("%s=false", runtime.FeaturePlayerAllocationFilter),
 		},
+		"warming up": {
+			list: []agonesv1.GameServer{
+				{ObjectMeta: metav1.ObjectMeta{Name: "gs1", Namespace: defaultNs, Labels: oneLabel}, Spec: agonesv1.GameServerSpec{MinReadySeconds: 60}, Status: agonesv1.GameServerStatus{NodeName: "node1", State: agonesv1.GameServerStateReady, ReadyTime: &n}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "gs2", Namespace: defaultNs, Labels: oneLabel}, Spec: agonesv1.GameServerSpec{MinReadySeconds: 60}, Status: agonesv1.GameServerStatus{NodeName: "node1", State: agonesv1.GameServerStateAllocated, ReadyTime: &n}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "gs3", Namespace: defaultNs, Labels: oneLabel}, Status: agonesv1.GameServerStatus{NodeName: "node2", State: agonesv1.GameServerStateReady, ReadyTime: &n}},
+			},
+			test: func(t *testing.T, list []*agonesv1.GameServer) {
+				require.Len(t, list, 3)
+
+				// gs1 is on the fuller node, but has not been Ready for its MinReadySeconds
+				gs, index, err := findGameServerForAllocation(gsa, list)
+				assert.NoError(t, err)
+				require.NotNil(t, gs)
+				assert.Equal(t, "gs3", gs.ObjectMeta.Name)
+				assert.Equal(t, gs, list[index])
+
+				list = append(list[:index], list[index+1:]...)
+				gs, _, err = findGameServerForAllocation(gsa, list)
+				assert.Equal(t, ErrNoGameServer, err)
+				assert.Nil(t, gs)
+			},
+			features: fmt.Sprintf("%s=true", runtime.FeatureMinReadySeconds),
+		},
 	}
 
 	for k, v := range fixtures {
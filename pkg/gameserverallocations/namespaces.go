@@ -0,0 +1,74 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserverallocations
+
+import (
+	"sort"
+
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"agones.dev/agones/pkg/util/runtime"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1lister "k8s.io/client-go/listers/core/v1"
+)
+
+// ResolveNamespaceSelector replaces the NamespaceSelector of the GameServerAllocation with the Namespaces
+// it selects, in order of their names. If no namespace is selected, the selector is left as is, and the
+// GameServerAllocation searches no namespace. Does nothing if the CrossNamespaceAllocation feature is disabled,
+// or there is no NamespaceSelector.
+func ResolveNamespaceSelector(namespaceLister corev1lister.NamespaceLister, gsa *allocationv1.GameServerAllocation) error {
+	if !runtime.FeatureEnabled(runtime.FeatureCrossNamespaceAllocation) || gsa.Spec.NamespaceSelector == nil {
+		return nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(gsa.Spec.NamespaceSelector)
+	if err != nil {
+		return errors.Wrap(err, "invalid namespace selector")
+	}
+	list, err := namespaceLister.List(selector)
+	if err != nil {
+		return errors.Wrap(err, "could not list namespaces")
+	}
+	if len(list) == 0 {
+		return nil
+	}
+
+	namespaces := make([]string, 0, len(list))
+	for _, ns := range list {
+		namespaces = append(namespaces, ns.ObjectMeta.Name)
+	}
+	sort.Strings(namespaces)
+
+	gsa.Spec.Namespaces = namespaces
+	gsa.Spec.NamespaceSelector = nil
+	return nil
+}
+
+// namespaceOrder returns the position of each of the SearchNamespaces of the GameServerAllocation,
+// or nil if it does not search more than one namespace.
+func namespaceOrder(gsa *allocationv1.GameServerAllocation) map[string]int {
+	if gsa == nil {
+		return nil
+	}
+	namespaces := gsa.SearchNamespaces()
+	if len(namespaces) < 2 {
+		return nil
+	}
+	order := make(map[string]int, len(namespaces))
+	for i, ns := range namespaces {
+		order[ns] = i
+	}
+	return order
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserverallocations
+
+import (
+	"fmt"
+	"testing"
+
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"agones.dev/agones/pkg/util/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1lister "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestResolveNamespaceSelector(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for name, team := range map[string]string{"us": "blue", "eu": "blue", "asia": "red", "default": ""} {
+		require.NoError(t, indexer.Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"team": team}}}))
+	}
+	lister := corev1lister.NewNamespaceLister(indexer)
+
+	newGSA := func(team string) *allocationv1.GameServerAllocation {
+		gsa := &allocationv1.GameServerAllocation{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+			Spec: allocationv1.GameServerAllocationSpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": team}},
+			},
+		}
+		gsa.ApplyDefaults()
+		return gsa
+	}
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=false", runtime.FeatureCrossNamespaceAllocation)))
+	gsa := newGSA("blue")
+	require.NoError(t, ResolveNamespaceSelector(lister, gsa))
+	assert.Nil(t, gsa.Spec.Namespaces)
+	assert.NotNil(t, gsa.Spec.NamespaceSelector)
+
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureCrossNamespaceAllocation)))
+	require.NoError(t, ResolveNamespaceSelector(lister, gsa))
+	assert.Equal(t, []string{"eu", "us"}, gsa.Spec.Namespaces)
+	assert.Nil(t, gsa.Spec.NamespaceSelector)
+	assert.Empty(t, gsa.Validate())
+
+	gsa = newGSA("green")
+	require.NoError(t, ResolveNamespaceSelector(lister, gsa))
+	assert.Nil(t, gsa.Spec.Namespaces)
+	assert.NotNil(t, gsa.Spec.NamespaceSelector)
+	assert.Empty(t, gsa.SearchNamespaces())
+
+	// without a selector the allocation is unchanged
+	gsa = &allocationv1.GameServerAllocation{ObjectMeta: metav1.ObjectMeta{Namespace: "default"}}
+	require.NoError(t, ResolveNamespaceSelector(lister, gsa))
+	assert.Equal(t, []string{"default"}, gsa.SearchNamespaces())
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserverallocations
+
+import (
+	"context"
+	"encoding/json"
+	goErrors "errors"
+
+	"agones.dev/agones/pkg/apis/agones"
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	getterv1 "agones.dev/agones/pkg/client/clientset/versioned/typed/agones/v1"
+	"agones.dev/agones/pkg/util/runtime"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+)
+
+// MetaPatchTemplatesAnnotationKey is a GameServer annotation with the JSON encoded MetaPatch labels and
+// annotations that the templates of its most recent allocation rendered, so that a release only reverts those values.
+const MetaPatchTemplatesAnnotationKey = agones.GroupName + "/metapatch-templates"
+
+var (
+	// ErrGameServerNotAllocated is returned when a GameServer that is asked to be released is not Allocated
+	ErrGameServerNotAllocated = errors.New("the GameServer is not Allocated")
+	// ErrGameServerReallocated is returned when a GameServer that is asked to be released has been
+	// allocated again since the allocation the release was requested for
+	ErrGameServerReallocated = errors.New("the GameServer has been allocated again since the requested allocation")
+	// ErrGameServerNotSelected is returned when a GameServer that is asked to be released does not have
+	// the SelectorLabels of the request
+	ErrGameServerNotSelected = errors.New("the GameServer does not have the labels the release is restricted to")
+)
+
+// ReleaseRequest describes an Allocated GameServer that should be moved back to Ready.
+type ReleaseRequest struct {
+	// Namespace of the GameServer
+	Namespace string
+	// Name of the GameServer
+	Name string
+	// LastAllocated, if set, must match the LastAllocatedAnnotationKey annotation value
+	// on the GameServer for the release to go ahead.
+	LastAllocated string
+	// Allocation, if set, is the original GameServerAllocation. Its MetaPatch and
+	// Counter and List actions are reverted on release.
+	Allocation *allocationv1.GameServerAllocation
+	// SelectorLabels, if set, must all be labels of the GameServer for the release to go ahead,
+	// for callers that may only allocate, and so release, some of the GameServers of the namespace.
+	SelectorLabels map[string]string
+}
+
+// Release moves an Allocated GameServer back to the Ready state, and records an event on it.
+func (c *Allocator) Release(ctx context.Context, req ReleaseRequest) (*agonesv1.GameServer, error) {
+	return ReleaseGameServer(ctx, c.gameServerGetter, c.recorder, req)
+}
+
+// ReleaseGameServer moves an Allocated GameServer back to the Ready state through RequestReady,
+// optionally reverting the changes applied by the original GameServerAllocation.
+// Releasing a GameServer that is already Ready or RequestReady is a no-op.
+// recorder may be nil, in which case no events are recorded.
+func ReleaseGameServer(ctx context.Context, gameServerGetter getterv1.GameServersGetter, recorder record.EventRecorder, req ReleaseRequest) (*agonesv1.GameServer, error) {
+	var result *agonesv1.GameServer
+	var actionErrors error
+	updated := false
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		actionErrors = nil
+		gs, err := gameServerGetter.GameServers(req.Namespace).Get(ctx, req.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		for k, v := range req.SelectorLabels {
+			if gs.ObjectMeta.Labels[k] != v {
+				return errors.Wrapf(ErrGameServerNotSelected, "GameServer %s/%s", gs.ObjectMeta.Namespace, gs.ObjectMeta.Name)
+			}
+		}
+
+		switch gs.Status.State {
+		case agonesv1.GameServerStateReady, agonesv1.GameServerStateRequestReady:
+			result = gs
+			return nil
+		case agonesv1.GameServerStateAllocated:
+		default:
+			return errors.Wrapf(ErrGameServerNotAllocated, "GameServer %s/%s is %s", gs.ObjectMeta.Namespace, gs.ObjectMeta.Name, gs.Status.State)
+		}
+
+		if req.LastAllocated != "" && gs.ObjectMeta.Annotations[LastAllocatedAnnotationKey] != req.LastAllocated {
+			return errors.Wrapf(ErrGameServerReallocated, "GameServer %s/%s", gs.ObjectMeta.Namespace, gs.ObjectMeta.Name)
+		}
+
+		gsCopy := gs.DeepCopy()
+		if req.Allocation != nil {
+			actionErrors = revertAllocationFromGameServer(req.Allocation, gsCopy)
+		}
+		gsCopy.SetState(agonesv1.GameServerStateRequestReady, "Released by allocator")
+
+		result, err = gameServerGetter.GameServers(gsCopy.ObjectMeta.Namespace).Update(ctx, gsCopy, metav1.UpdateOptions{})
+		updated = err == nil
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if recorder != nil && updated {
+		if actionErrors != nil {
+			recorder.Event(result, corev1.EventTypeWarning, "ReleaseActionError", actionErrors.Error())
+		}
+		recorder.Event(result, corev1.EventTypeNormal, string(result.Status.State), "Released by allocator")
+	}
+
+	return result, nil
+}
+
+// revertAllocationFromGameServer removes the MetaPatch labels and annotations that still hold the
+// patched values, and reverts any Counter or List actions of the GameServerAllocation.
+// Templated labels and annotations are compared with the values that were rendered at allocation,
+// as recorded in the MetaPatchTemplatesAnnotationKey annotation, and left alone if there is no record.
+// Returns the errors of any Counter or List actions that could not be reverted.
+func revertAllocationFromGameServer(gsa *allocationv1.GameServerAllocation, gs *agonesv1.GameServer) error {
+	rendered := renderedMetaPatchTemplates(gs)
+	revertMetaPatchValues(gsa.Spec.MetaPatch.Labels, rendered.Labels, gs.ObjectMeta.Labels)
+	revertMetaPatchValues(gsa.Spec.MetaPatch.Annotations, rendered.Annotations, gs.ObjectMeta.Annotations)
+	delete(gs.ObjectMeta.Annotations, MetaPatchTemplatesAnnotationKey)
+
+	var errs error
+	if runtime.FeatureEnabled(runtime.FeatureCountsAndLists) {
+		for counter, ca := range gsa.Spec.Counters {
+			errs = goErrors.Join(errs, ca.RevertCounterActions(counter, gs))
+		}
+		for list, la := range gsa.Spec.Lists {
+			errs = goErrors.Join(errs, la.RevertListActions(list, gs))
+		}
+	}
+	return errs
+}
+
+// revertMetaPatchValues deletes the patched keys from current that still hold the value that was applied,
+// where the value of a templated key is the one in rendered.
+func revertMetaPatchValues(patch, rendered, current map[string]string) {
+	for key, value := range patch {
+		if allocationv1.IsMetaPatchTemplate(value) {
+			var ok bool
+			if value, ok = rendered[key]; !ok {
+				continue
+			}
+		}
+		if v, ok := current[key]; ok && v == value {
+			delete(current, key)
+		}
+	}
+}
+
+// recordMetaPatchTemplates records the labels and annotations of the rendered MetaPatch whose value
+// in mp is a template in the MetaPatchTemplatesAnnotationKey annotation, or removes the annotation
+// if there are none. gs must have annotations.
+func recordMetaPatchTemplates(mp, rendered allocationv1.MetaPatch, gs *agonesv1.GameServer) error {
+	templates := allocationv1.MetaPatch{
+		Labels:      templatedMetaPatchValues(mp.Labels, rendered.Labels),
+		Annotations: templatedMetaPatchValues(mp.Annotations, rendered.Annotations),
+	}
+	if len(templates.Labels) == 0 && len(templates.Annotations) == 0 {
+		delete(gs.ObjectMeta.Annotations, MetaPatchTemplatesAnnotationKey)
+		return nil
+	}
+	b, err := json.Marshal(templates)
+	if err != nil {
+		return errors.Wrap(err, "could not record MetaPatch template values")
+	}
+	gs.ObjectMeta.Annotations[MetaPatchTemplatesAnnotationKey] = string(b)
+	return nil
+}
+
+// templatedMetaPatchValues returns the rendered values of the keys whose value in patch is a template.
+func templatedMetaPatchValues(patch, rendered map[string]string) map[string]string {
+	var values map[string]string
+	for key, value := range patch {
+		if !allocationv1.IsMetaPatchTemplate(value) {
+			continue
+		}
+		if values == nil {
+			values = map[string]string{}
+		}
+		values[key] = rendered[key]
+	}
+	return values
+}
+
+// renderedMetaPatchTemplates returns the MetaPatch template values recorded on the GameServer at allocation.
+func renderedMetaPatchTemplates(gs *agonesv1.GameServer) allocationv1.MetaPatch {
+	var mp allocationv1.MetaPatch
+	if value, ok := gs.ObjectMeta.Annotations[MetaPatchTemplatesAnnotationKey]; ok {
+		// an unreadable record reverts no templated values
+		_ = json.Unmarshal([]byte(value), &mp)
+	}
+	return mp
+}
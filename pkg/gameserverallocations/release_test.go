@@ -0,0 +1,208 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserverallocations
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	agtesting "agones.dev/agones/pkg/testing"
+	"agones.dev/agones/pkg/util/runtime"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestAllocatorRelease(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true&%s=true", runtime.FeatureCountsAndLists, runtime.FeatureMetaPatchTemplates)))
+
+	increment := agonesv1.GameServerPriorityIncrement
+	two := int64(2)
+	gsa := &allocationv1.GameServerAllocation{
+		Spec: allocationv1.GameServerAllocationSpec{
+			MetaPatch: allocationv1.MetaPatch{
+				Labels:      map[string]string{"mode": "deathmatch", "region": "us"},
+				Annotations: map[string]string{"map": "searide", "session": "{{ .RequestID }}", "match": "{{ .Parameters.match }}"},
+			},
+			Counters: map[string]allocationv1.CounterAction{
+				"players": {Action: &increment, Amount: &two},
+			},
+			Lists: map[string]allocationv1.ListAction{
+				"sessions": {AddValues: []string{"session1"}},
+			},
+		},
+	}
+
+	newGameServer := func(state agonesv1.GameServerState) *agonesv1.GameServer {
+		return &agonesv1.GameServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gs1",
+				Namespace: defaultNs,
+				Labels:    map[string]string{"mode": "deathmatch", "region": "eu"},
+				Annotations: map[string]string{
+					"map": "searide", "session": "abc", "match": "m2",
+					LastAllocatedAnnotationKey:      "2026-01-01T00:00:00Z",
+					MetaPatchTemplatesAnnotationKey: `{"annotations":{"session":"abc","match":"m1"}}`,
+				},
+			},
+			Status: agonesv1.GameServerStatus{
+				State: state,
+				Counters: map[string]agonesv1.CounterStatus{
+					"players": {Count: 3, Capacity: 10},
+				},
+				Lists: map[string]agonesv1.ListStatus{
+					"sessions": {Values: []string{"session0", "session1"}, Capacity: 10},
+				},
+			},
+		}
+	}
+
+	fixtures := map[string]struct {
+		state         agonesv1.GameServerState
+		lastAllocated string
+		allocation    *allocationv1.GameServerAllocation
+		labels        map[string]string
+		wantErr       error
+		wantUpdate    bool
+		verify        func(t *testing.T, gs *agonesv1.GameServer)
+	}{
+		"allocated, name only": {
+			state:      agonesv1.GameServerStateAllocated,
+			wantUpdate: true,
+			verify: func(t *testing.T, gs *agonesv1.GameServer) {
+				assert.Equal(t, "deathmatch", gs.ObjectMeta.Labels["mode"])
+				assert.Equal(t, "searide", gs.ObjectMeta.Annotations["map"])
+				assert.Equal(t, "abc", gs.ObjectMeta.Annotations["session"])
+				assert.Equal(t, int64(3), gs.Status.Counters["players"].Count)
+			},
+		},
+		"allocated, revert allocation": {
+			state:         agonesv1.GameServerStateAllocated,
+			lastAllocated: "2026-01-01T00:00:00Z",
+			allocation:    gsa,
+			wantUpdate:    true,
+			verify: func(t *testing.T, gs *agonesv1.GameServer) {
+				assert.NotContains(t, gs.ObjectMeta.Labels, "mode")
+				// value has changed since allocation, so it is left alone
+				assert.Equal(t, "eu", gs.ObjectMeta.Labels["region"])
+				assert.NotContains(t, gs.ObjectMeta.Annotations, "map")
+				// templated values are compared with the values rendered at allocation
+				assert.NotContains(t, gs.ObjectMeta.Annotations, "session")
+				assert.Equal(t, "m2", gs.ObjectMeta.Annotations["match"])
+				assert.NotContains(t, gs.ObjectMeta.Annotations, MetaPatchTemplatesAnnotationKey)
+				assert.Equal(t, int64(1), gs.Status.Counters["players"].Count)
+				assert.Equal(t, []string{"session0"}, gs.Status.Lists["sessions"].Values)
+			},
+		},
+		"reallocated": {
+			state:         agonesv1.GameServerStateAllocated,
+			lastAllocated: "2025-01-01T00:00:00Z",
+			wantErr:       ErrGameServerReallocated,
+		},
+		"allocated, selected": {
+			state:      agonesv1.GameServerStateAllocated,
+			labels:     map[string]string{"mode": "deathmatch"},
+			wantUpdate: true,
+		},
+		"allocated, not selected": {
+			state:   agonesv1.GameServerStateAllocated,
+			labels:  map[string]string{"mode": "deathmatch", "team": "a"},
+			wantErr: ErrGameServerNotSelected,
+		},
+		"already ready": {
+			state: agonesv1.GameServerStateReady,
+		},
+		"already request ready": {
+			state: agonesv1.GameServerStateRequestReady,
+		},
+		"not allocated": {
+			state:   agonesv1.GameServerStateShutdown,
+			wantErr: ErrGameServerNotAllocated,
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			a, m := newFakeAllocator()
+			updated := false
+
+			m.AgonesClient.AddReactor("get", "gameservers", func(_ k8stesting.Action) (bool, k8sruntime.Object, error) {
+				return true, newGameServer(v.state), nil
+			})
+			m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+				updated = true
+				ua := action.(k8stesting.UpdateAction)
+				gs := ua.GetObject().(*agonesv1.GameServer)
+				return true, gs, nil
+			})
+
+			gs, err := a.Release(context.Background(), ReleaseRequest{
+				Namespace:      defaultNs,
+				Name:           "gs1",
+				LastAllocated:  v.lastAllocated,
+				Allocation:     v.allocation,
+				SelectorLabels: v.labels,
+			})
+			assert.Equal(t, v.wantUpdate, updated)
+			if v.wantErr != nil {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, v.wantErr), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			if v.wantUpdate {
+				assert.Equal(t, agonesv1.GameServerStateRequestReady, gs.Status.State)
+				agtesting.AssertEventContains(t, m.FakeRecorder.Events, "Released by allocator")
+			} else {
+				assert.Equal(t, v.state, gs.Status.State)
+				// nothing was released, so no event is recorded
+				assert.Len(t, m.FakeRecorder.Events, 0)
+			}
+			if v.verify != nil {
+				v.verify(t, gs)
+			}
+		})
+	}
+}
+
+func TestRevertAllocationFromGameServerMetaPatchTemplates(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true", runtime.FeatureMetaPatchTemplates)))
+
+	gsa := &allocationv1.GameServerAllocation{Spec: allocationv1.GameServerAllocationSpec{
+		MetaPatch: allocationv1.MetaPatch{Labels: map[string]string{"session": "{{ .RequestID }}"}},
+	}}
+
+	// without a record of the rendered value, a templated label is left alone
+	gs := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"session": "abc"}}}
+	require.NoError(t, revertAllocationFromGameServer(gsa, gs))
+	assert.Equal(t, "abc", gs.ObjectMeta.Labels["session"])
+
+	gs.ObjectMeta.Annotations = map[string]string{MetaPatchTemplatesAnnotationKey: `{"labels":{"session":"abc"}}`}
+	require.NoError(t, revertAllocationFromGameServer(gsa, gs))
+	assert.NotContains(t, gs.ObjectMeta.Labels, "session")
+	assert.NotContains(t, gs.ObjectMeta.Annotations, MetaPatchTemplatesAnnotationKey)
+}
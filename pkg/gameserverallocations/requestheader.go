@@ -0,0 +1,106 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserverallocations
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1lister "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// the ConfigMap that the Kubernetes API server publishes the client CA and names of its front proxy in,
+	// which sets the remote user headers of the requests it proxies
+	requestHeaderConfigMapNamespace = "kube-system"
+	requestHeaderConfigMapName      = "extension-apiserver-authentication"
+	requestHeaderClientCAKey        = "requestheader-client-ca-file"
+	requestHeaderAllowedNamesKey    = "requestheader-allowed-names"
+)
+
+// requestHeaderVerifier checks that a request was proxied by the Kubernetes API server,
+// so the user in its remote user headers can be trusted
+type requestHeaderVerifier struct {
+	configMapLister corev1lister.ConfigMapLister
+	configMapSynced cache.InformerSynced
+	informer        cache.SharedIndexInformer
+}
+
+// newRequestHeaderVerifier returns a requestHeaderVerifier that watches only the
+// extension-apiserver-authentication ConfigMap, as that is all it can read
+func newRequestHeaderVerifier(kubeClient kubernetes.Interface) *requestHeaderVerifier {
+	informer := coreinformers.NewFilteredConfigMapInformer(kubeClient, requestHeaderConfigMapNamespace, 0,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", requestHeaderConfigMapName).String()
+		})
+	return &requestHeaderVerifier{
+		configMapLister: corev1lister.NewConfigMapLister(informer.GetIndexer()),
+		configMapSynced: informer.HasSynced,
+		informer:        informer,
+	}
+}
+
+// verify returns an error unless the request presented a client certificate signed by the
+// request header client CA, with one of the allowed names if any are set
+func (v *requestHeaderVerifier) verify(r *http.Request) error {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return errors.New("request has no client certificate")
+	}
+
+	cm, err := v.configMapLister.ConfigMaps(requestHeaderConfigMapNamespace).Get(requestHeaderConfigMapName)
+	if err != nil {
+		return errors.Wrapf(err, "could not get ConfigMap %s/%s", requestHeaderConfigMapNamespace, requestHeaderConfigMapName)
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM([]byte(cm.Data[requestHeaderClientCAKey])) {
+		return errors.Errorf("ConfigMap %s/%s has no request header client CA", requestHeaderConfigMapNamespace, requestHeaderConfigMapName)
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range r.TLS.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	chains, err := r.TLS.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return errors.Wrap(err, "client certificate is not signed by the request header client CA")
+	}
+
+	var allowedNames []string
+	if names := cm.Data[requestHeaderAllowedNamesKey]; names != "" {
+		if err := json.Unmarshal([]byte(names), &allowedNames); err != nil {
+			return errors.Wrapf(err, "could not parse %s of ConfigMap %s/%s", requestHeaderAllowedNamesKey, requestHeaderConfigMapNamespace, requestHeaderConfigMapName)
+		}
+	}
+	if len(allowedNames) == 0 {
+		return nil
+	}
+	name := chains[0][0].Subject.CommonName
+	for _, allowed := range allowedNames {
+		if name == allowed {
+			return nil
+		}
+	}
+	return errors.Errorf("client certificate name %q is not an allowed request header name", name)
+}
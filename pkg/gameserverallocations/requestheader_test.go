@@ -0,0 +1,145 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserverallocations
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1lister "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestRequestHeaderVerifierVerify(t *testing.T) {
+	t.Parallel()
+
+	ca, caKey := newTestCA(t)
+	otherCA, otherCAKey := newTestCA(t)
+	proxyCert := newTestClientCert(t, ca, caKey, "front-proxy-client")
+
+	fixtures := map[string]struct {
+		allowedNames string
+		noConfigMap  bool
+		certs        []*x509.Certificate
+		valid        bool
+	}{
+		"allowed name": {
+			allowedNames: `["front-proxy-client"]`,
+			certs:        []*x509.Certificate{proxyCert},
+			valid:        true,
+		},
+		"any name": {
+			certs: []*x509.Certificate{proxyCert},
+			valid: true,
+		},
+		"name not allowed": {
+			allowedNames: `["aggregator"]`,
+			certs:        []*x509.Certificate{proxyCert},
+		},
+		"other CA": {
+			certs: []*x509.Certificate{newTestClientCert(t, otherCA, otherCAKey, "front-proxy-client")},
+		},
+		"no client certificate": {},
+		"no ConfigMap": {
+			noConfigMap: true,
+			certs:       []*x509.Certificate{proxyCert},
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			verifier := newTestRequestHeaderVerifier(t, ca, v.allowedNames, v.noConfigMap)
+
+			r, err := http.NewRequest(http.MethodPost, "/", nil)
+			require.NoError(t, err)
+			if v.certs != nil {
+				r.TLS = &tls.ConnectionState{PeerCertificates: v.certs}
+			}
+
+			err = verifier.verify(r)
+			if v.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+// newTestRequestHeaderVerifier returns a requestHeaderVerifier with the extension-apiserver-authentication
+// ConfigMap of the CA and allowed names in its lister
+func newTestRequestHeaderVerifier(t *testing.T, ca *x509.Certificate, allowedNames string, noConfigMap bool) *requestHeaderVerifier {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	if !noConfigMap {
+		require.NoError(t, indexer.Add(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: requestHeaderConfigMapName, Namespace: requestHeaderConfigMapNamespace},
+			Data: map[string]string{
+				requestHeaderClientCAKey:     string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw})),
+				requestHeaderAllowedNamesKey: allowedNames,
+			},
+		}))
+	}
+	return &requestHeaderVerifier{configMapLister: corev1lister.NewConfigMapLister(indexer)}
+}
+
+func newTestCA(t *testing.T) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "front-proxy-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+func newTestClientCert(t *testing.T, ca *x509.Certificate, caKey *ecdsa.PrivateKey, name string) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
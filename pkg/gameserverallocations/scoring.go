@@ -0,0 +1,326 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserverallocations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	extism "github.com/extism/go-sdk"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+const (
+	// maxScoringCandidates is the maximum number of matching GameServers, in scheduling order,
+	// that are passed to a Wasm scoring function for a single allocation.
+	maxScoringCandidates = 100
+	// maxWasmScorers is the maximum number of distinct Wasm scoring modules that are kept loaded.
+	// The least recently used module is unloaded first.
+	maxWasmScorers = 16
+	// wasmScoringTimeout is how long a call to a Wasm scoring function can take, as it runs in the
+	// allocation batch, which cannot process any other allocation in the meantime.
+	wasmScoringTimeout = 100 * time.Millisecond
+	// wasmScoringMaxPages is the maximum memory of a Wasm scoring module, in 64KiB pages.
+	wasmScoringMaxPages = 256
+)
+
+// wasmScorers loads and caches the Wasm modules used to score GameServers for allocation.
+type wasmScorers struct {
+	httpClient *http.Client
+
+	// scorers is a LRU cache of the loaded *wasmScorer by wasmScorerKey, which unloads evicted modules
+	scorers *lru.Cache
+
+	// mu guards loading, and adding to and removing from scorers
+	mu sync.Mutex
+	// loading are the modules that are being loaded, so each module is only fetched once,
+	// while the modules that are already loaded can still be used.
+	loading map[string]*wasmScorerLoad
+}
+
+// wasmScorerLoad is a Wasm scoring module that is being loaded.
+type wasmScorerLoad struct {
+	done   chan struct{}
+	scorer *wasmScorer
+	err    error
+}
+
+// wasmScorer is a loaded Wasm scoring module.
+type wasmScorer struct {
+	url string
+
+	// mu serialises calls, as Wasm plugins are not safe for concurrent use
+	mu     sync.Mutex
+	plugin *extism.Plugin
+}
+
+func newWasmScorers() *wasmScorers {
+	scorers, err := lru.NewWithEvict(maxWasmScorers, func(_, value interface{}) {
+		value.(*wasmScorer).close(context.Background())
+	})
+	if err != nil {
+		// only returned for a non positive size
+		panic(err)
+	}
+	return &wasmScorers{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		scorers:    scorers,
+		loading:    map[string]*wasmScorerLoad{},
+	}
+}
+
+// get returns the loaded module for the WasmScoring, fetching it if it is not already loaded.
+func (s *wasmScorers) get(ctx context.Context, ws *allocationv1.WasmScoring) (*wasmScorer, error) {
+	key := wasmScorerKey(ws)
+
+	s.mu.Lock()
+	if value, ok := s.scorers.Get(key); ok {
+		scorer := value.(*wasmScorer)
+		if scorer.loaded() {
+			s.mu.Unlock()
+			return scorer, nil
+		}
+		// the module was unloaded, such as after a call timed out, so it is loaded again
+		s.scorers.Remove(key)
+	}
+	load, loading := s.loading[key]
+	if !loading {
+		load = &wasmScorerLoad{done: make(chan struct{})}
+		s.loading[key] = load
+	}
+	s.mu.Unlock()
+
+	if !loading {
+		load.scorer, load.err = s.load(ws)
+
+		s.mu.Lock()
+		if load.err == nil {
+			s.scorers.Add(key, load.scorer)
+		}
+		delete(s.loading, key)
+		s.mu.Unlock()
+		close(load.done)
+	}
+
+	select {
+	case <-load.done:
+		return load.scorer, load.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// load fetches and compiles the module of the WasmScoring. As a module is shared by all the
+// allocations that use it, it is not loaded with the context of any one of them.
+func (s *wasmScorers) load(ws *allocationv1.WasmScoring) (*wasmScorer, error) {
+	res, err := s.httpClient.Get(ws.URL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch Wasm scoring module from %s", ws.URL)
+	}
+	defer res.Body.Close() //nolint:errcheck
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bad status code %d from the server: %s", res.StatusCode, ws.URL)
+	}
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read Wasm scoring module from %s", ws.URL)
+	}
+
+	data := extism.WasmData{Data: b}
+	if len(ws.Hash) > 0 {
+		data.Hash = ws.Hash
+	}
+	manifest := extism.Manifest{
+		Wasm: []extism.Wasm{
+			data,
+		},
+		Memory: &extism.ManifestMemory{MaxPages: wasmScoringMaxPages},
+		Config: ws.Config,
+		// a timeout also interrupts calls as soon as their context is done
+		Timeout: uint64(wasmScoringTimeout.Milliseconds()),
+	}
+
+	config := extism.PluginConfig{
+		EnableWasi: true,
+	}
+	plugin, err := extism.NewPlugin(context.Background(), manifest, config, []extism.HostFunction{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create Wasm scoring plugin from %s", ws.URL)
+	}
+
+	return &wasmScorer{url: ws.URL, plugin: plugin}, nil
+}
+
+// wasmScorerKey returns the cache key of the module for the WasmScoring, which includes
+// the startup config, as it is fixed for the lifetime of the loaded module.
+func wasmScorerKey(ws *allocationv1.WasmScoring) string {
+	keys := make([]string, 0, len(ws.Config))
+	for k := range ws.Config {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(ws.URL)
+	b.WriteString("|")
+	b.WriteString(ws.Hash)
+	for _, k := range keys {
+		b.WriteString("|")
+		b.WriteString(k)
+		b.WriteString("=")
+		b.WriteString(ws.Config[k])
+	}
+	return b.String()
+}
+
+// loaded returns false once the module has been unloaded.
+func (s *wasmScorer) loaded() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.plugin != nil
+}
+
+func (s *wasmScorer) close(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.plugin != nil {
+		_ = s.plugin.Close(ctx)
+		s.plugin = nil
+	}
+}
+
+// score calls the scoring function of the module with the candidates, and returns their scores.
+func (s *wasmScorer) score(ctx context.Context, gsa *allocationv1.GameServerAllocation, candidates []*agonesv1.GameServer) ([]int64, error) {
+	now := time.Now()
+	review := allocationv1.GameServerScoringReview{
+		Request: &allocationv1.GameServerScoringRequest{
+			UID:         uuid.NewUUID(),
+			Namespace:   gsa.ObjectMeta.Namespace,
+			Parameters:  gsa.Spec.Scoring.Parameters,
+			GameServers: make([]allocationv1.GameServerScoringCandidate, 0, len(candidates)),
+		},
+	}
+	for _, gs := range candidates {
+		review.Request.GameServers = append(review.Request.GameServers, allocationv1.GameServerScoringCandidate{
+			Name:              gs.ObjectMeta.Name,
+			Namespace:         gs.ObjectMeta.Namespace,
+			Labels:            gs.ObjectMeta.Labels,
+			Annotations:       gs.ObjectMeta.Annotations,
+			NodeName:          gs.Status.NodeName,
+			CreationTimestamp: gs.ObjectMeta.CreationTimestamp,
+			AgeSeconds:        int64(now.Sub(gs.ObjectMeta.CreationTimestamp.Time).Seconds()),
+			Counters:          gs.Status.Counters,
+			Lists:             gs.Status.Lists,
+		})
+	}
+
+	b, err := json.Marshal(review)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal scoring request")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.plugin == nil {
+		return nil, errors.Errorf("Wasm scoring module from %s has been unloaded", s.url)
+	}
+	callCtx, cancel := context.WithTimeout(ctx, wasmScoringTimeout)
+	defer cancel()
+	_, b, err = s.plugin.CallWithContext(callCtx, gsa.Spec.Scoring.Function, b)
+	if err != nil {
+		if callCtx.Err() != nil {
+			// an interrupted module cannot be called again, so it is unloaded, to be loaded again by the next allocation
+			_ = s.plugin.Close(ctx)
+			s.plugin = nil
+		}
+		return nil, errors.Wrapf(err, "failed to call Wasm scoring function %s", gsa.Spec.Scoring.Function)
+	}
+
+	if err := json.Unmarshal(b, &review); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal scoring response")
+	}
+	if review.Response == nil || len(review.Response.Scores) != len(candidates) {
+		return nil, errors.Errorf("Wasm scoring function %s must return one score for each of the %d GameServers", gsa.Spec.Scoring.Function, len(candidates))
+	}
+
+	return review.Response.Scores, nil
+}
+
+// findScoredGameServerForAllocation finds the GameServer with the highest score from the Wasm scoring module,
+// out of the GameServers that match the first selector of the GameServerAllocation with any matches.
+// Ties are broken by the scheduling order, as is used by findGameServerForAllocation. If the module
+// fails to score the candidates, the first candidate in scheduling order is returned.
+func findScoredGameServerForAllocation(ctx context.Context, logger *logrus.Entry, scorer *wasmScorer, gsa *allocationv1.GameServerAllocation, list []*agonesv1.GameServer) (*agonesv1.GameServer, int, error) {
+	loop, err := allocationLoop(gsa, list)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	// indices of the matching GameServers in list, in scheduling order, for each selector
+	selectors := make([][]int, len(gsa.Spec.Selectors))
+	loop(list, func(i int, gs *agonesv1.GameServer) {
+		// only search the namespaces of the allocation
+		if !gsa.SearchesNamespace(gs.ObjectMeta.Namespace) {
+			return
+		}
+
+		for j, sel := range gsa.Spec.Selectors {
+			if len(selectors[j]) < maxScoringCandidates && sel.Matches(gs) {
+				selectors[j] = append(selectors[j], i)
+			}
+		}
+	})
+
+	for _, indices := range selectors {
+		if len(indices) == 0 {
+			continue
+		}
+
+		candidates := make([]*agonesv1.GameServer, len(indices))
+		for k, i := range indices {
+			candidates[k] = list[i]
+		}
+
+		scores, err := scorer.score(ctx, gsa, candidates)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to score GameServers for allocation, using scheduling order")
+			return list[indices[0]], indices[0], nil
+		}
+
+		best := 0
+		for k := range scores {
+			if scores[k] > scores[best] {
+				best = k
+			}
+		}
+		return list[indices[best]], indices[best], nil
+	}
+
+	return nil, 0, ErrNoGameServer
+}
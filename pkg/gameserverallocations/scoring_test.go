@@ -0,0 +1,313 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserverallocations
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"agones.dev/agones/pkg/apis"
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"agones.dev/agones/pkg/util/runtime"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// newScoringPluginServer serves the allocation scoring Wasm example, and returns the URL and hash of the plugin.
+func newScoringPluginServer(t *testing.T) (string, string) {
+	wasmFilePath, err := filepath.Abs(filepath.Join("..", "..", "examples", "allocation-scoring-wasm"))
+	require.NoError(t, err)
+
+	pluginBytes, err := os.ReadFile(filepath.Join(wasmFilePath, "plugin.wasm"))
+	require.NoError(t, err, "WASM plugin file not found at %s", wasmFilePath)
+	sum := sha256.Sum256(pluginBytes)
+
+	srv := httptest.NewServer(http.FileServer(http.Dir(wasmFilePath)))
+	t.Cleanup(srv.Close)
+
+	return srv.URL + "/plugin.wasm", hex.EncodeToString(sum[:])
+}
+
+// loopingScoringModule is a Wasm module with a score function that never returns:
+// (module (func (export "score") (result i32) (loop (br 0)) (i32.const 0)))
+var loopingScoringModule = []byte{
+	0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+	0x01, 0x05, 0x01, 0x60, 0x00, 0x01, 0x7f,
+	0x03, 0x02, 0x01, 0x00,
+	0x07, 0x09, 0x01, 0x05, 's', 'c', 'o', 'r', 'e', 0x00, 0x00,
+	0x0a, 0x0b, 0x01, 0x09, 0x00, 0x03, 0x40, 0x0c, 0x00, 0x0b, 0x41, 0x00, 0x0b,
+}
+
+// newLoopingScoringModuleServer serves loopingScoringModule, and returns its URL.
+func newLoopingScoringModuleServer(t *testing.T) string {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(loopingScoringModule)
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func TestWasmScorersGet(t *testing.T) {
+	t.Parallel()
+
+	url, hash := newScoringPluginServer(t)
+	ctx := context.Background()
+	s := newWasmScorers()
+
+	scorer, err := s.get(ctx, &allocationv1.WasmScoring{URL: url, Hash: hash, Function: "score"})
+	require.NoError(t, err)
+	defer scorer.close(ctx)
+
+	// the same module and config, with a different function, is not loaded again
+	again, err := s.get(ctx, &allocationv1.WasmScoring{URL: url, Hash: hash, Function: "newest"})
+	require.NoError(t, err)
+	assert.Same(t, scorer, again)
+	assert.Equal(t, 1, s.scorers.Len())
+
+	_, err = s.get(ctx, &allocationv1.WasmScoring{URL: url, Hash: "0000", Function: "score"})
+	assert.ErrorContains(t, err, "failed to create Wasm scoring plugin")
+
+	_, err = s.get(ctx, &allocationv1.WasmScoring{URL: url + ".missing", Function: "score"})
+	assert.ErrorContains(t, err, "bad status code 404")
+	assert.Equal(t, 1, s.scorers.Len())
+
+	// an unloaded module is loaded again
+	scorer.close(ctx)
+	again, err = s.get(ctx, &allocationv1.WasmScoring{URL: url, Hash: hash, Function: "score"})
+	require.NoError(t, err)
+	assert.NotSame(t, scorer, again)
+	assert.True(t, again.loaded())
+	assert.Equal(t, 1, s.scorers.Len())
+}
+
+func TestWasmScorersGetConcurrently(t *testing.T) {
+	t.Parallel()
+
+	url, hash := newScoringPluginServer(t)
+	var fetches atomic.Int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		<-release
+		http.Redirect(w, r, url, http.StatusFound)
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	s := newWasmScorers()
+	ws := &allocationv1.WasmScoring{URL: srv.URL + "/plugin.wasm", Hash: hash, Function: "score"}
+
+	var wg sync.WaitGroup
+	scorers := make([]*wasmScorer, 5)
+	for i := range scorers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			scorer, err := s.get(ctx, ws)
+			assert.NoError(t, err)
+			scorers[i] = scorer
+		}(i)
+	}
+
+	// a slow module does not block the modules that are already loaded
+	loaded, err := s.get(ctx, &allocationv1.WasmScoring{URL: url, Hash: hash, Function: "score"})
+	require.NoError(t, err)
+	defer loaded.close(ctx)
+
+	// nor waiting for it past the allocation context
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = s.get(cancelled, ws)
+	assert.Equal(t, context.Canceled, err)
+
+	close(release)
+	wg.Wait()
+	for _, scorer := range scorers {
+		assert.Same(t, scorers[0], scorer)
+	}
+	assert.Equal(t, int32(1), fetches.Load())
+	scorers[0].close(ctx)
+}
+
+func TestWasmScorersEvict(t *testing.T) {
+	t.Parallel()
+
+	url := newLoopingScoringModuleServer(t)
+	ctx := context.Background()
+	s := newWasmScorers()
+
+	first, err := s.get(ctx, &allocationv1.WasmScoring{URL: url, Config: map[string]string{"index": "0"}})
+	require.NoError(t, err)
+	second, err := s.get(ctx, &allocationv1.WasmScoring{URL: url, Config: map[string]string{"index": "1"}})
+	require.NoError(t, err)
+
+	// using the first module makes the second one the least recently used
+	again, err := s.get(ctx, &allocationv1.WasmScoring{URL: url, Config: map[string]string{"index": "0"}})
+	require.NoError(t, err)
+	require.Same(t, first, again)
+
+	for i := 2; i <= maxWasmScorers; i++ {
+		_, err := s.get(ctx, &allocationv1.WasmScoring{URL: url, Config: map[string]string{"index": strconv.Itoa(i)}})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, maxWasmScorers, s.scorers.Len())
+	assert.True(t, first.loaded())
+	assert.False(t, second.loaded())
+
+	s.scorers.Purge()
+	assert.False(t, first.loaded())
+}
+
+func TestWasmScorerTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := newWasmScorers()
+	ws := &allocationv1.WasmScoring{URL: newLoopingScoringModuleServer(t), Function: "score"}
+	scorer, err := s.get(ctx, ws)
+	require.NoError(t, err)
+
+	gsa := &allocationv1.GameServerAllocation{Spec: allocationv1.GameServerAllocationSpec{Scoring: ws}}
+	start := time.Now()
+	_, err = scorer.score(ctx, gsa, []*agonesv1.GameServer{{ObjectMeta: metav1.ObjectMeta{Name: "gs1"}}})
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 10*wasmScoringTimeout)
+
+	// the interrupted module is loaded again
+	assert.False(t, scorer.loaded())
+	again, err := s.get(ctx, ws)
+	require.NoError(t, err)
+	assert.NotSame(t, scorer, again)
+	again.close(ctx)
+}
+
+func TestFindScoredGameServerForAllocation(t *testing.T) {
+	t.Parallel()
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(fmt.Sprintf("%s=true&%s=true", runtime.FeatureCountsAndLists, runtime.FeatureWasmAllocationScoring)))
+
+	url, hash := newScoringPluginServer(t)
+	ctx := context.Background()
+	scorer, err := newWasmScorers().get(ctx, &allocationv1.WasmScoring{URL: url, Hash: hash})
+	require.NoError(t, err)
+	defer scorer.close(ctx)
+
+	newGameServer := func(name string, age time.Duration, maps ...string) *agonesv1.GameServer {
+		return &agonesv1.GameServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         defaultNs,
+				Labels:            map[string]string{"role": "gameserver"},
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			},
+			Status: agonesv1.GameServerStatus{
+				State: agonesv1.GameServerStateReady,
+				Lists: map[string]agonesv1.ListStatus{"maps": {Capacity: 10, Values: maps}},
+			},
+		}
+	}
+	list := []*agonesv1.GameServer{
+		newGameServer("gs1", time.Hour, "oasis"),
+		newGameServer("gs2", 2*time.Hour, "searide"),
+		newGameServer("gs3", time.Minute, "oasis"),
+		{ObjectMeta: metav1.ObjectMeta{Name: "gs4", Namespace: "other", Labels: map[string]string{"role": "gameserver"}}},
+	}
+
+	fixtures := map[string]struct {
+		function   string
+		parameters map[string]string
+		selectors  []allocationv1.GameServerSelector
+		want       string
+		wantErr    error
+	}{
+		"map vote": {
+			function:   "score",
+			parameters: map[string]string{"map": "searide"},
+			want:       "gs2",
+		},
+		"no map vote prefers newest": {
+			function: "score",
+			want:     "gs3",
+		},
+		"other function": {
+			function:   "newest",
+			parameters: map[string]string{"map": "searide"},
+			want:       "gs3",
+		},
+		"only scores the first selector with matches": {
+			function:   "score",
+			parameters: map[string]string{"map": "searide"},
+			selectors: []allocationv1.GameServerSelector{
+				{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"role": "none"}}},
+				{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"role": "gameserver"}}, Lists: map[string]allocationv1.ListSelector{"maps": {ContainsValue: "oasis"}}},
+				{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"role": "gameserver"}}},
+			},
+			want: "gs3",
+		},
+		"function error falls back to scheduling order": {
+			function: "missing",
+			want:     "gs1",
+		},
+		"no matches": {
+			function: "score",
+			selectors: []allocationv1.GameServerSelector{
+				{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"role": "none"}}},
+			},
+			wantErr: ErrNoGameServer,
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			selectors := v.selectors
+			if selectors == nil {
+				selectors = []allocationv1.GameServerSelector{{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"role": "gameserver"}}}}
+			}
+			gsa := &allocationv1.GameServerAllocation{
+				ObjectMeta: metav1.ObjectMeta{Namespace: defaultNs},
+				Spec: allocationv1.GameServerAllocationSpec{
+					Scheduling: apis.Packed,
+					Selectors:  selectors,
+					Scoring:    &allocationv1.WasmScoring{URL: url, Function: v.function, Parameters: v.parameters},
+				},
+			}
+			gsa.ApplyDefaults()
+
+			gs, index, err := findScoredGameServerForAllocation(ctx, logrus.NewEntry(logrus.New()), scorer, gsa, list)
+			if v.wantErr != nil {
+				assert.Equal(t, v.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, v.want, gs.ObjectMeta.Name)
+			assert.Equal(t, gs, list[index])
+		})
+	}
+}
@@ -43,6 +43,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	extclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	apiextclientv1 "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -64,6 +65,13 @@ const (
 	grpcPortEnvVar        = "AGONES_SDK_GRPC_PORT"
 	httpPortEnvVar        = "AGONES_SDK_HTTP_PORT"
 	passthroughPortEnvVar = "PASSTHROUGH"
+
+	// connectionTokenKeysVolume is the volume of the ConfigMap with the JSON Web Key Set that
+	// the sidecar verifies connection tokens with. It is a volume rather than an env var,
+	// so that rotated keys reach running GameServers.
+	connectionTokenKeysVolume    = "agones-connection-token-keys"
+	connectionTokenKeysMountPath = "/etc/agones/connection-token"
+	connectionTokenKeysFile      = "jwks.json"
 )
 
 // Extensions struct contains what is needed to bind webhook handlers
@@ -86,6 +94,7 @@ type Controller struct {
 	sidecarMemoryLimit       resource.Quantity
 	sidecarRunAsUser         int
 	sidecarRequestsRateLimit time.Duration
+	connectionTokenKeys      string
 	sdkServiceAccount        string
 	crdGetter                apiextclientv1.CustomResourceDefinitionInterface
 	podGetter                typedcorev1.PodsGetter
@@ -94,6 +103,8 @@ type Controller struct {
 	gameServerGetter         getterv1.GameServersGetter
 	gameServerLister         listerv1.GameServerLister
 	gameServerSynced         cache.InformerSynced
+	gameServerSetLister      listerv1.GameServerSetLister
+	gameServerSetSynced      cache.InformerSynced
 	nodeLister               corelisterv1.NodeLister
 	nodeSynced               cache.InformerSynced
 	portAllocator            portallocator.Interface
@@ -101,6 +112,9 @@ type Controller struct {
 	migrationController      *MigrationController
 	missingPodController     *MissingPodController
 	succeededController      *SucceededController
+	lifetimeController       *LifetimeController
+	idleController           *IdleController
+	terminationController    *TerminationController
 	workerqueue              *workerqueue.WorkerQueue
 	creationWorkerQueue      *workerqueue.WorkerQueue // handles creation only
 	deletionWorkerQueue      *workerqueue.WorkerQueue // handles deletion only
@@ -121,6 +135,7 @@ func NewController(
 	sidecarRunAsUser int,
 	sidecarRequestsRateLimit time.Duration,
 	sdkServiceAccount string,
+	connectionTokenKeys string,
 	kubeClient kubernetes.Interface,
 	kubeInformerFactory informers.SharedInformerFactory,
 	extClient extclientset.Interface,
@@ -143,6 +158,7 @@ func NewController(
 		sidecarRequestsRateLimit: sidecarRequestsRateLimit,
 		alwaysPullSidecarImage:   alwaysPullSidecarImage,
 		sdkServiceAccount:        sdkServiceAccount,
+		connectionTokenKeys:      connectionTokenKeys,
 		crdGetter:                extClient.ApiextensionsV1().CustomResourceDefinitions(),
 		podGetter:                kubeClient.CoreV1(),
 		podLister:                pods.Lister(),
@@ -150,6 +166,8 @@ func NewController(
 		gameServerGetter:         agonesClient.AgonesV1(),
 		gameServerLister:         gameServers.Lister(),
 		gameServerSynced:         gsInformer.HasSynced,
+		gameServerSetLister:      agonesInformerFactory.Agones().V1().GameServerSets().Lister(),
+		gameServerSetSynced:      agonesInformerFactory.Agones().V1().GameServerSets().Informer().HasSynced,
 		nodeLister:               kubeInformerFactory.Core().V1().Nodes().Lister(),
 		nodeSynced:               kubeInformerFactory.Core().V1().Nodes().Informer().HasSynced,
 		portAllocator:            controllerHooks.NewPortAllocator(portRanges, kubeInformerFactory, agonesInformerFactory),
@@ -157,6 +175,9 @@ func NewController(
 		migrationController:      NewMigrationController(health, kubeClient, agonesClient, kubeInformerFactory, agonesInformerFactory, controllerHooks.SyncPodPortsToGameServer),
 		missingPodController:     NewMissingPodController(health, kubeClient, agonesClient, kubeInformerFactory, agonesInformerFactory),
 		succeededController:      NewSucceededController(health, kubeClient, agonesClient, kubeInformerFactory, agonesInformerFactory),
+		lifetimeController:       NewLifetimeController(health, kubeClient, agonesClient, agonesInformerFactory),
+		idleController:           NewIdleController(health, kubeClient, agonesClient, agonesInformerFactory),
+		terminationController:    NewTerminationController(health, kubeClient, agonesClient, kubeInformerFactory, agonesInformerFactory),
 	}
 
 	c.baseLogger = runtime.NewLoggerWithType(c)
@@ -181,6 +202,12 @@ func NewController(
 			newGs := newObj.(*agonesv1.GameServer)
 			if oldGs.Status.State != newGs.Status.State || !newGs.ObjectMeta.DeletionTimestamp.IsZero() {
 				c.enqueueGameServerBasedOnState(newGs)
+				return
+			}
+			// a condition that a readiness gate refers to may have changed, so the GameServer may now be able to move to Ready
+			if newGs.Status.State == agonesv1.GameServerStateRequestReady && runtime.FeatureEnabled(runtime.FeatureReadinessGates) &&
+				!apiequality.Semantic.DeepEqual(oldGs.Status.Conditions, newGs.Status.Conditions) {
+				c.enqueueGameServerBasedOnState(newGs)
 			}
 		},
 	})
@@ -397,7 +424,7 @@ func (c *Controller) Run(ctx context.Context, workers int) error {
 	}
 
 	c.baseLogger.Debug("Wait for cache sync")
-	if !cache.WaitForCacheSync(ctx.Done(), c.gameServerSynced, c.podSynced, c.nodeSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.gameServerSynced, c.gameServerSetSynced, c.podSynced, c.nodeSynced) {
 		return errors.New("failed to wait for caches to sync")
 	}
 
@@ -436,6 +463,33 @@ func (c *Controller) Run(ctx context.Context, workers int) error {
 		}()
 	}
 
+	// Run the Lifetime Controller
+	if runtime.FeatureEnabled(runtime.FeatureGameServerLifetime) {
+		go func() {
+			if err := c.lifetimeController.Run(ctx, workers); err != nil {
+				c.baseLogger.WithError(err).Error("error running lifetime controller")
+			}
+		}()
+	}
+
+	// Run the Idle Controller
+	if runtime.FeatureEnabled(runtime.FeatureIdleGameServers) {
+		go func() {
+			if err := c.idleController.Run(ctx, workers); err != nil {
+				c.baseLogger.WithError(err).Error("error running idle controller")
+			}
+		}()
+	}
+
+	// Run the Termination Controller
+	if runtime.FeatureEnabled(runtime.FeatureTerminationNotices) {
+		go func() {
+			if err := c.terminationController.Run(ctx, workers); err != nil {
+				c.baseLogger.WithError(err).Error("error running termination controller")
+			}
+		}()
+	}
+
 	// start work queues
 	var wg sync.WaitGroup
 
@@ -554,7 +608,7 @@ func (c *Controller) syncGameServerPortAllocationState(ctx context.Context, gs *
 
 	gsCopy := c.portAllocator.Allocate(gs.DeepCopy())
 
-	gsCopy.Status.State = agonesv1.GameServerStateCreating
+	gsCopy.SetState(agonesv1.GameServerStateCreating, "Port allocated")
 	c.recorder.Event(gs, corev1.EventTypeNormal, string(gs.Status.State), "Port allocated")
 
 	loggerForGameServer(gsCopy, c.baseLogger).Debug("Syncing Port Allocation GameServerState")
@@ -613,7 +667,7 @@ func (c *Controller) syncGameServerCreatingState(ctx context.Context, gs *agones
 	}
 
 	gsCopy := gs.DeepCopy()
-	gsCopy.Status.State = agonesv1.GameServerStateStarting
+	gsCopy.SetState(agonesv1.GameServerStateStarting, "Pod created")
 	gs, err = c.gameServerGetter.GameServers(gs.ObjectMeta.Namespace).Update(ctx, gsCopy, metav1.UpdateOptions{})
 	if err != nil {
 		return gs, errors.Wrapf(err, "error updating GameServer %s to Starting state", gs.Name)
@@ -643,7 +697,11 @@ func (c *Controller) syncDevelopmentGameServer(ctx context.Context, gs *agonesv1
 	loggerForGameServer(gs, c.baseLogger).Debug("GS is a development game server and will not be managed by Agones.")
 	gsCopy := gs.DeepCopy()
 
-	gsCopy.Status.State = agonesv1.GameServerStateReady
+	gsCopy.SetState(agonesv1.GameServerStateReady, "Development GameServer")
+	if recordReadyTime() {
+		now := metav1.Now()
+		gsCopy.Status.ReadyTime = &now
+	}
 
 	if gs.Status.State == agonesv1.GameServerStateCreating {
 		var ports []agonesv1.GameServerStatusPort
@@ -675,6 +733,19 @@ func (c *Controller) createGameServerPod(ctx context.Context, gs *agonesv1.GameS
 		return gs, err
 	}
 
+	if c.connectionTokensEnabled() {
+		// optional, so that GameServers still start in namespaces the keys have not been published to.
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: connectionTokenKeysVolume,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: c.connectionTokenKeys},
+					Optional:             ptr.To(true),
+				},
+			},
+		})
+	}
+
 	// if the service account is not set, then you are in the "opinionated"
 	// mode. If the user sets the service account, we assume they know what they are
 	// doing, and don't disable the gameserver container.
@@ -718,6 +789,12 @@ func (c *Controller) createGameServerPod(ctx context.Context, gs *agonesv1.GameS
 	return gs, nil
 }
 
+// connectionTokensEnabled returns true if the sidecar should verify connection tokens
+// with the keys from the connectionTokenKeys ConfigMap.
+func (c *Controller) connectionTokensEnabled() bool {
+	return runtime.FeatureEnabled(runtime.FeatureConnectionTokens) && c.connectionTokenKeys != ""
+}
+
 // sidecar creates the sidecar container for a given GameServer
 func (c *Controller) sidecar(gs *agonesv1.GameServer) corev1.Container {
 	sidecar := corev1.Container{
@@ -762,6 +839,18 @@ func (c *Controller) sidecar(gs *agonesv1.GameServer) corev1.Container {
 		},
 	}
 
+	if c.connectionTokensEnabled() {
+		sidecar.Env = append(sidecar.Env, corev1.EnvVar{
+			Name:  "CONNECTION_TOKEN_KEYS_FILE",
+			Value: connectionTokenKeysMountPath + "/" + connectionTokenKeysFile,
+		})
+		sidecar.VolumeMounts = append(sidecar.VolumeMounts, corev1.VolumeMount{
+			Name:      connectionTokenKeysVolume,
+			MountPath: connectionTokenKeysMountPath,
+			ReadOnly:  true,
+		})
+	}
+
 	if gs.Spec.SdkServer.GRPCPort != 0 {
 		sidecar.Args = append(sidecar.Args, fmt.Sprintf("--grpc-port=%d", gs.Spec.SdkServer.GRPCPort))
 	}
@@ -939,7 +1028,7 @@ func (c *Controller) syncGameServerStartingState(ctx context.Context, gs *agones
 		return gs, err
 	}
 
-	gsCopy.Status.State = agonesv1.GameServerStateScheduled
+	gsCopy.SetState(agonesv1.GameServerStateScheduled, "Address and port populated")
 	gs, err = c.gameServerGetter.GameServers(gs.ObjectMeta.Namespace).Update(ctx, gsCopy, metav1.UpdateOptions{})
 	if err != nil {
 		return gs, errors.Wrapf(err, "error updating GameServer %s to Scheduled state", gs.Name)
@@ -963,6 +1052,12 @@ func (c *Controller) syncGameServerRequestReadyState(ctx context.Context, gs *ag
 
 	loggerForGameServer(gs, c.baseLogger).Debug("Syncing RequestReady State")
 
+	// stay in RequestReady until every readiness gate is True. Changes to the conditions re-queue the GameServer.
+	if !gs.ReadinessGatesPassed() {
+		loggerForGameServer(gs, c.baseLogger).Debug("Waiting for readiness gates to pass")
+		return gs, nil
+	}
+
 	gsCopy := gs.DeepCopy()
 
 	pod, err := c.gameServerPod(gs)
@@ -996,7 +1091,23 @@ func (c *Controller) syncGameServerRequestReadyState(ctx context.Context, gs *ag
 		return gs, err
 	}
 
-	gsCopy.Status.State = agonesv1.GameServerStateReady
+	msg := "SDK.Ready() complete"
+	if _, recycle := gs.ObjectMeta.Annotations[agonesv1.GameServerRecycleAnnotation]; recycle && runtime.FeatureEnabled(runtime.FeatureRecycleGameServers) {
+		template, err := c.gameServerTemplate(gs)
+		if err != nil {
+			return gs, err
+		}
+		gsCopy.Recycle(template)
+		msg = "SDK.Recycle() complete"
+	}
+
+	gsCopy.SetState(agonesv1.GameServerStateReady, msg)
+	// the payload is for the previous allocation, if the GameServer is being reused
+	gsCopy.Status.AllocationPayload = nil
+	if recordReadyTime() {
+		now := metav1.Now()
+		gsCopy.Status.ReadyTime = &now
+	}
 	gs, err = c.gameServerGetter.GameServers(gs.ObjectMeta.Namespace).Update(ctx, gsCopy, metav1.UpdateOptions{})
 	if err != nil {
 		return gs, errors.Wrapf(err, "error setting Ready, Port and address on GameServer %s Status", gs.ObjectMeta.Name)
@@ -1005,10 +1116,33 @@ func (c *Controller) syncGameServerRequestReadyState(ctx context.Context, gs *ag
 	if addressPopulated {
 		c.recorder.Event(gs, corev1.EventTypeNormal, string(gs.Status.State), "Address and port populated")
 	}
-	c.recorder.Event(gs, corev1.EventTypeNormal, string(gs.Status.State), "SDK.Ready() complete")
+	c.recorder.Event(gs, corev1.EventTypeNormal, string(gs.Status.State), msg)
 	return gs, nil
 }
 
+// gameServerTemplate returns the metadata of the template of the GameServerSet that owns the GameServer,
+// or nil if the GameServer is not owned by a GameServerSet.
+func (c *Controller) gameServerTemplate(gs *agonesv1.GameServer) (*metav1.ObjectMeta, error) {
+	owner := metav1.GetControllerOf(gs)
+	if owner == nil || owner.Kind != "GameServerSet" {
+		return nil, nil
+	}
+	gsSet, err := c.gameServerSetLister.GameServerSets(gs.ObjectMeta.Namespace).Get(owner.Name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "error retrieving GameServerSet %s for GameServer %s", owner.Name, gs.ObjectMeta.Name)
+	}
+	return &gsSet.Spec.Template.ObjectMeta, nil
+}
+
+// recordReadyTime returns true if a feature flag that relies on the GameServer's Status.ReadyTime is enabled.
+func recordReadyTime() bool {
+	return runtime.FeatureEnabled(runtime.FeatureMinReadySeconds) || runtime.FeatureEnabled(runtime.FeatureLifecyclePriorities) ||
+		runtime.FeatureEnabled(runtime.FeatureGameServerLifetime) || runtime.FeatureEnabled(runtime.FeatureCrashLoopBackoff)
+}
+
 // applyGameServerReadyContainerIDAnnotation updates the GameServer and its corresponding Pod with an annotation
 // indicating the ID of the container that is running the game server, once it's in a Running state.
 func (c *Controller) applyGameServerReadyContainerIDAnnotation(ctx context.Context, gsCopy *agonesv1.GameServer, pod *corev1.Pod) (*agonesv1.GameServer, error) {
@@ -1077,7 +1211,7 @@ func (c *Controller) moveToErrorState(ctx context.Context, gs *agonesv1.GameServ
 		gsCopy.Annotations = make(map[string]string, 1)
 	}
 	gsCopy.Annotations[agonesv1.GameServerErroredAtAnnotation] = time.Now().Format(time.RFC3339)
-	gsCopy.Status.State = agonesv1.GameServerStateError
+	gsCopy.SetState(agonesv1.GameServerStateError, msg)
 
 	gs, err := c.gameServerGetter.GameServers(gs.ObjectMeta.Namespace).Update(ctx, gsCopy, metav1.UpdateOptions{})
 	if err != nil {
@@ -1549,12 +1549,14 @@ func TestControllerSyncGameServerRequestReadyState(t *testing.T) {
 	defer agruntime.FeatureTestMutex.Unlock()
 
 	t.Run("GameServer with ReadyRequest State", func(t *testing.T) {
+		require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureMinReadySeconds)+"=true"))
 		c, m := newFakeController()
 
 		gsFixture := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
 			Spec: newSingleContainerSpec(), Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateRequestReady}}
 		gsFixture.ApplyDefaults()
 		gsFixture.Status.NodeName = nodeName
+		gsFixture.Status.AllocationPayload = []byte(`{"teams":2}`)
 		pod, err := gsFixture.Pod(agtesting.FakeAPIHooks{})
 		require.NoError(t, err)
 		pod.Status.ContainerStatuses = []corev1.ContainerStatus{
@@ -1576,6 +1578,9 @@ func TestControllerSyncGameServerRequestReadyState(t *testing.T) {
 			ua := action.(k8stesting.UpdateAction)
 			gs := ua.GetObject().(*agonesv1.GameServer)
 			assert.Equal(t, agonesv1.GameServerStateReady, gs.Status.State)
+			// the payload of the previous allocation is cleared
+			assert.Nil(t, gs.Status.AllocationPayload)
+			assert.NotNil(t, gs.Status.ReadyTime)
 
 			// only set if not using sidecars.
 			if !agruntime.FeatureEnabled(agruntime.FeatureSidecarContainers) {
@@ -1606,6 +1611,129 @@ func TestControllerSyncGameServerRequestReadyState(t *testing.T) {
 		agtesting.AssertEventContains(t, m.FakeRecorder.Events, "SDK.Ready() complete")
 	})
 
+	t.Run("GameServer with state history", func(t *testing.T) {
+		require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureGameServerStateHistory)+"=true"))
+		c, m := newFakeController()
+
+		gsFixture := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: newSingleContainerSpec(), Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateRequestReady}}
+		gsFixture.ApplyDefaults()
+		gsFixture.Status.NodeName = nodeName
+		pod, err := gsFixture.Pod(agtesting.FakeAPIHooks{})
+		require.NoError(t, err)
+
+		m.KubeClient.AddReactor("list", "pods", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &corev1.PodList{Items: []corev1.Pod{*pod}}, nil
+		})
+		m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			ua := action.(k8stesting.UpdateAction)
+			return true, ua.GetObject().(*agonesv1.GameServer), nil
+		})
+		m.KubeClient.AddReactor("update", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			ua := action.(k8stesting.UpdateAction)
+			return true, ua.GetObject().(*corev1.Pod), nil
+		})
+
+		ctx, cancel := agtesting.StartInformers(m, c.podSynced)
+		defer cancel()
+
+		gs, err := c.syncGameServerRequestReadyState(ctx, gsFixture)
+		require.NoError(t, err)
+		require.Len(t, gs.Status.StateHistory, 1)
+		assert.Equal(t, agonesv1.GameServerStateReady, gs.Status.StateHistory[0].State)
+		assert.Equal(t, "SDK.Ready() complete", gs.Status.StateHistory[0].Reason)
+		assert.False(t, gs.Status.StateHistory[0].Time.IsZero())
+	})
+
+	t.Run("GameServer with readiness gates that have not passed", func(t *testing.T) {
+		require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureReadinessGates)+"=true"))
+		c, m := newFakeController()
+
+		gsFixture := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: newSingleContainerSpec(), Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateRequestReady}}
+		gsFixture.Spec.ReadinessGates = []agonesv1.GameServerReadinessGate{{ConditionType: "AntiCheat"}, {ConditionType: "AssetsCached"}}
+		gsFixture.ApplyDefaults()
+		gsFixture.Status.NodeName = nodeName
+		gsFixture.SetCondition("AntiCheat", corev1.ConditionTrue, "", metav1.Now())
+
+		m.AgonesClient.AddReactor("update", "gameservers", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			assert.FailNow(t, "gameserver should not be updated")
+			return false, nil, nil
+		})
+
+		gs, err := c.syncGameServerRequestReadyState(context.Background(), gsFixture)
+		require.NoError(t, err)
+		assert.Equal(t, agonesv1.GameServerStateRequestReady, gs.Status.State)
+	})
+
+	t.Run("GameServer with readiness gates that have passed", func(t *testing.T) {
+		require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureReadinessGates)+"=true&"+string(agruntime.FeatureSidecarContainers)+"=true"))
+		c, m := newFakeController()
+
+		gsFixture := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: newSingleContainerSpec(), Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateRequestReady}}
+		gsFixture.Spec.ReadinessGates = []agonesv1.GameServerReadinessGate{{ConditionType: "AntiCheat"}}
+		gsFixture.ApplyDefaults()
+		gsFixture.Status.NodeName = nodeName
+		gsFixture.SetCondition("AntiCheat", corev1.ConditionTrue, "", metav1.Now())
+		pod, err := gsFixture.Pod(agtesting.FakeAPIHooks{})
+		require.NoError(t, err)
+
+		m.KubeClient.AddReactor("list", "pods", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &corev1.PodList{Items: []corev1.Pod{*pod}}, nil
+		})
+		m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+			return true, gs, nil
+		})
+
+		ctx, cancel := agtesting.StartInformers(m, c.podSynced)
+		defer cancel()
+
+		gs, err := c.syncGameServerRequestReadyState(ctx, gsFixture)
+		require.NoError(t, err)
+		assert.Equal(t, agonesv1.GameServerStateReady, gs.Status.State)
+	})
+
+	t.Run("GameServer being recycled", func(t *testing.T) {
+		require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureRecycleGameServers)+"=true&"+string(agruntime.FeatureSidecarContainers)+"=true"))
+		c, m := newFakeController()
+
+		gsSet := &agonesv1.GameServerSet{ObjectMeta: metav1.ObjectMeta{Name: "gss", Namespace: "default", UID: "1234"},
+			Spec: agonesv1.GameServerSetSpec{Template: agonesv1.GameServerTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"mode": "dm"}}}}}
+		gsFixture := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default",
+			Labels:      map[string]string{"mode": "ctf", "agones.dev/sdk-map": "dust"},
+			Annotations: map[string]string{agonesv1.GameServerRecycleAnnotation: agonesv1.True}},
+			Spec: newSingleContainerSpec(), Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateRequestReady}}
+		gsFixture.ObjectMeta.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(gsSet, agonesv1.SchemeGroupVersion.WithKind("GameServerSet"))}
+		gsFixture.ApplyDefaults()
+		gsFixture.Status.NodeName = nodeName
+		pod, err := gsFixture.Pod(agtesting.FakeAPIHooks{})
+		require.NoError(t, err)
+
+		m.KubeClient.AddReactor("list", "pods", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &corev1.PodList{Items: []corev1.Pod{*pod}}, nil
+		})
+		m.AgonesClient.AddReactor("list", "gameserversets", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerSetList{Items: []agonesv1.GameServerSet{*gsSet}}, nil
+		})
+		m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+			assert.Equal(t, map[string]string{"mode": "dm"}, gs.ObjectMeta.Labels)
+			assert.NotContains(t, gs.ObjectMeta.Annotations, agonesv1.GameServerRecycleAnnotation)
+			return true, gs, nil
+		})
+
+		ctx, cancel := agtesting.StartInformers(m, c.podSynced, c.gameServerSetSynced)
+		defer cancel()
+
+		gs, err := c.syncGameServerRequestReadyState(ctx, gsFixture)
+		require.NoError(t, err)
+		assert.Equal(t, agonesv1.GameServerStateReady, gs.Status.State)
+		agtesting.AssertEventContains(t, m.FakeRecorder.Events, "SDK.Recycle() complete")
+	})
+
 	t.Run("Error on GameServer update", func(t *testing.T) {
 		require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureSidecarContainers)+"=false"))
 
@@ -2442,6 +2570,63 @@ func testWithNonZeroDeletionTimestamp(t *testing.T, f func(*Controller, *agonesv
 	assert.Equal(t, fixture, result)
 }
 
+func TestControllerConnectionTokenKeys(t *testing.T) {
+	t.Parallel()
+
+	agruntime.FeatureTestMutex.Lock()
+	defer agruntime.FeatureTestMutex.Unlock()
+
+	newFixture := func() *agonesv1.GameServer {
+		gs := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "gameserver", Namespace: "default"},
+			Spec: newSingleContainerSpec(), Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateCreating}}
+		gs.ApplyDefaults()
+		return gs
+	}
+	env := corev1.EnvVar{Name: "CONNECTION_TOKEN_KEYS_FILE", Value: "/etc/agones/connection-token/jwks.json"}
+	mount := corev1.VolumeMount{Name: "agones-connection-token-keys", MountPath: "/etc/agones/connection-token", ReadOnly: true}
+
+	c, _ := newFakeController()
+	require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureConnectionTokens)+"=true"))
+	sidecar := c.sidecar(newFixture())
+	assert.NotContains(t, sidecar.Env, env)
+	assert.Empty(t, sidecar.VolumeMounts)
+
+	c.connectionTokenKeys = "agones-connection-token-keys"
+	sidecar = c.sidecar(newFixture())
+	assert.Contains(t, sidecar.Env, env)
+	assert.Contains(t, sidecar.VolumeMounts, mount)
+
+	require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureConnectionTokens)+"=false"))
+	sidecar = c.sidecar(newFixture())
+	assert.NotContains(t, sidecar.Env, env)
+	assert.Empty(t, sidecar.VolumeMounts)
+
+	t.Run("pod mounts the keys", func(t *testing.T) {
+		require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureConnectionTokens)+"=true"))
+		c, m := newFakeController()
+		c.connectionTokenKeys = "agones-connection-token-keys"
+		created := false
+		m.KubeClient.AddReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			created = true
+			pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+			assert.Contains(t, pod.Spec.Volumes, corev1.Volume{
+				Name: "agones-connection-token-keys",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "agones-connection-token-keys"},
+						Optional:             ptr.To(true),
+					},
+				},
+			})
+			return true, pod, nil
+		})
+
+		_, err := c.createGameServerPod(context.Background(), newFixture())
+		require.NoError(t, err)
+		assert.True(t, created)
+	})
+}
+
 // newFakeController returns a controller, backed by the fake Clientset
 func newFakeController() (*Controller, agtesting.Mocks) {
 	m := agtesting.NewMocks()
@@ -2451,7 +2636,7 @@ func newFakeController() (*Controller, agtesting.Mocks) {
 		map[string]portallocator.PortRange{agonesv1.DefaultPortRange: {MinPort: 10, MaxPort: 20}},
 		"sidecar:dev", false,
 		resource.MustParse("0.05"), resource.MustParse("0.1"),
-		resource.MustParse("50Mi"), resource.MustParse("100Mi"), sidecarRunAsUser, 500*time.Millisecond, "sdk-service-account",
+		resource.MustParse("50Mi"), resource.MustParse("100Mi"), sidecarRunAsUser, 500*time.Millisecond, "sdk-service-account", "",
 		m.KubeClient, m.KubeInformerFactory, m.ExtClient, m.AgonesClient, m.AgonesInformerFactory)
 	c.recorder = m.FakeRecorder
 	return c, m
@@ -16,6 +16,7 @@ package gameservers
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"agones.dev/agones/pkg/apis/agones"
@@ -176,6 +177,20 @@ func (hc *HealthController) failedContainer(pod *corev1.Pod) bool {
 	return false
 }
 
+// gameContainerTermination returns how the main gameserver container last terminated, or nil if it has not.
+func gameContainerTermination(pod *corev1.Pod) *corev1.ContainerStateTerminated {
+	container := pod.Annotations[agonesv1.GameServerContainerAnnotation]
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name == container {
+			if cs.State.Terminated != nil {
+				return cs.State.Terminated
+			}
+			return cs.LastTerminationState.Terminated
+		}
+	}
+	return nil
+}
+
 // Run processes the rate limited queue.
 // Will block until stop is closed
 func (hc *HealthController) Run(ctx context.Context, workers int) error {
@@ -251,14 +266,22 @@ func (hc *HealthController) syncGameServer(ctx context.Context, key string) erro
 	}
 
 	hc.loggerForGameServer(gs).Debug("Issue with GameServer pod, marking as GameServerStateUnhealthy")
+	msg := "Issue with Gameserver pod"
+	// record why the game server container terminated, for the post-mortem inspection of the GameServer
+	if pod != nil && gs.Spec.PostMortem != nil && runtime.FeatureEnabled(runtime.FeaturePostMortemRetention) {
+		if terminated := gameContainerTermination(pod); terminated != nil {
+			msg = fmt.Sprintf("%s: container %s terminated with exit code %d (%s)", msg,
+				pod.Annotations[agonesv1.GameServerContainerAnnotation], terminated.ExitCode, terminated.Reason)
+		}
+	}
 	gsCopy := gs.DeepCopy()
-	gsCopy.Status.State = agonesv1.GameServerStateUnhealthy
+	gsCopy.SetState(agonesv1.GameServerStateUnhealthy, msg)
 
 	if _, err := hc.gameServerGetter.GameServers(gs.ObjectMeta.Namespace).Update(ctx, gsCopy, metav1.UpdateOptions{}); err != nil {
 		return errors.Wrapf(err, "error updating GameServer %s/%s to unhealthy", gs.ObjectMeta.Name, gs.ObjectMeta.Namespace)
 	}
 
-	hc.recorder.Event(gs, corev1.EventTypeWarning, string(gsCopy.Status.State), "Issue with Gameserver pod")
+	hc.recorder.Event(gs, corev1.EventTypeWarning, string(gsCopy.Status.State), msg)
 
 	return nil
 }
@@ -427,6 +427,50 @@ func TestHealthControllerSyncGameServerUpdateFailed(t *testing.T) {
 	}
 }
 
+func TestHealthControllerSyncGameServerPostMortem(t *testing.T) {
+	t.Parallel()
+
+	agruntime.FeatureTestMutex.Lock()
+	defer agruntime.FeatureTestMutex.Unlock()
+	require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureSidecarContainers)+"=false&"+string(agruntime.FeaturePostMortemRetention)+"=true"))
+
+	m := agtesting.NewMocks()
+	hc := NewHealthController(healthcheck.NewHandler(), m.KubeClient, m.AgonesClient, m.KubeInformerFactory, m.AgonesInformerFactory, false)
+	hc.recorder = m.FakeRecorder
+
+	gs := agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"}, Spec: newSingleContainerSpec(),
+		Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateAllocated}}
+	gs.Spec.PostMortem = &agonesv1.PostMortem{}
+	gs.ApplyDefaults()
+
+	m.KubeClient.AddReactor("list", "pods", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+		pod, err := gs.Pod(agtesting.FakeAPIHooks{})
+		assert.NoError(t, err)
+		pod.Status = corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+			{Name: "container", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}}}}}
+		return true, &corev1.PodList{Items: []corev1.Pod{*pod}}, nil
+	})
+	m.AgonesClient.AddReactor("list", "gameservers", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &agonesv1.GameServerList{Items: []agonesv1.GameServer{gs}}, nil
+	})
+	updated := false
+	m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		updated = true
+		ua := action.(k8stesting.UpdateAction)
+		gsObj := ua.GetObject().(*agonesv1.GameServer)
+		assert.Equal(t, agonesv1.GameServerStateUnhealthy, gsObj.Status.State)
+		return true, gsObj, nil
+	})
+
+	ctx, cancel := agtesting.StartInformers(m, hc.gameServerSynced, hc.podSynced)
+	defer cancel()
+
+	err := hc.syncGameServer(ctx, "default/test")
+	assert.NoError(t, err)
+	assert.True(t, updated, "GameServer should be updated")
+	agtesting.AssertEventContains(t, m.FakeRecorder.Events, "container container terminated with exit code 137 (OOMKilled)")
+}
+
 func TestHealthControllerRunNoSideCar(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,188 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"agones.dev/agones/pkg/apis/agones"
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"agones.dev/agones/pkg/client/clientset/versioned"
+	"agones.dev/agones/pkg/client/clientset/versioned/scheme"
+	getterv1 "agones.dev/agones/pkg/client/clientset/versioned/typed/agones/v1"
+	"agones.dev/agones/pkg/client/informers/externalversions"
+	listerv1 "agones.dev/agones/pkg/client/listers/agones/v1"
+	"agones.dev/agones/pkg/util/logfields"
+	"agones.dev/agones/pkg/util/runtime"
+	"agones.dev/agones/pkg/util/workerqueue"
+	"github.com/heptiolabs/healthcheck"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+// IdleController tracks how long an Allocated GameServer has been empty for, as configured
+// by its Spec.Idle, and shuts it down or returns it to Ready once it has been empty for too long.
+type IdleController struct {
+	baseLogger       *logrus.Entry
+	gameServerSynced cache.InformerSynced
+	gameServerGetter getterv1.GameServersGetter
+	gameServerLister listerv1.GameServerLister
+	workerqueue      *workerqueue.WorkerQueue
+	recorder         record.EventRecorder
+}
+
+// NewIdleController creates a new IdleController and sets up event handlers.
+func NewIdleController(health healthcheck.Handler,
+	kubeClient kubernetes.Interface,
+	agonesClient versioned.Interface,
+	agonesInformerFactory externalversions.SharedInformerFactory) *IdleController {
+	gameServers := agonesInformerFactory.Agones().V1().GameServers()
+
+	c := &IdleController{
+		gameServerSynced: gameServers.Informer().HasSynced,
+		gameServerGetter: agonesClient.AgonesV1(),
+		gameServerLister: gameServers.Lister(),
+	}
+
+	c.baseLogger = runtime.NewLoggerWithType(c)
+	c.workerqueue = workerqueue.NewWorkerQueue(c.syncGameServer, c.baseLogger, logfields.GameServerKey, agones.GroupName+".IdleController")
+	health.AddLivenessCheck("gameserver-idle-workerqueue", healthcheck.Check(c.workerqueue.Healthy))
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(c.baseLogger.Debugf)
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+	c.recorder = eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "idle-controller"})
+
+	_, _ = gameServers.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			gs := obj.(*agonesv1.GameServer)
+			if gs.Spec.Idle != nil {
+				c.workerqueue.Enqueue(gs)
+			}
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			gs := newObj.(*agonesv1.GameServer)
+			if gs.Spec.Idle != nil {
+				c.workerqueue.Enqueue(gs)
+			}
+		},
+	})
+
+	return c
+}
+
+// Run starts the IdleController worker queue after ensuring caches are synced.
+func (c *IdleController) Run(ctx context.Context, workers int) error {
+	c.baseLogger.Debug("Wait for cache sync")
+	if !cache.WaitForCacheSync(ctx.Done(), c.gameServerSynced) {
+		return errors.New("failed to wait for caches to sync")
+	}
+
+	c.workerqueue.Run(ctx, workers)
+	return nil
+}
+
+func (c *IdleController) loggerForGameServerKey(key string) *logrus.Entry {
+	return logfields.AugmentLogEntry(c.baseLogger, logfields.GameServerKey, key)
+}
+
+// syncGameServer records the time since which an Allocated GameServer has been empty in its Status.IdleSince,
+// and clears it when the GameServer is no longer empty or Allocated. It is restarted when an empty GameServer is
+// allocated again, as its state does not change. Once the GameServer has been empty for its Spec.Idle.Seconds,
+// it is moved to Shutdown, or back to Ready.
+func (c *IdleController) syncGameServer(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		// don't return an error, as we don't want this retried
+		runtime.HandleError(c.loggerForGameServerKey(key), errors.Wrapf(err, "invalid resource key"))
+		return nil
+	}
+
+	gs, err := c.gameServerLister.GameServers(namespace).Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			c.loggerForGameServerKey(key).Debug("GameServer is no longer available for syncing")
+			return nil
+		}
+		return errors.Wrapf(err, "error retrieving GameServer %s from namespace %s", name, namespace)
+	}
+
+	if gs.Spec.Idle == nil || gs.IsBeingDeleted() || agonesv1.TerminalGameServerStates[gs.Status.State] {
+		return nil
+	}
+
+	gsCopy := gs.DeepCopy()
+	if gs.Status.State != agonesv1.GameServerStateAllocated || !gs.IsEmpty() {
+		if gs.Status.IdleSince == nil {
+			return nil
+		}
+		gsCopy.Status.IdleSince = nil
+		_, err = c.gameServerGetter.GameServers(namespace).Update(ctx, gsCopy, metav1.UpdateOptions{})
+		return errors.Wrapf(err, "error clearing idle time of GameServer %s", name)
+	}
+
+	now := time.Now()
+	period := time.Duration(gs.Spec.Idle.Seconds) * time.Second
+	if gs.Status.IdleSince == nil || reallocatedSinceIdle(gs) {
+		idleSince := metav1.NewTime(now)
+		gsCopy.Status.IdleSince = &idleSince
+		if _, err = c.gameServerGetter.GameServers(namespace).Update(ctx, gsCopy, metav1.UpdateOptions{}); err != nil {
+			return errors.Wrapf(err, "error setting idle time of GameServer %s", name)
+		}
+		c.workerqueue.EnqueueAfter(gs, period)
+		return nil
+	}
+
+	if remaining := gs.Status.IdleSince.Add(period).Sub(now); remaining > 0 {
+		c.workerqueue.EnqueueAfter(gs, remaining)
+		return nil
+	}
+
+	gsCopy.Status.IdleSince = nil
+	msg := fmt.Sprintf("Empty for %s while Allocated", period)
+	switch gs.Spec.Idle.Action {
+	case agonesv1.IdleActionReady:
+		// go through RequestReady, so the GameServer is made Ready the same way as through SDK.Ready()
+		gsCopy.SetState(agonesv1.GameServerStateRequestReady, msg)
+	default:
+		gsCopy.SetState(agonesv1.GameServerStateShutdown, msg)
+	}
+	gs, err = c.gameServerGetter.GameServers(namespace).Update(ctx, gsCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "error updating idle GameServer %s to %s", name, gsCopy.Status.State)
+	}
+
+	c.recorder.Event(gs, corev1.EventTypeNormal, string(gs.Status.State), msg)
+	return nil
+}
+
+// reallocatedSinceIdle returns true if the GameServer was allocated again after its Status.IdleSince.
+// The allocation time is truncated to the second precision that Status.IdleSince is stored with.
+func reallocatedSinceIdle(gs *agonesv1.GameServer) bool {
+	allocated, err := time.Parse(time.RFC3339Nano, gs.ObjectMeta.Annotations[agonesv1.GameServerLastAllocatedAnnotation])
+	if err != nil {
+		return false
+	}
+	return allocated.Truncate(time.Second).After(gs.Status.IdleSince.Time)
+}
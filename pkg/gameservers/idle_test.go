@@ -0,0 +1,195 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"testing"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	agtesting "agones.dev/agones/pkg/testing"
+	"github.com/heptiolabs/healthcheck"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestIdleControllerSyncGameServer(t *testing.T) {
+	t.Parallel()
+
+	idleSince := func(ago time.Duration) *metav1.Time {
+		ts := metav1.NewTime(time.Now().Add(-ago))
+		return &ts
+	}
+
+	type expected struct {
+		updated     bool
+		updateTests func(t *testing.T, gs *agonesv1.GameServer)
+		event       string
+	}
+	fixtures := map[string]struct {
+		setup    func(*agonesv1.GameServer)
+		expected expected
+	}{
+		"allocated and not empty": {
+			setup: func(gs *agonesv1.GameServer) {
+				gs.Status.Players.Count = 2
+			},
+			expected: expected{updated: false},
+		},
+		"allocated and empty": {
+			setup: func(_ *agonesv1.GameServer) {},
+			expected: expected{
+				updated: true,
+				updateTests: func(t *testing.T, gs *agonesv1.GameServer) {
+					assert.Equal(t, agonesv1.GameServerStateAllocated, gs.Status.State)
+					require.NotNil(t, gs.Status.IdleSince)
+					assert.WithinDuration(t, time.Now(), gs.Status.IdleSince.Time, 5*time.Second)
+				},
+			},
+		},
+		"empty, but not for long enough": {
+			setup: func(gs *agonesv1.GameServer) {
+				gs.Status.IdleSince = idleSince(30 * time.Second)
+			},
+			expected: expected{updated: false},
+		},
+		"no longer empty": {
+			setup: func(gs *agonesv1.GameServer) {
+				gs.Status.IdleSince = idleSince(30 * time.Second)
+				gs.Status.Players.Count = 1
+			},
+			expected: expected{
+				updated: true,
+				updateTests: func(t *testing.T, gs *agonesv1.GameServer) {
+					assert.Nil(t, gs.Status.IdleSince)
+				},
+			},
+		},
+		"no longer allocated": {
+			setup: func(gs *agonesv1.GameServer) {
+				gs.Status.IdleSince = idleSince(30 * time.Second)
+				gs.Status.State = agonesv1.GameServerStateReady
+			},
+			expected: expected{
+				updated: true,
+				updateTests: func(t *testing.T, gs *agonesv1.GameServer) {
+					assert.Equal(t, agonesv1.GameServerStateReady, gs.Status.State)
+					assert.Nil(t, gs.Status.IdleSince)
+				},
+			},
+		},
+		"empty for long enough, shutdown": {
+			setup: func(gs *agonesv1.GameServer) {
+				gs.Status.IdleSince = idleSince(2 * time.Minute)
+			},
+			expected: expected{
+				updated: true,
+				updateTests: func(t *testing.T, gs *agonesv1.GameServer) {
+					assert.Equal(t, agonesv1.GameServerStateShutdown, gs.Status.State)
+				},
+				event: "Normal Shutdown Empty for 1m0s while Allocated",
+			},
+		},
+		"empty for long enough, ready": {
+			setup: func(gs *agonesv1.GameServer) {
+				gs.Spec.Idle.Action = agonesv1.IdleActionReady
+				gs.Status.IdleSince = idleSince(2 * time.Minute)
+			},
+			expected: expected{
+				updated: true,
+				updateTests: func(t *testing.T, gs *agonesv1.GameServer) {
+					assert.Equal(t, agonesv1.GameServerStateRequestReady, gs.Status.State)
+					assert.Nil(t, gs.Status.IdleSince)
+				},
+				event: "Normal RequestReady Empty for 1m0s while Allocated",
+			},
+		},
+		"empty for long enough, but allocated again": {
+			setup: func(gs *agonesv1.GameServer) {
+				gs.Status.IdleSince = idleSince(2 * time.Minute)
+				gs.ObjectMeta.Annotations = map[string]string{agonesv1.GameServerLastAllocatedAnnotation: time.Now().Add(-10 * time.Second).Format(time.RFC3339Nano)}
+			},
+			expected: expected{
+				updated: true,
+				updateTests: func(t *testing.T, gs *agonesv1.GameServer) {
+					assert.Equal(t, agonesv1.GameServerStateAllocated, gs.Status.State)
+					require.NotNil(t, gs.Status.IdleSince)
+					assert.WithinDuration(t, time.Now(), gs.Status.IdleSince.Time, 5*time.Second)
+				},
+			},
+		},
+		"empty for long enough, allocated before": {
+			setup: func(gs *agonesv1.GameServer) {
+				gs.Status.IdleSince = idleSince(2 * time.Minute)
+				gs.ObjectMeta.Annotations = map[string]string{agonesv1.GameServerLastAllocatedAnnotation: time.Now().Add(-3 * time.Minute).Format(time.RFC3339Nano)}
+			},
+			expected: expected{
+				updated: true,
+				updateTests: func(t *testing.T, gs *agonesv1.GameServer) {
+					assert.Equal(t, agonesv1.GameServerStateShutdown, gs.Status.State)
+				},
+				event: "Normal Shutdown Empty for 1m0s while Allocated",
+			},
+		},
+		"game server is being deleted": {
+			setup: func(gs *agonesv1.GameServer) {
+				now := metav1.Now()
+				gs.ObjectMeta.DeletionTimestamp = &now
+				gs.Status.IdleSince = idleSince(2 * time.Minute)
+			},
+			expected: expected{updated: false},
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			m := agtesting.NewMocks()
+			c := NewIdleController(healthcheck.NewHandler(), m.KubeClient, m.AgonesClient, m.AgonesInformerFactory)
+			c.recorder = m.FakeRecorder
+
+			gs := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec: newSingleContainerSpec(), Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateAllocated}}
+			gs.Spec.Idle = &agonesv1.Idle{Seconds: 60}
+			gs.ApplyDefaults()
+			gs.Status.Players = &agonesv1.PlayerStatus{}
+			v.setup(gs)
+
+			m.AgonesClient.AddReactor("list", "gameservers", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+				return true, &agonesv1.GameServerList{Items: []agonesv1.GameServer{*gs}}, nil
+			})
+
+			updated := false
+			m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				updated = true
+				gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+				v.expected.updateTests(t, gs)
+				return true, gs, nil
+			})
+
+			ctx, cancel := agtesting.StartInformers(m, c.gameServerSynced)
+			defer cancel()
+
+			err := c.syncGameServer(ctx, "default/test")
+			require.NoError(t, err)
+			require.Equal(t, v.expected.updated, updated)
+			if v.expected.event != "" {
+				agtesting.AssertEventContains(t, m.FakeRecorder.Events, v.expected.event)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"agones.dev/agones/pkg/apis/agones"
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"agones.dev/agones/pkg/client/clientset/versioned"
+	"agones.dev/agones/pkg/client/clientset/versioned/scheme"
+	getterv1 "agones.dev/agones/pkg/client/clientset/versioned/typed/agones/v1"
+	"agones.dev/agones/pkg/client/informers/externalversions"
+	listerv1 "agones.dev/agones/pkg/client/listers/agones/v1"
+	"agones.dev/agones/pkg/util/logfields"
+	"agones.dev/agones/pkg/util/runtime"
+	"agones.dev/agones/pkg/util/workerqueue"
+	"github.com/heptiolabs/healthcheck"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+// LifetimeController moves a GameServer to Shutdown once it has exceeded one of the
+// limits in its Spec.Lifetime, after the configured grace period.
+type LifetimeController struct {
+	baseLogger       *logrus.Entry
+	gameServerSynced cache.InformerSynced
+	gameServerGetter getterv1.GameServersGetter
+	gameServerLister listerv1.GameServerLister
+	workerqueue      *workerqueue.WorkerQueue
+	recorder         record.EventRecorder
+}
+
+// NewLifetimeController creates a new LifetimeController and sets up event handlers.
+func NewLifetimeController(health healthcheck.Handler,
+	kubeClient kubernetes.Interface,
+	agonesClient versioned.Interface,
+	agonesInformerFactory externalversions.SharedInformerFactory) *LifetimeController {
+	gameServers := agonesInformerFactory.Agones().V1().GameServers()
+
+	c := &LifetimeController{
+		gameServerSynced: gameServers.Informer().HasSynced,
+		gameServerGetter: agonesClient.AgonesV1(),
+		gameServerLister: gameServers.Lister(),
+	}
+
+	c.baseLogger = runtime.NewLoggerWithType(c)
+	c.workerqueue = workerqueue.NewWorkerQueue(c.syncGameServer, c.baseLogger, logfields.GameServerKey, agones.GroupName+".LifetimeController")
+	health.AddLivenessCheck("gameserver-lifetime-workerqueue", healthcheck.Check(c.workerqueue.Healthy))
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(c.baseLogger.Debugf)
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+	c.recorder = eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "lifetime-controller"})
+
+	_, _ = gameServers.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			gs := obj.(*agonesv1.GameServer)
+			if gs.Spec.Lifetime != nil {
+				c.workerqueue.Enqueue(gs)
+			}
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			gs := newObj.(*agonesv1.GameServer)
+			if gs.Spec.Lifetime != nil {
+				c.workerqueue.Enqueue(gs)
+			}
+		},
+	})
+
+	return c
+}
+
+// Run starts the LifetimeController worker queue after ensuring caches are synced.
+func (c *LifetimeController) Run(ctx context.Context, workers int) error {
+	c.baseLogger.Debug("Wait for cache sync")
+	if !cache.WaitForCacheSync(ctx.Done(), c.gameServerSynced) {
+		return errors.New("failed to wait for caches to sync")
+	}
+
+	c.workerqueue.Run(ctx, workers)
+	return nil
+}
+
+func (c *LifetimeController) loggerForGameServerKey(key string) *logrus.Entry {
+	return logfields.AugmentLogEntry(c.baseLogger, logfields.GameServerKey, key)
+}
+
+// syncGameServer annotates a GameServer with the time it will be shut down once it has exceeded one of its lifetime
+// limits, and changes it to Shutdown state once that time has passed. Until then, it is re-queued for when it
+// next needs to be checked.
+func (c *LifetimeController) syncGameServer(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		// don't return an error, as we don't want this retried
+		runtime.HandleError(c.loggerForGameServerKey(key), errors.Wrapf(err, "invalid resource key"))
+		return nil
+	}
+
+	gs, err := c.gameServerLister.GameServers(namespace).Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			c.loggerForGameServerKey(key).Debug("GameServer is no longer available for syncing")
+			return nil
+		}
+		return errors.Wrapf(err, "error retrieving GameServer %s from namespace %s", name, namespace)
+	}
+
+	// already on the way out, so no need to do anything.
+	if gs.IsBeingDeleted() || agonesv1.TerminalGameServerStates[gs.Status.State] {
+		return nil
+	}
+
+	now := time.Now()
+	if gs.IsShuttingDown() {
+		shutdownAt, err := time.Parse(time.RFC3339, gs.ObjectMeta.Annotations[agonesv1.GameServerShutdownAtAnnotation])
+		if err != nil {
+			runtime.HandleError(c.loggerForGameServerKey(key), errors.Wrapf(err, "invalid %s annotation", agonesv1.GameServerShutdownAtAnnotation))
+			return nil
+		}
+		if now.Before(shutdownAt) {
+			c.workerqueue.EnqueueAfter(gs, shutdownAt.Sub(now))
+			return nil
+		}
+		return c.shutdown(ctx, gs, "Lifetime grace period has ended")
+	}
+
+	limit, reason, ok := gs.LifetimeLimit()
+	if !ok {
+		return nil
+	}
+	if now.Before(limit) {
+		c.workerqueue.EnqueueAfter(gs, limit.Sub(now))
+		return nil
+	}
+
+	grace := time.Duration(gs.Spec.Lifetime.GracePeriodSeconds) * time.Second
+	if grace <= 0 {
+		return c.shutdown(ctx, gs, fmt.Sprintf("%s exceeded", reason))
+	}
+
+	shutdownAt := now.Add(grace)
+	gsCopy := gs.DeepCopy()
+	if gsCopy.ObjectMeta.Annotations == nil {
+		gsCopy.ObjectMeta.Annotations = map[string]string{}
+	}
+	gsCopy.ObjectMeta.Annotations[agonesv1.GameServerShutdownAtAnnotation] = shutdownAt.UTC().Format(time.RFC3339)
+	// give the game the whole grace period of warning, rather than only once it is shut down
+	if runtime.FeatureEnabled(runtime.FeatureTerminationNotices) {
+		gsCopy.SetTerminationNotice(agonesv1.TerminationReasonLifetimeExpired, shutdownAt)
+	}
+	gs, err = c.gameServerGetter.GameServers(gsCopy.ObjectMeta.Namespace).Update(ctx, gsCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "error setting %s annotation on GameServer %s", agonesv1.GameServerShutdownAtAnnotation, gsCopy.ObjectMeta.Name)
+	}
+
+	c.recorder.Eventf(gs, corev1.EventTypeNormal, "LifetimeExceeded", "%s exceeded, shutting down in %s", reason, grace)
+	c.workerqueue.EnqueueAfter(gs, grace)
+	return nil
+}
+
+// shutdown changes the GameServer to Shutdown state
+func (c *LifetimeController) shutdown(ctx context.Context, gs *agonesv1.GameServer, msg string) error {
+	gsCopy := gs.DeepCopy()
+	gsCopy.SetState(agonesv1.GameServerStateShutdown, msg)
+	if runtime.FeatureEnabled(runtime.FeatureTerminationNotices) {
+		gsCopy.SetTerminationNotice(agonesv1.TerminationReasonLifetimeExpired, time.Now().Add(gs.TerminationGracePeriod()))
+	}
+	gs, err := c.gameServerGetter.GameServers(gsCopy.ObjectMeta.Namespace).Update(ctx, gsCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return errors.Wrap(err, "error updating GameServer to Shutdown")
+	}
+
+	c.recorder.Event(gs, corev1.EventTypeNormal, string(gs.Status.State), msg)
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"testing"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	agtesting "agones.dev/agones/pkg/testing"
+	agruntime "agones.dev/agones/pkg/util/runtime"
+	"github.com/heptiolabs/healthcheck"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestLifetimeControllerSyncGameServer(t *testing.T) {
+	t.Parallel()
+	agruntime.FeatureTestMutex.Lock()
+	defer agruntime.FeatureTestMutex.Unlock()
+	require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureGameServerLifetime)+"=true&"+string(agruntime.FeatureTerminationNotices)+"=true"))
+
+	type expected struct {
+		updated     bool
+		updateTests func(t *testing.T, gs *agonesv1.GameServer)
+		event       string
+	}
+	fixtures := map[string]struct {
+		setup    func(*agonesv1.GameServer)
+		expected expected
+	}{
+		"limit not yet exceeded": {
+			setup: func(gs *agonesv1.GameServer) {
+				gs.Spec.Lifetime = &agonesv1.Lifetime{MaxSeconds: 3600}
+			},
+			expected: expected{updated: false},
+		},
+		"limit exceeded, no grace period": {
+			setup: func(gs *agonesv1.GameServer) {
+				gs.Spec.Lifetime = &agonesv1.Lifetime{MaxReadySeconds: 60}
+				readyTime := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+				gs.Status.ReadyTime = &readyTime
+			},
+			expected: expected{
+				updated: true,
+				updateTests: func(t *testing.T, gs *agonesv1.GameServer) {
+					assert.Equal(t, agonesv1.GameServerStateShutdown, gs.Status.State)
+				},
+				event: "Normal Shutdown Maximum time Ready exceeded",
+			},
+		},
+		"limit exceeded, with grace period": {
+			setup: func(gs *agonesv1.GameServer) {
+				gs.Spec.Lifetime = &agonesv1.Lifetime{MaxSeconds: 60, GracePeriodSeconds: 30}
+				gs.ObjectMeta.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Minute))
+			},
+			expected: expected{
+				updated: true,
+				updateTests: func(t *testing.T, gs *agonesv1.GameServer) {
+					assert.Equal(t, agonesv1.GameServerStateReady, gs.Status.State)
+					shutdownAt, err := time.Parse(time.RFC3339, gs.ObjectMeta.Annotations[agonesv1.GameServerShutdownAtAnnotation])
+					require.NoError(t, err)
+					assert.WithinDuration(t, time.Now().Add(30*time.Second), shutdownAt, 5*time.Second)
+					// the game is warned when the grace period starts
+					assert.Equal(t, agonesv1.TerminationReasonLifetimeExpired, gs.ObjectMeta.Annotations[agonesv1.GameServerTerminationReasonAnnotation])
+					assert.Equal(t, gs.ObjectMeta.Annotations[agonesv1.GameServerShutdownAtAnnotation], gs.ObjectMeta.Annotations[agonesv1.GameServerTerminationDeadlineAnnotation])
+				},
+				event: "Normal LifetimeExceeded Maximum lifetime exceeded, shutting down in 30s",
+			},
+		},
+		"grace period not yet over": {
+			setup: func(gs *agonesv1.GameServer) {
+				gs.Spec.Lifetime = &agonesv1.Lifetime{MaxSeconds: 60, GracePeriodSeconds: 30}
+				gs.ObjectMeta.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Minute))
+				gs.ObjectMeta.Annotations = map[string]string{
+					agonesv1.GameServerShutdownAtAnnotation: time.Now().Add(time.Minute).UTC().Format(time.RFC3339),
+				}
+			},
+			expected: expected{updated: false},
+		},
+		"grace period over": {
+			setup: func(gs *agonesv1.GameServer) {
+				gs.Spec.Lifetime = &agonesv1.Lifetime{MaxSeconds: 60, GracePeriodSeconds: 30}
+				gs.ObjectMeta.Annotations = map[string]string{
+					agonesv1.GameServerShutdownAtAnnotation: time.Now().Add(-time.Second).UTC().Format(time.RFC3339),
+				}
+			},
+			expected: expected{
+				updated: true,
+				updateTests: func(t *testing.T, gs *agonesv1.GameServer) {
+					assert.Equal(t, agonesv1.GameServerStateShutdown, gs.Status.State)
+				},
+				event: "Normal Shutdown Lifetime grace period has ended",
+			},
+		},
+		"game server is already in Shutdown state": {
+			setup: func(gs *agonesv1.GameServer) {
+				gs.Spec.Lifetime = &agonesv1.Lifetime{MaxSeconds: 60}
+				gs.ObjectMeta.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Minute))
+				gs.Status.State = agonesv1.GameServerStateShutdown
+			},
+			expected: expected{updated: false},
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			m := agtesting.NewMocks()
+			c := NewLifetimeController(healthcheck.NewHandler(), m.KubeClient, m.AgonesClient, m.AgonesInformerFactory)
+			c.recorder = m.FakeRecorder
+
+			gs := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", CreationTimestamp: metav1.Now()},
+				Spec: newSingleContainerSpec(), Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady}}
+			gs.ApplyDefaults()
+			v.setup(gs)
+
+			m.AgonesClient.AddReactor("list", "gameservers", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+				return true, &agonesv1.GameServerList{Items: []agonesv1.GameServer{*gs}}, nil
+			})
+
+			updated := false
+			m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				updated = true
+				gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+				v.expected.updateTests(t, gs)
+				return true, gs, nil
+			})
+
+			ctx, cancel := agtesting.StartInformers(m, c.gameServerSynced)
+			defer cancel()
+
+			err := c.syncGameServer(ctx, "default/test")
+			require.NoError(t, err)
+			require.Equal(t, v.expected.updated, updated)
+			if v.expected.event != "" {
+				agtesting.AssertEventContains(t, m.FakeRecorder.Events, v.expected.event)
+			}
+		})
+	}
+}
@@ -217,8 +217,8 @@ func (mc *MigrationController) syncGameServer(ctx context.Context, key string) e
 		}
 		eventMsg = "Address updated due to Node migration"
 	} else {
-		gsCopy.Status.State = agonesv1.GameServerStateUnhealthy
 		eventMsg = "Node migration occurred"
+		gsCopy.SetState(agonesv1.GameServerStateUnhealthy, eventMsg)
 	}
 
 	if gs, err = mc.gameServerGetter.GameServers(gsCopy.ObjectMeta.Namespace).Update(ctx, gsCopy, metav1.UpdateOptions{}); err != nil {
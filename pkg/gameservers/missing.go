@@ -159,7 +159,7 @@ func (c *MissingPodController) syncGameServer(ctx context.Context, key string) e
 	}
 
 	gsCopy := gs.DeepCopy()
-	gsCopy.Status.State = agonesv1.GameServerStateUnhealthy
+	gsCopy.SetState(agonesv1.GameServerStateUnhealthy, "Pod is missing")
 	gs, err = c.gameServerGetter.GameServers(gsCopy.ObjectMeta.Namespace).Update(ctx, gsCopy, metav1.UpdateOptions{})
 	if err != nil {
 		return errors.Wrap(err, "error updating GameServer to Unhealthy")
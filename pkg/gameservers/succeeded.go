@@ -155,7 +155,7 @@ func (c *SucceededController) syncGameServer(ctx context.Context, key string) er
 	}
 
 	gsCopy := gs.DeepCopy()
-	gsCopy.Status.State = agonesv1.GameServerStateShutdown
+	gsCopy.SetState(agonesv1.GameServerStateShutdown, "Pod is in Succeeded state")
 	gs, err = c.gameServerGetter.GameServers(gsCopy.ObjectMeta.Namespace).Update(ctx, gsCopy, metav1.UpdateOptions{})
 	if err != nil {
 		return errors.Wrap(err, "error updating GameServer to Shutdown")
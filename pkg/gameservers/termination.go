@@ -0,0 +1,193 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"context"
+	"time"
+
+	"agones.dev/agones/pkg/apis/agones"
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"agones.dev/agones/pkg/client/clientset/versioned"
+	"agones.dev/agones/pkg/client/clientset/versioned/scheme"
+	getterv1 "agones.dev/agones/pkg/client/clientset/versioned/typed/agones/v1"
+	"agones.dev/agones/pkg/client/informers/externalversions"
+	listerv1 "agones.dev/agones/pkg/client/listers/agones/v1"
+	"agones.dev/agones/pkg/util/logfields"
+	"agones.dev/agones/pkg/util/runtime"
+	"agones.dev/agones/pkg/util/workerqueue"
+	"github.com/heptiolabs/healthcheck"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	corelisterv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+// TerminationController records on a GameServer that it is about to be terminated, when its Pod is
+// being evicted or deleted, so the SDK can notify the game server process.
+type TerminationController struct {
+	baseLogger       *logrus.Entry
+	podSynced        cache.InformerSynced
+	podLister        corelisterv1.PodLister
+	nodeSynced       cache.InformerSynced
+	nodeLister       corelisterv1.NodeLister
+	gameServerSynced cache.InformerSynced
+	gameServerGetter getterv1.GameServersGetter
+	gameServerLister listerv1.GameServerLister
+	workerqueue      *workerqueue.WorkerQueue
+	recorder         record.EventRecorder
+}
+
+// NewTerminationController creates a new TerminationController and sets up event handlers.
+func NewTerminationController(health healthcheck.Handler,
+	kubeClient kubernetes.Interface,
+	agonesClient versioned.Interface,
+	kubeInformerFactory informers.SharedInformerFactory,
+	agonesInformerFactory externalversions.SharedInformerFactory) *TerminationController {
+	podInformer := kubeInformerFactory.Core().V1().Pods().Informer()
+	nodes := kubeInformerFactory.Core().V1().Nodes()
+	gameServers := agonesInformerFactory.Agones().V1().GameServers()
+
+	c := &TerminationController{
+		podSynced:        podInformer.HasSynced,
+		podLister:        kubeInformerFactory.Core().V1().Pods().Lister(),
+		nodeSynced:       nodes.Informer().HasSynced,
+		nodeLister:       nodes.Lister(),
+		gameServerSynced: gameServers.Informer().HasSynced,
+		gameServerGetter: agonesClient.AgonesV1(),
+		gameServerLister: gameServers.Lister(),
+	}
+
+	c.baseLogger = runtime.NewLoggerWithType(c)
+	c.workerqueue = workerqueue.NewWorkerQueue(c.syncGameServer, c.baseLogger, logfields.GameServerKey, agones.GroupName+".TerminationController")
+	health.AddLivenessCheck("gameserver-termination-workerqueue", healthcheck.Check(c.workerqueue.Healthy))
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(c.baseLogger.Debugf)
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+	c.recorder = eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "termination-controller"})
+
+	_, _ = podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(_, newObj interface{}) {
+			pod := newObj.(*corev1.Pod)
+			if isGameServerPod(pod) && isPodTerminating(pod) {
+				c.workerqueue.Enqueue(pod)
+			}
+		},
+	})
+
+	return c
+}
+
+// Run starts the TerminationController worker queue after ensuring caches are synced.
+func (c *TerminationController) Run(ctx context.Context, workers int) error {
+	c.baseLogger.Debug("Wait for cache sync")
+	if !cache.WaitForCacheSync(ctx.Done(), c.gameServerSynced, c.podSynced, c.nodeSynced) {
+		return errors.New("failed to wait for caches to sync")
+	}
+
+	c.workerqueue.Run(ctx, workers)
+	return nil
+}
+
+func (c *TerminationController) loggerForGameServerKey(key string) *logrus.Entry {
+	return logfields.AugmentLogEntry(c.baseLogger, logfields.GameServerKey, key)
+}
+
+// syncGameServer records the termination reason and deadline on a GameServer whose Pod is being
+// evicted or deleted. The reason is NodeDrain if the Pod's Node has been cordoned, and Eviction otherwise.
+func (c *TerminationController) syncGameServer(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		// don't return an error, as we don't want this retried
+		runtime.HandleError(c.loggerForGameServerKey(key), errors.Wrapf(err, "invalid resource key"))
+		return nil
+	}
+
+	pod, err := c.podLister.Pods(namespace).Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			c.loggerForGameServerKey(key).Debug("Pod is no longer available for syncing")
+			return nil
+		}
+		return errors.Wrapf(err, "error retrieving Pod %s from namespace %s", name, namespace)
+	}
+	if !isGameServerPod(pod) || !isPodTerminating(pod) {
+		return nil
+	}
+
+	gs, err := c.gameServerLister.GameServers(namespace).Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			c.loggerForGameServerKey(key).Debug("GameServer is no longer available for syncing")
+			return nil
+		}
+		return errors.Wrapf(err, "error retrieving GameServer %s from namespace %s", name, namespace)
+	}
+
+	// already on the way out, so the Pod is being deleted by Agones.
+	if gs.IsBeingDeleted() || agonesv1.TerminalGameServerStates[gs.Status.State] {
+		return nil
+	}
+
+	reason := agonesv1.TerminationReasonEviction
+	if pod.Spec.NodeName != "" {
+		node, err := c.nodeLister.Get(pod.Spec.NodeName)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error retrieving Node %s for Pod %s", pod.Spec.NodeName, pod.ObjectMeta.Name)
+		}
+		if node != nil && node.Spec.Unschedulable {
+			reason = agonesv1.TerminationReasonNodeDrain
+		}
+	}
+	var deadline time.Time
+	if pod.ObjectMeta.DeletionTimestamp != nil {
+		deadline = pod.ObjectMeta.DeletionTimestamp.Time
+	}
+
+	if r, d, ok := gs.TerminationNotice(); ok && r == reason && d.Equal(deadline.Truncate(time.Second)) {
+		return nil
+	}
+
+	gsCopy := gs.DeepCopy()
+	gsCopy.SetTerminationNotice(reason, deadline)
+	gs, err = c.gameServerGetter.GameServers(gsCopy.ObjectMeta.Namespace).Update(ctx, gsCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "error setting termination notice on GameServer %s", gsCopy.ObjectMeta.Name)
+	}
+
+	c.recorder.Eventf(gs, corev1.EventTypeNormal, "TerminationNotice", "Pod is being terminated: %s", reason)
+	return nil
+}
+
+// isPodTerminating returns true if the Pod is being deleted, or is about to be evicted.
+func isPodTerminating(pod *corev1.Pod) bool {
+	if !pod.ObjectMeta.DeletionTimestamp.IsZero() {
+		return true
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.DisruptionTarget && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
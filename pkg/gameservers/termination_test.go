@@ -0,0 +1,136 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"testing"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	agtesting "agones.dev/agones/pkg/testing"
+	agruntime "agones.dev/agones/pkg/util/runtime"
+	"github.com/heptiolabs/healthcheck"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestTerminationControllerSyncGameServer(t *testing.T) {
+	t.Parallel()
+	agruntime.FeatureTestMutex.Lock()
+	defer agruntime.FeatureTestMutex.Unlock()
+	require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureTerminationNotices)+"=true"))
+
+	deletion := metav1.NewTime(time.Now().Add(30 * time.Second).Truncate(time.Second))
+	disruption := corev1.PodCondition{Type: corev1.DisruptionTarget, Status: corev1.ConditionTrue, Reason: "EvictionByEvictionAPI"}
+
+	type expected struct {
+		updated  bool
+		reason   string
+		deadline string
+	}
+	fixtures := map[string]struct {
+		setup    func(*agonesv1.GameServer, *corev1.Pod, *corev1.Node)
+		expected expected
+	}{
+		"pod is not terminating": {
+			setup:    func(_ *agonesv1.GameServer, _ *corev1.Pod, _ *corev1.Node) {},
+			expected: expected{updated: false},
+		},
+		"pod is being deleted": {
+			setup: func(_ *agonesv1.GameServer, pod *corev1.Pod, _ *corev1.Node) {
+				pod.ObjectMeta.DeletionTimestamp = &deletion
+			},
+			expected: expected{
+				updated:  true,
+				reason:   agonesv1.TerminationReasonEviction,
+				deadline: deletion.UTC().Format(time.RFC3339),
+			},
+		},
+		"pod is about to be evicted from a cordoned node": {
+			setup: func(_ *agonesv1.GameServer, pod *corev1.Pod, node *corev1.Node) {
+				pod.Status.Conditions = append(pod.Status.Conditions, disruption)
+				node.Spec.Unschedulable = true
+			},
+			expected: expected{
+				updated: true,
+				reason:  agonesv1.TerminationReasonNodeDrain,
+			},
+		},
+		"notice already recorded": {
+			setup: func(gs *agonesv1.GameServer, pod *corev1.Pod, _ *corev1.Node) {
+				pod.ObjectMeta.DeletionTimestamp = &deletion
+				gs.SetTerminationNotice(agonesv1.TerminationReasonEviction, deletion.Time)
+			},
+			expected: expected{updated: false},
+		},
+		"game server is already in Shutdown state": {
+			setup: func(gs *agonesv1.GameServer, pod *corev1.Pod, _ *corev1.Node) {
+				pod.ObjectMeta.DeletionTimestamp = &deletion
+				gs.Status.State = agonesv1.GameServerStateShutdown
+			},
+			expected: expected{updated: false},
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			m := agtesting.NewMocks()
+			c := NewTerminationController(healthcheck.NewHandler(), m.KubeClient, m.AgonesClient, m.KubeInformerFactory, m.AgonesInformerFactory)
+			c.recorder = m.FakeRecorder
+
+			gs := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec: newSingleContainerSpec(), Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateAllocated, NodeName: nodeFixtureName}}
+			gs.ApplyDefaults()
+			pod, err := gs.Pod(agtesting.FakeAPIHooks{})
+			require.NoError(t, err)
+			pod.Spec.NodeName = nodeFixtureName
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeFixtureName}}
+
+			v.setup(gs, pod, node)
+			m.AgonesClient.AddReactor("list", "gameservers", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+				return true, &agonesv1.GameServerList{Items: []agonesv1.GameServer{*gs}}, nil
+			})
+			m.KubeClient.AddReactor("list", "pods", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+				return true, &corev1.PodList{Items: []corev1.Pod{*pod}}, nil
+			})
+			m.KubeClient.AddReactor("list", "nodes", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+				return true, &corev1.NodeList{Items: []corev1.Node{*node}}, nil
+			})
+
+			updated := false
+			m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				updated = true
+				gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+				assert.Equal(t, v.expected.reason, gs.ObjectMeta.Annotations[agonesv1.GameServerTerminationReasonAnnotation])
+				assert.Equal(t, v.expected.deadline, gs.ObjectMeta.Annotations[agonesv1.GameServerTerminationDeadlineAnnotation])
+				return true, gs, nil
+			})
+
+			ctx, cancel := agtesting.StartInformers(m, c.gameServerSynced, c.podSynced, c.nodeSynced)
+			defer cancel()
+
+			err = c.syncGameServer(ctx, "default/test")
+			require.NoError(t, err)
+			assert.Equal(t, v.expected.updated, updated)
+			if v.expected.updated {
+				agtesting.AssertEventContains(t, m.FakeRecorder.Events, "TerminationNotice")
+			}
+		})
+	}
+}
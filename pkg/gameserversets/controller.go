@@ -17,6 +17,7 @@ package gameserversets
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"sync"
 	"time"
 
@@ -44,6 +45,7 @@ import (
 	apiextclientv1 "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	runtimeschema "k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -88,6 +90,7 @@ type Controller struct {
 	workerqueue                    *workerqueue.WorkerQueue
 	recorder                       record.EventRecorder
 	stateCache                     *gameServerStateCache
+	crashLoops                     *crashLoopCache
 	allocationController           *AllocationOverflowController
 	maxCreationParallelism         int
 	maxGameServerCreationsPerBatch int
@@ -130,6 +133,7 @@ func NewController(
 		maxGameServerDeletionsPerBatch: maxGameServerDeletionsPerBatch,
 		maxPodPendingCount:             maxPodPendingCount,
 		stateCache:                     &gameServerStateCache{},
+		crashLoops:                     &crashLoopCache{},
 	}
 
 	c.baseLogger = runtime.NewLoggerWithType(c)
@@ -154,6 +158,7 @@ func NewController(
 		},
 		DeleteFunc: func(gsSet interface{}) {
 			c.stateCache.deleteGameServerSet(gsSet.(*agonesv1.GameServerSet))
+			c.crashLoops.deleteGameServerSet(gsSet.(*agonesv1.GameServerSet))
 		},
 	})
 
@@ -316,6 +321,13 @@ func (c *Controller) syncGameServerSet(ctx context.Context, key string) error {
 
 	list = c.stateCache.forGameServerSet(gsSet).reconcileWithUpdatedServerList(list)
 
+	// failed GameServers kept for post-mortem inspection are not part of the GameServerSet anymore,
+	// so they are neither counted, nor replaced, until they have been kept for long enough to be deleted.
+	list, kept, expired, nextExpiry := splitPostMortemGameServers(list, time.Now())
+	if nextExpiry > 0 {
+		defer c.workerqueue.EnqueueAfter(gsSet, nextExpiry)
+	}
+
 	numServersToAdd, toDelete, isPartial := computeReconciliationAction(gsSet.Spec.Scheduling, list, c.counter.Counts(),
 		int(gsSet.Spec.Replicas), c.maxGameServerCreationsPerBatch, c.maxGameServerDeletionsPerBatch, c.maxPodPendingCount, gsSet.Spec.Priorities)
 
@@ -324,9 +336,34 @@ func (c *Controller) syncGameServerSet(ctx context.Context, key string) error {
 		numServersToAdd = 0
 	}
 
+	crashLoopBackoff := c.backoffCrashLoop(ctx, gsSet, list, kept, toDelete)
+	if runtime.FeatureEnabled(runtime.FeatureCrashLoopBackoff) {
+		// replacements are only created once the backoff is over, but scaling up is not delayed
+		numServersToAdd = c.crashLoops.forGameServerSet(gsSet).creations(int(gsSet.Spec.Replicas), numServersToAdd, crashLoopBackoff > 0)
+	}
+	if crashLoopBackoff > 0 {
+		isPartial = len(toDelete) >= c.maxGameServerDeletionsPerBatch || numServersToAdd >= c.maxGameServerCreationsPerBatch
+		defer c.workerqueue.EnqueueAfter(gsSet, crashLoopBackoff)
+	}
+
+	var toKeep []*agonesv1.GameServer
+	if postMortem := gsSet.Spec.Template.Spec.PostMortem; postMortem != nil && runtime.FeatureEnabled(runtime.FeaturePostMortemRetention) {
+		keptCount, err := c.postMortemCount(gsSet, kept)
+		if err != nil {
+			return err
+		}
+		toDelete, toKeep = computePostMortemAction(postMortem, toDelete, keptCount)
+	}
+	toDelete = append(toDelete, expired...)
+
 	status := computeStatus(gsSet, list)
 	fields := logrus.Fields{}
 
+	if d := nextWarmUp(list, time.Now()); d > 0 {
+		// sync again once the next GameServer has warmed up, to keep WarmingReplicas up to date
+		defer c.workerqueue.EnqueueAfter(gsSet, d)
+	}
+
 	for _, gs := range list {
 		key := "gsCount" + string(gs.Status.State)
 		if gs.ObjectMeta.DeletionTimestamp != nil {
@@ -343,6 +380,8 @@ func (c *Controller) syncGameServerSet(ctx context.Context, key string) error {
 		WithField("targetReplicaCount", gsSet.Spec.Replicas).
 		WithField("numServersToAdd", numServersToAdd).
 		WithField("numServersToDelete", len(toDelete)).
+		WithField("numServersToKeep", len(toKeep)).
+		WithField("crashLoopBackoff", crashLoopBackoff).
 		WithField("isPartial", isPartial).
 		WithField("status", status).
 		WithFields(fields).
@@ -368,9 +407,50 @@ func (c *Controller) syncGameServerSet(ctx context.Context, key string) error {
 		}
 	}
 
+	if len(toKeep) > 0 {
+		if err := c.keepGameServersForPostMortem(ctx, gsSet, toKeep); err != nil {
+			loggerForGameServerSet(c.baseLogger, gsSet).WithError(err).Warning("error keeping game servers for post-mortem")
+			return errors.Wrap(err, "error keeping game servers for post-mortem")
+		}
+	}
+
 	return c.syncGameServerSetStatus(ctx, gsSet, list)
 }
 
+// backoffCrashLoop records the GameServers to delete that failed before Ready, and returns how long the creation
+// of replacements is backed off for, if the GameServers of the GameServerSet keep failing before Ready.
+// The failures are restored from the GameServerSet on its first sync, as they are only tracked in memory.
+func (c *Controller) backoffCrashLoop(ctx context.Context, gsSet *agonesv1.GameServerSet, list, kept, toDelete []*agonesv1.GameServer) time.Duration {
+	if !runtime.FeatureEnabled(runtime.FeatureCrashLoopBackoff) {
+		return 0
+	}
+
+	now := time.Now()
+	entry := c.crashLoops.forGameServerSet(gsSet)
+	entry.restore(gsSet, failedBeforeSync(list, kept), now)
+
+	failed := entry.newFailures(failedBeforeReady(toDelete))
+	backoff, backedOff := entry.observe(list, failed, now)
+	recordCrashLoop(ctx, gsSet, failed, backedOff)
+	if backedOff {
+		c.recorder.Eventf(gsSet, corev1.EventTypeWarning, "CrashLoopBackOff",
+			"GameServers keep failing before Ready, backing off creating replacements for %s", backoff)
+	}
+	return backoff
+}
+
+// setCrashLoopCondition sets the CrashLoopBackOff condition of the GameServerSet status to whether its
+// GameServers keep failing before Ready. The condition is only added once it is True.
+func (c *Controller) setCrashLoopCondition(gsSet *agonesv1.GameServerSet, status *agonesv1.GameServerSetStatus) {
+	looping, failures := c.crashLoops.forGameServerSet(gsSet).crashLooping()
+	if looping {
+		status.SetCondition(agonesv1.GameServerSetCrashLoopBackOffCondition, corev1.ConditionTrue,
+			fmt.Sprintf(crashLoopMessage, failures), metav1.Now())
+	} else if status.Condition(agonesv1.GameServerSetCrashLoopBackOffCondition) != nil {
+		status.SetCondition(agonesv1.GameServerSetCrashLoopBackOffCondition, corev1.ConditionFalse, "", metav1.Now())
+	}
+}
+
 // computeReconciliationAction computes the action to take to reconcile a game server set set given
 // the list of game servers that were found and target replica count.
 func computeReconciliationAction(strategy apis.SchedulingStrategy, list []*agonesv1.GameServer,
@@ -549,7 +629,10 @@ func (c *Controller) deleteGameServers(ctx context.Context, gsSet *agonesv1.Game
 	return parallelize(gameServerListToChannel(toDelete), c.maxDeletionParallelism, func(gs *agonesv1.GameServer) error {
 		// We should not delete the gameservers directly buy set their state to shutdown and let the gameserver controller to delete
 		gsCopy := gs.DeepCopy()
-		gsCopy.Status.State = agonesv1.GameServerStateShutdown
+		gsCopy.SetState(agonesv1.GameServerStateShutdown, "Scaled down by GameServerSet")
+		if runtime.FeatureEnabled(runtime.FeatureTerminationNotices) {
+			gsCopy.SetTerminationNotice(agonesv1.TerminationReasonScaleDown, time.Now().Add(gs.TerminationGracePeriod()))
+		}
 		_, err := c.gameServerGetter.GameServers(gs.Namespace).Update(ctx, gsCopy, metav1.UpdateOptions{})
 		if err != nil {
 			return errors.Wrapf(err, "error updating gameserver %s from status %s to Shutdown status", gs.ObjectMeta.Name, gs.Status.State)
@@ -561,6 +644,42 @@ func (c *Controller) deleteGameServers(ctx context.Context, gsSet *agonesv1.Game
 	})
 }
 
+// keepGameServersForPostMortem labels the failed GameServers as kept for post-mortem inspection, instead of deleting them.
+func (c *Controller) keepGameServersForPostMortem(ctx context.Context, gsSet *agonesv1.GameServerSet, toKeep []*agonesv1.GameServer) error {
+	loggerForGameServerSet(c.baseLogger, gsSet).WithField("diff", len(toKeep)).Debug("Keeping gameservers for post-mortem")
+
+	return parallelize(gameServerListToChannel(toKeep), c.maxDeletionParallelism, func(gs *agonesv1.GameServer) error {
+		gsCopy := gs.DeepCopy()
+		gsCopy.KeepForPostMortem(time.Now())
+		_, err := c.gameServerGetter.GameServers(gs.Namespace).Update(ctx, gsCopy, metav1.UpdateOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "error keeping gameserver %s in status %s for post-mortem", gs.ObjectMeta.Name, gs.Status.State)
+		}
+
+		c.recorder.Eventf(gsSet, corev1.EventTypeNormal, "PostMortem", "Kept gameserver in state %s for post-mortem until %s: %v",
+			gs.Status.State, gsCopy.ObjectMeta.Annotations[agonesv1.GameServerPostMortemUntilAnnotation], gs.ObjectMeta.Name)
+		return nil
+	})
+}
+
+// postMortemCount returns the number of GameServers kept for post-mortem inspection in the Fleet of the
+// GameServerSet, or, if it is not part of a Fleet, the number of kept GameServers of the GameServerSet.
+func (c *Controller) postMortemCount(gsSet *agonesv1.GameServerSet, kept []*agonesv1.GameServer) (int, error) {
+	fleetName, ok := gsSet.ObjectMeta.Labels[agonesv1.FleetNameLabel]
+	if !ok {
+		return len(kept), nil
+	}
+	list, err := c.gameServerLister.GameServers(gsSet.ObjectMeta.Namespace).List(labels.SelectorFromSet(labels.Set{
+		agonesv1.FleetNameLabel:            fleetName,
+		agonesv1.GameServerPostMortemLabel: agonesv1.True,
+	}))
+	if err != nil {
+		return 0, errors.Wrapf(err, "error listing gameservers kept for post-mortem for fleet %s", fleetName)
+	}
+	_, fleetKept, _, _ := splitPostMortemGameServers(list, time.Now())
+	return len(fleetKept), nil
+}
+
 func newGameServersChannel(n int, gsSet *agonesv1.GameServerSet) chan *agonesv1.GameServer {
 	gameServers := make(chan *agonesv1.GameServer)
 	go func() {
@@ -622,7 +741,11 @@ func parallelize(gameServers chan *agonesv1.GameServer, parallelism int, work fu
 
 // syncGameServerSetStatus synchronises the GameServerSet State with active GameServer counts
 func (c *Controller) syncGameServerSetStatus(ctx context.Context, gsSet *agonesv1.GameServerSet, list []*agonesv1.GameServer) error {
-	return c.updateStatusIfChanged(ctx, gsSet, computeStatus(gsSet, list))
+	status := computeStatus(gsSet, list)
+	if runtime.FeatureEnabled(runtime.FeatureCrashLoopBackoff) {
+		c.setCrashLoopCondition(gsSet, &status)
+	}
+	return c.updateStatusIfChanged(ctx, gsSet, status)
 }
 
 // updateStatusIfChanged updates GameServerSet status if it's different than provided.
@@ -640,7 +763,9 @@ func (c *Controller) updateStatusIfChanged(ctx context.Context, gsSet *agonesv1.
 
 // computeStatus computes the status of the game server set.
 func computeStatus(gsSet *agonesv1.GameServerSet, list []*agonesv1.GameServer) agonesv1.GameServerSetStatus {
-	var status agonesv1.GameServerSetStatus
+	// conditions are set by the controller as it reconciles, rather than computed from the GameServers
+	status := agonesv1.GameServerSetStatus{Conditions: gsSet.Status.Conditions}
+	now := time.Now()
 
 	// Initialize list status with empty lists from spec
 	if runtime.FeatureEnabled(runtime.FeatureCountsAndLists) {
@@ -658,6 +783,9 @@ func computeStatus(gsSet *agonesv1.GameServerSet, list []*agonesv1.GameServer) a
 		switch gs.Status.State {
 		case agonesv1.GameServerStateReady:
 			status.ReadyReplicas++
+			if gs.IsWarmingUp(now) {
+				status.WarmingReplicas++
+			}
 		case agonesv1.GameServerStateAllocated:
 			status.AllocatedReplicas++
 		case agonesv1.GameServerStateReserved:
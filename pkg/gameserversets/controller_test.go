@@ -21,6 +21,7 @@ import (
 	"math/rand"
 	"net/http"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
@@ -40,6 +41,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -314,6 +316,25 @@ func TestComputeStatus(t *testing.T) {
 		}
 	})
 
+	t.Run("min ready seconds", func(t *testing.T) {
+		utilruntime.FeatureTestMutex.Lock()
+		defer utilruntime.FeatureTestMutex.Unlock()
+
+		require.NoError(t, utilruntime.ParseFeatures(fmt.Sprintf("%s=true&%s=false", utilruntime.FeatureMinReadySeconds, utilruntime.FeatureCountsAndLists)))
+
+		gsSet := defaultFixture()
+		readyTime := metav1.NewTime(time.Now().Add(-5 * time.Second))
+		var list []*agonesv1.GameServer
+		for _, minReadySeconds := range []int32{0, 1, 60, 120} {
+			gs := gsWithState(agonesv1.GameServerStateReady)
+			gs.Spec.MinReadySeconds = minReadySeconds
+			gs.Status.ReadyTime = &readyTime
+			list = append(list, gs)
+		}
+
+		assert.Equal(t, agonesv1.GameServerSetStatus{Replicas: 4, ReadyReplicas: 4, WarmingReplicas: 2}, computeStatus(gsSet, list))
+	})
+
 	t.Run("player tracking", func(t *testing.T) {
 		utilruntime.FeatureTestMutex.Lock()
 		defer utilruntime.FeatureTestMutex.Unlock()
@@ -888,6 +909,185 @@ func TestSyncGameServerSet(t *testing.T) {
 		assert.False(t, updated, "A game servers should not have been updated")
 	})
 
+	t.Run("keeping unhealthy gameservers for post-mortem", func(t *testing.T) {
+		utilruntime.FeatureTestMutex.Lock()
+		defer utilruntime.FeatureTestMutex.Unlock()
+		require.NoError(t, utilruntime.ParseFeatures(fmt.Sprintf("%s=true", utilruntime.FeaturePostMortemRetention)))
+
+		gsSet := defaultFixture()
+		gsSet.Spec.Template.Spec.PostMortem = &agonesv1.PostMortem{MaxGameServers: 1, Seconds: 600}
+		list := createGameServers(gsSet, 5)
+
+		list[0].Status.State = agonesv1.GameServerStateUnhealthy
+		list[1].Status.State = agonesv1.GameServerStateUnhealthy
+		// kept for long enough already
+		list[2].Status.State = agonesv1.GameServerStateUnhealthy
+		list[2].KeepForPostMortem(time.Now().Add(-time.Hour))
+
+		var mu sync.Mutex
+		kept := map[string]string{}
+		deleted := map[string]bool{}
+		count := 0
+
+		c, m := newFakeController()
+		m.AgonesClient.AddReactor("list", "gameserversets", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerSetList{Items: []agonesv1.GameServerSet{*gsSet}}, nil
+		})
+		m.AgonesClient.AddReactor("list", "gameservers", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerList{Items: list}, nil
+		})
+		m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			ua := action.(k8stesting.UpdateAction)
+			gs := ua.GetObject().(*agonesv1.GameServer)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if gs.Status.State == agonesv1.GameServerStateShutdown {
+				deleted[gs.GetName()] = true
+			} else {
+				assert.Equal(t, agonesv1.True, gs.ObjectMeta.Labels[agonesv1.GameServerPostMortemLabel])
+				kept[gs.GetName()] = gs.ObjectMeta.Annotations[agonesv1.GameServerPostMortemUntilAnnotation]
+			}
+			return true, gs, nil
+		})
+		m.AgonesClient.AddReactor("create", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			ca := action.(k8stesting.CreateAction)
+			gs := ca.GetObject().(*agonesv1.GameServer)
+
+			mu.Lock()
+			defer mu.Unlock()
+			count++
+			return true, gs, nil
+		})
+
+		ctx, cancel := agtesting.StartInformers(m, c.gameServerSetSynced, c.gameServerSynced)
+		defer cancel()
+
+		require.NoError(t, c.syncGameServerSet(ctx, gsSet.ObjectMeta.Namespace+"/"+gsSet.ObjectMeta.Name))
+
+		assert.Equal(t, 8, count)
+		require.Len(t, kept, 1)
+		require.NotEmpty(t, kept["test-0"])
+		assert.Equal(t, map[string]bool{"test-1": true, "test-2": true}, deleted)
+		var events []string
+		for len(m.FakeRecorder.Events) > 0 {
+			events = append(events, <-m.FakeRecorder.Events)
+		}
+		assert.Contains(t, events, "Normal PostMortem Kept gameserver in state Unhealthy for post-mortem until "+kept["test-0"]+": test-0")
+	})
+
+	t.Run("backing off gameservers failing before ready", func(t *testing.T) {
+		utilruntime.FeatureTestMutex.Lock()
+		defer utilruntime.FeatureTestMutex.Unlock()
+		require.NoError(t, utilruntime.ParseFeatures(fmt.Sprintf("%s=true", utilruntime.FeatureCrashLoopBackoff)))
+
+		gsSet := defaultFixture()
+		list := createGameServers(gsSet, 5)
+		for i := 0; i < crashLoopThreshold; i++ {
+			list[i].ObjectMeta.UID = types.UID(list[i].ObjectMeta.Name)
+			list[i].Status.State = agonesv1.GameServerStateUnhealthy
+		}
+
+		var mu sync.Mutex
+		deleted := 0
+		created := 0
+		var condition *agonesv1.GameServerSetCondition
+
+		c, m := newFakeController()
+		m.AgonesClient.AddReactor("list", "gameserversets", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerSetList{Items: []agonesv1.GameServerSet{*gsSet}}, nil
+		})
+		m.AgonesClient.AddReactor("list", "gameservers", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerList{Items: list}, nil
+		})
+		m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+			assert.Equal(t, agonesv1.GameServerStateShutdown, gs.Status.State)
+
+			mu.Lock()
+			defer mu.Unlock()
+			deleted++
+			return true, gs, nil
+		})
+		m.AgonesClient.AddReactor("create", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			created++
+			return true, action.(k8stesting.CreateAction).GetObject(), nil
+		})
+		m.AgonesClient.AddReactor("update", "gameserversets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			gsSet := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServerSet)
+			condition = gsSet.Status.Condition(agonesv1.GameServerSetCrashLoopBackOffCondition)
+			return true, gsSet, nil
+		})
+
+		ctx, cancel := agtesting.StartInformers(m, c.gameServerSetSynced, c.gameServerSynced)
+		defer cancel()
+
+		require.NoError(t, c.syncGameServerSet(ctx, gsSet.ObjectMeta.Namespace+"/"+gsSet.ObjectMeta.Name))
+
+		assert.Equal(t, 0, created)
+		assert.Equal(t, crashLoopThreshold, deleted)
+		require.NotNil(t, condition)
+		assert.Equal(t, corev1.ConditionTrue, condition.Status)
+		assert.Equal(t, "3 GameServers failed before Ready in a row", condition.Message)
+		agtesting.AssertEventContains(t, m.FakeRecorder.Events, "Warning CrashLoopBackOff GameServers keep failing before Ready, backing off creating replacements for 10s")
+	})
+
+	t.Run("not counting gameservers that failed to be deleted again", func(t *testing.T) {
+		utilruntime.FeatureTestMutex.Lock()
+		defer utilruntime.FeatureTestMutex.Unlock()
+		require.NoError(t, utilruntime.ParseFeatures(fmt.Sprintf("%s=true", utilruntime.FeatureCrashLoopBackoff)))
+
+		gsSet := defaultFixture()
+		list := createGameServers(gsSet, 5)
+		for i := 0; i < crashLoopThreshold; i++ {
+			list[i].ObjectMeta.UID = types.UID(list[i].ObjectMeta.Name)
+			list[i].Status.State = agonesv1.GameServerStateUnhealthy
+		}
+
+		var mu sync.Mutex
+		failDeletes := true
+		deleted := 0
+		var condition *agonesv1.GameServerSetCondition
+
+		c, m := newFakeController()
+		m.AgonesClient.AddReactor("list", "gameserversets", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerSetList{Items: []agonesv1.GameServerSet{*gsSet}}, nil
+		})
+		m.AgonesClient.AddReactor("list", "gameservers", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerList{Items: list}, nil
+		})
+		m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if failDeletes {
+				return true, nil, errors.New("update failed")
+			}
+			deleted++
+			return true, action.(k8stesting.UpdateAction).GetObject(), nil
+		})
+		m.AgonesClient.AddReactor("update", "gameserversets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			gsSet := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServerSet)
+			condition = gsSet.Status.Condition(agonesv1.GameServerSetCrashLoopBackOffCondition)
+			return true, gsSet, nil
+		})
+
+		ctx, cancel := agtesting.StartInformers(m, c.gameServerSetSynced, c.gameServerSynced)
+		defer cancel()
+
+		require.Error(t, c.syncGameServerSet(ctx, gsSet.ObjectMeta.Namespace+"/"+gsSet.ObjectMeta.Name))
+		assert.Equal(t, 0, deleted)
+
+		mu.Lock()
+		failDeletes = false
+		mu.Unlock()
+		require.NoError(t, c.syncGameServerSet(ctx, gsSet.ObjectMeta.Namespace+"/"+gsSet.ObjectMeta.Name))
+		assert.Equal(t, crashLoopThreshold, deleted)
+		require.NotNil(t, condition)
+		assert.Equal(t, "3 GameServers failed before Ready in a row", condition.Message)
+	})
+
 	t.Run("removing gamservers", func(t *testing.T) {
 		gsSet := defaultFixture()
 		list := createGameServers(gsSet, 15)
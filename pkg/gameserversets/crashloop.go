@@ -0,0 +1,236 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserversets
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	lru "github.com/hashicorp/golang-lru"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// crashLoopThreshold is the number of GameServers of a GameServerSet that can fail before Ready in a row,
+	// before the creation of their replacements is backed off.
+	crashLoopThreshold = 3
+	// crashLoopInitialBackoff is how long the creation of replacements is first backed off for.
+	// It doubles with each further failure, up to crashLoopMaxBackoff.
+	crashLoopInitialBackoff = 10 * time.Second
+	// crashLoopMaxBackoff is the maximum time the creation of replacements is backed off for.
+	crashLoopMaxBackoff = 5 * time.Minute
+	// crashLoopResetPeriod is how long after the last failure the failures are forgotten, if no GameServer
+	// has reached Ready in the meantime.
+	crashLoopResetPeriod = 10 * time.Minute
+	// crashLoopMessage is the message of the CrashLoopBackOff condition, with the number of failures in a row.
+	crashLoopMessage = "%d GameServers failed before Ready in a row"
+	// crashLoopMaxSeen is the maximum number of failed GameServers that are remembered per GameServerSet,
+	// so that they are only counted once.
+	crashLoopMaxSeen = 1024
+)
+
+// crashLoopEntry tracks the GameServers of a single GameServerSet that failed before Ready.
+type crashLoopEntry struct {
+	failures     int
+	lastFailure  time.Time
+	backoffUntil time.Time
+	restored     bool
+	// seen are the UIDs of the failed GameServers that have been counted
+	seen *lru.Cache
+	// replicas is the number of replicas that GameServers can be created for while backed off
+	replicas int
+	mu       sync.Mutex
+}
+
+// restore rebuilds the failures of an entry that has just been created, such as after a controller restart or a
+// leader change, from the CrashLoopBackOff condition of the GameServerSet and its GameServers that already failed
+// before Ready, so that the backoff is not reset while they keep failing. The backoff starts again from now.
+// Does nothing once the entry has been restored.
+func (e *crashLoopEntry) restore(gsSet *agonesv1.GameServerSet, failed []*agonesv1.GameServer, now time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.restored {
+		return
+	}
+	e.restored = true
+	e.replicas = int(gsSet.Spec.Replicas)
+
+	failures := e.markSeen(failed)
+	if c := gsSet.Status.Condition(agonesv1.GameServerSetCrashLoopBackOffCondition); c != nil && c.Status == corev1.ConditionTrue {
+		var n int
+		if _, err := fmt.Sscanf(c.Message, crashLoopMessage, &n); err != nil || n < crashLoopThreshold {
+			n = crashLoopThreshold
+		}
+		if n > failures {
+			failures = n
+		}
+	}
+	if failures == 0 {
+		return
+	}
+
+	e.failures = failures
+	e.lastFailure = now
+	if failures >= crashLoopThreshold {
+		e.backoffUntil = now.Add(crashLoopBackoff(failures))
+	}
+}
+
+// newFailures returns the number of the failed GameServers that have not been counted before, and remembers them,
+// so that a GameServer that is not deleted, e.g. because its deletion failed, is not counted again on the next sync.
+func (e *crashLoopEntry) newFailures(failed []*agonesv1.GameServer) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.markSeen(failed)
+}
+
+// markSeen remembers the failed GameServers, and returns how many of them had not been seen before.
+// Must be called with the lock held.
+func (e *crashLoopEntry) markSeen(failed []*agonesv1.GameServer) int {
+	if e.seen == nil {
+		seen, err := lru.New(crashLoopMaxSeen)
+		if err != nil {
+			// only returned for a non positive size
+			panic(err)
+		}
+		e.seen = seen
+	}
+
+	count := 0
+	for _, gs := range failed {
+		if found, _ := e.seen.ContainsOrAdd(gs.ObjectMeta.UID, true); !found {
+			count++
+		}
+	}
+	return count
+}
+
+// observe records the number of GameServers that failed before they ever reached Ready, and forgets the previous
+// failures once a GameServer in the list has reached Ready since, or after crashLoopResetPeriod.
+// Returns how long the creation of replacements is still backed off for, and whether a new backoff has started.
+func (e *crashLoopEntry) observe(list []*agonesv1.GameServer, failed int, now time.Time) (time.Duration, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.failures > 0 && (now.Sub(e.lastFailure) > crashLoopResetPeriod || readySince(list, e.lastFailure)) {
+		e.failures = 0
+		e.backoffUntil = time.Time{}
+	}
+
+	started := false
+	if failed > 0 {
+		e.failures += failed
+		e.lastFailure = now
+		if e.failures >= crashLoopThreshold {
+			e.backoffUntil = now.Add(crashLoopBackoff(e.failures))
+			started = true
+		}
+	}
+
+	if now.Before(e.backoffUntil) {
+		return e.backoffUntil.Sub(now), started
+	}
+	return 0, started
+}
+
+// creations returns how many of the toAdd GameServers that a GameServerSet with the given replicas needs can be
+// created. While backed off, only the GameServerSet being scaled up is not delayed: GameServers are only created for
+// the replicas it has gained since the backoff started, and not to replace the ones that failed.
+func (e *crashLoopEntry) creations(replicas, toAdd int, backedOff bool) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !backedOff || replicas < e.replicas {
+		e.replicas = replicas
+	}
+	if !backedOff {
+		return toAdd
+	}
+	allowed := min(toAdd, replicas-e.replicas)
+	e.replicas += allowed
+	return allowed
+}
+
+// crashLooping returns true if enough GameServers failed before Ready in a row for the GameServerSet to be crash looping,
+// and the number of failures.
+func (e *crashLoopEntry) crashLooping() (bool, int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.failures >= crashLoopThreshold, e.failures
+}
+
+// crashLoopBackoff returns how long the creation of replacements is backed off for after the given number of failures.
+func crashLoopBackoff(failures int) time.Duration {
+	backoff := crashLoopInitialBackoff
+	for i := crashLoopThreshold; i < failures && backoff < crashLoopMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > crashLoopMaxBackoff {
+		return crashLoopMaxBackoff
+	}
+	return backoff
+}
+
+// failedBeforeReady returns the Unhealthy or Error GameServers that never reached Ready.
+func failedBeforeReady(list []*agonesv1.GameServer) []*agonesv1.GameServer {
+	var failed []*agonesv1.GameServer
+	for _, gs := range list {
+		isFailed := gs.Status.State == agonesv1.GameServerStateUnhealthy || gs.Status.State == agonesv1.GameServerStateError
+		if isFailed && gs.Status.ReadyTime == nil {
+			failed = append(failed, gs)
+		}
+	}
+	return failed
+}
+
+// failedBeforeSync returns the GameServers that failed before Ready that a sync does not delete, and so does not
+// observe, as they were already observed before: the ones already being deleted, and the ones kept for post-mortem.
+func failedBeforeSync(list, kept []*agonesv1.GameServer) []*agonesv1.GameServer {
+	failed := failedBeforeReady(kept)
+	for _, gs := range failedBeforeReady(list) {
+		if gs.IsBeingDeleted() {
+			failed = append(failed, gs)
+		}
+	}
+	return failed
+}
+
+// readySince returns true if a GameServer in the list has reached Ready after the given time.
+func readySince(list []*agonesv1.GameServer, t time.Time) bool {
+	for _, gs := range list {
+		if gs.Status.ReadyTime != nil && gs.Status.ReadyTime.Time.After(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// crashLoopCache tracks per-GSS the GameServers that failed before Ready, to back off the creation of
+// their replacements while the GameServerSet is crash looping.
+type crashLoopCache struct {
+	cache sync.Map
+}
+
+func (c *crashLoopCache) forGameServerSet(gsSet *agonesv1.GameServerSet) *crashLoopEntry {
+	v, _ := c.cache.LoadOrStore(gsSet.ObjectMeta.Namespace+"/"+gsSet.ObjectMeta.Name, &crashLoopEntry{})
+	return v.(*crashLoopEntry)
+}
+
+func (c *crashLoopCache) deleteGameServerSet(gsSet *agonesv1.GameServerSet) {
+	c.cache.Delete(gsSet.ObjectMeta.Namespace + "/" + gsSet.ObjectMeta.Name)
+}
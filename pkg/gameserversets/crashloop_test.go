@@ -0,0 +1,227 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserversets
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestCrashLoopBackoff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 10*time.Second, crashLoopBackoff(3))
+	assert.Equal(t, 20*time.Second, crashLoopBackoff(4))
+	assert.Equal(t, 160*time.Second, crashLoopBackoff(7))
+	assert.Equal(t, 5*time.Minute, crashLoopBackoff(8))
+	assert.Equal(t, 5*time.Minute, crashLoopBackoff(1000))
+}
+
+func TestFailedBeforeReady(t *testing.T) {
+	t.Parallel()
+
+	readyTime := metav1.Now()
+	list := []*agonesv1.GameServer{
+		{Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateUnhealthy}},
+		{Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateError}},
+		{Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateUnhealthy, ReadyTime: &readyTime}},
+		{Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady}},
+	}
+	assert.Equal(t, list[:2], failedBeforeReady(list))
+	assert.Empty(t, failedBeforeReady(nil))
+}
+
+func TestCrashLoopEntryNewFailures(t *testing.T) {
+	t.Parallel()
+
+	failed := func(uids ...types.UID) []*agonesv1.GameServer {
+		var list []*agonesv1.GameServer
+		for _, uid := range uids {
+			list = append(list, &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{UID: uid}})
+		}
+		return list
+	}
+
+	var e crashLoopEntry
+	assert.Equal(t, 2, e.newFailures(failed("a", "b")))
+	// a GameServer that failed to be deleted is only counted once
+	assert.Equal(t, 1, e.newFailures(failed("a", "b", "c")))
+	assert.Equal(t, 0, e.newFailures(failed("c")))
+	assert.Equal(t, 0, e.newFailures(nil))
+
+	// the GameServers that are restored are counted
+	e = crashLoopEntry{}
+	e.restore(&agonesv1.GameServerSet{}, failed("a"), time.Now())
+	assert.Equal(t, 1, e.newFailures(failed("a", "b")))
+	_, failures := e.crashLooping()
+	assert.Equal(t, 1, failures)
+}
+
+func TestCrashLoopEntryCreations(t *testing.T) {
+	t.Parallel()
+
+	var e crashLoopEntry
+	// not backed off, all the GameServers are created
+	assert.Equal(t, 5, e.creations(10, 5, false))
+
+	// backed off, failed GameServers are not replaced
+	assert.Equal(t, 0, e.creations(10, 3, true))
+
+	// but the GameServerSet can still be scaled up, once
+	assert.Equal(t, 2, e.creations(12, 5, true))
+	assert.Equal(t, 0, e.creations(12, 3, true))
+
+	// as many as can be created at a time, with the rest on the next sync
+	assert.Equal(t, 1, e.creations(15, 1, true))
+	assert.Equal(t, 2, e.creations(15, 5, true))
+
+	// after scaling down, only scaling back up is not delayed
+	assert.Equal(t, 0, e.creations(8, 0, true))
+	assert.Equal(t, 1, e.creations(9, 4, true))
+
+	// once the backoff is over, the failed GameServers are replaced
+	assert.Equal(t, 3, e.creations(9, 3, false))
+}
+
+func TestCrashLoopEntry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	var e crashLoopEntry
+
+	// below the threshold, no backoff
+	backoff, started := e.observe(nil, 2, now)
+	assert.Equal(t, time.Duration(0), backoff)
+	assert.False(t, started)
+	looping, failures := e.crashLooping()
+	assert.False(t, looping)
+	assert.Equal(t, 2, failures)
+
+	// reaching the threshold starts a backoff
+	backoff, started = e.observe(nil, 1, now)
+	assert.Equal(t, crashLoopInitialBackoff, backoff)
+	assert.True(t, started)
+	looping, failures = e.crashLooping()
+	assert.True(t, looping)
+	assert.Equal(t, 3, failures)
+
+	// still backed off, without any new failure
+	backoff, started = e.observe(nil, 0, now.Add(4*time.Second))
+	assert.Equal(t, 6*time.Second, backoff)
+	assert.False(t, started)
+
+	// each new failure doubles the backoff
+	now = now.Add(crashLoopInitialBackoff)
+	backoff, started = e.observe(nil, 1, now)
+	assert.Equal(t, 2*crashLoopInitialBackoff, backoff)
+	assert.True(t, started)
+
+	// a GameServer that was Ready before the last failure doesn't reset the failures
+	before := metav1.NewTime(now.Add(-time.Second))
+	backoff, _ = e.observe([]*agonesv1.GameServer{{Status: agonesv1.GameServerStatus{ReadyTime: &before}}}, 0, now)
+	assert.Equal(t, 2*crashLoopInitialBackoff, backoff)
+
+	// a GameServer reaching Ready resets the failures
+	after := metav1.NewTime(now.Add(time.Second))
+	backoff, started = e.observe([]*agonesv1.GameServer{{Status: agonesv1.GameServerStatus{ReadyTime: &after}}}, 0, now.Add(2*time.Second))
+	assert.Equal(t, time.Duration(0), backoff)
+	assert.False(t, started)
+	looping, failures = e.crashLooping()
+	assert.False(t, looping)
+	assert.Equal(t, 0, failures)
+
+	// failures are forgotten after a while without any
+	e.observe(nil, 2, now)
+	backoff, started = e.observe(nil, 1, now.Add(crashLoopResetPeriod+time.Second))
+	assert.Equal(t, time.Duration(0), backoff)
+	assert.False(t, started)
+	_, failures = e.crashLooping()
+	assert.Equal(t, 1, failures)
+}
+
+func TestCrashLoopEntryRestore(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	looping := func(message string) *agonesv1.GameServerSet {
+		gsSet := &agonesv1.GameServerSet{}
+		gsSet.Status.SetCondition(agonesv1.GameServerSetCrashLoopBackOffCondition, corev1.ConditionTrue, message, metav1.NewTime(now.Add(-time.Hour)))
+		return gsSet
+	}
+
+	// the failures and the backoff are restored from the condition
+	var e crashLoopEntry
+	e.restore(looping(fmt.Sprintf(crashLoopMessage, 5)), nil, now)
+	isLooping, failures := e.crashLooping()
+	assert.True(t, isLooping)
+	assert.Equal(t, 5, failures)
+	backoff, started := e.observe(nil, 0, now)
+	assert.Equal(t, crashLoopBackoff(5), backoff)
+	assert.False(t, started)
+
+	// only once
+	e.restore(looping(fmt.Sprintf(crashLoopMessage, 7)), nil, now)
+	_, failures = e.crashLooping()
+	assert.Equal(t, 5, failures)
+
+	// a condition with an unexpected message is still crash looping
+	e = crashLoopEntry{}
+	e.restore(looping("unexpected"), nil, now)
+	_, failures = e.crashLooping()
+	assert.Equal(t, crashLoopThreshold, failures)
+
+	// failed GameServers are restored below the threshold, and count towards it
+	e = crashLoopEntry{}
+	e.restore(&agonesv1.GameServerSet{}, []*agonesv1.GameServer{{ObjectMeta: metav1.ObjectMeta{UID: "a"}}, {ObjectMeta: metav1.ObjectMeta{UID: "b"}}}, now)
+	isLooping, failures = e.crashLooping()
+	assert.False(t, isLooping)
+	assert.Equal(t, 2, failures)
+	backoff, started = e.observe(nil, 1, now)
+	assert.Equal(t, crashLoopInitialBackoff, backoff)
+	assert.True(t, started)
+
+	// a condition that is no longer True is not restored
+	gsSet := looping(fmt.Sprintf(crashLoopMessage, 5))
+	gsSet.Status.SetCondition(agonesv1.GameServerSetCrashLoopBackOffCondition, corev1.ConditionFalse, "", metav1.NewTime(now))
+	e = crashLoopEntry{}
+	e.restore(gsSet, nil, now)
+	isLooping, failures = e.crashLooping()
+	assert.False(t, isLooping)
+	assert.Equal(t, 0, failures)
+}
+
+func TestFailedBeforeSync(t *testing.T) {
+	t.Parallel()
+
+	deleted := metav1.Now()
+	list := []*agonesv1.GameServer{
+		{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &deleted}, Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateUnhealthy}},
+		{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &deleted}, Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady}},
+		// not being deleted yet, so observed by the sync
+		{Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateError}},
+	}
+	kept := []*agonesv1.GameServer{
+		{Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateError}},
+	}
+	assert.Equal(t, []*agonesv1.GameServer{kept[0], list[0]}, failedBeforeSync(list, kept))
+	assert.Empty(t, failedBeforeSync(nil, nil))
+}
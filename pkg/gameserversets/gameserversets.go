@@ -16,6 +16,7 @@ package gameserversets
 
 import (
 	"sort"
+	"time"
 
 	"agones.dev/agones/pkg/apis"
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
@@ -140,3 +141,53 @@ func ListGameServersByGameServerSetOwner(gameServerLister listerv1.GameServerLis
 
 	return result, nil
 }
+
+// nextWarmUp returns how long until the next GameServer in the list that is warming up has been Ready for
+// its MinReadySeconds, or 0 if none of them are warming up.
+func nextWarmUp(list []*agonesv1.GameServer, now time.Time) time.Duration {
+	var next time.Duration
+	for _, gs := range list {
+		if d := gs.WarmUpRemaining(now); d > 0 && (next == 0 || d < next) {
+			next = d
+		}
+	}
+	return next
+}
+
+// splitPostMortemGameServers splits the list of GameServers into the ones that are kept for post-mortem inspection,
+// and the others. Returns how long until the next kept GameServer has been kept for long enough, or 0 if there is
+// none, and the kept GameServers that have been kept for long enough, which are then ready to be deleted.
+func splitPostMortemGameServers(list []*agonesv1.GameServer, now time.Time) (others, kept, expired []*agonesv1.GameServer, next time.Duration) {
+	for _, gs := range list {
+		if !gs.IsPostMortem() || gs.IsBeingDeleted() {
+			others = append(others, gs)
+			continue
+		}
+		until, ok := gs.PostMortemUntil()
+		if d := until.Sub(now); ok && d > 0 {
+			kept = append(kept, gs)
+			if next == 0 || d < next {
+				next = d
+			}
+			continue
+		}
+		expired = append(expired, gs)
+	}
+	return others, kept, expired, next
+}
+
+// computePostMortemAction splits the GameServers to delete into the failed ones that should be kept for post-mortem
+// inspection instead, up to postMortem.MaxGameServers including the keptCount GameServers already kept, and the
+// ones that should still be deleted.
+func computePostMortemAction(postMortem *agonesv1.PostMortem, toDelete []*agonesv1.GameServer, keptCount int) (stillToDelete, toKeep []*agonesv1.GameServer) {
+	for _, gs := range toDelete {
+		failed := gs.Status.State == agonesv1.GameServerStateUnhealthy || gs.Status.State == agonesv1.GameServerStateError
+		if failed && keptCount < int(postMortem.MaxGameServers) {
+			toKeep = append(toKeep, gs)
+			keptCount++
+			continue
+		}
+		stillToDelete = append(stillToDelete, gs)
+	}
+	return stillToDelete, toKeep
+}
@@ -15,6 +15,7 @@
 package gameserversets
 
 import (
+	"fmt"
 	"sort"
 	"testing"
 	"time"
@@ -139,7 +140,7 @@ func TestSortGameServersByDistributedStrategy(t *testing.T) {
 	utilruntime.FeatureTestMutex.Lock()
 	defer utilruntime.FeatureTestMutex.Unlock()
 
-	require.NoError(t, utilruntime.ParseFeatures(string(utilruntime.FeatureCountsAndLists)+"=true"))
+	require.NoError(t, utilruntime.ParseFeatures(fmt.Sprintf("%s=true&%s=true", utilruntime.FeatureCountsAndLists, utilruntime.FeatureLifecyclePriorities)))
 
 	now := metav1.Now()
 
@@ -167,6 +168,11 @@ func TestSortGameServersByDistributedStrategy(t *testing.T) {
 					Count:    0,
 					Capacity: 1000,
 				}}}}
+	readyEarlier := metav1.NewTime(now.Add(-time.Hour))
+	gs7 := agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "g7", CreationTimestamp: now},
+		Status: agonesv1.GameServerStatus{ReadyTime: &readyEarlier}}
+	gs8 := agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "g8", CreationTimestamp: metav1.Time{Time: now.Add(-time.Hour)}},
+		Status: agonesv1.GameServerStatus{ReadyTime: &now}}
 
 	testScenarios := map[string]struct {
 		list       []*agonesv1.GameServer
@@ -196,6 +202,14 @@ func TestSortGameServersByDistributedStrategy(t *testing.T) {
 			}},
 			want: []*agonesv1.GameServer{&gs5, &gs4, &gs6},
 		},
+		"Ready time priorities": {
+			list: []*agonesv1.GameServer{&gs7, &gs1, &gs8},
+			priorities: []agonesv1.Priority{{
+				Type:  "ReadyTime",
+				Order: "Descending",
+			}},
+			want: []*agonesv1.GameServer{&gs8, &gs7, &gs1},
+		},
 	}
 
 	for testName, testScenario := range testScenarios {
@@ -246,3 +260,95 @@ func TestListGameServersByGameServerSetOwner(t *testing.T) {
 	})
 	assert.Equal(t, []*agonesv1.GameServer{gs1, gs2}, list)
 }
+
+func TestNextWarmUp(t *testing.T) {
+	t.Parallel()
+	utilruntime.FeatureTestMutex.Lock()
+	defer utilruntime.FeatureTestMutex.Unlock()
+	require.NoError(t, utilruntime.ParseFeatures(fmt.Sprintf("%s=true", utilruntime.FeatureMinReadySeconds)))
+
+	now := time.Now()
+	readyTime := metav1.NewTime(now.Add(-5 * time.Second))
+	newGS := func(state agonesv1.GameServerState, minReadySeconds int32) *agonesv1.GameServer {
+		return &agonesv1.GameServer{
+			Spec:   agonesv1.GameServerSpec{MinReadySeconds: minReadySeconds},
+			Status: agonesv1.GameServerStatus{State: state, ReadyTime: &readyTime},
+		}
+	}
+
+	assert.Equal(t, time.Duration(0), nextWarmUp(nil, now))
+	assert.Equal(t, time.Duration(0), nextWarmUp([]*agonesv1.GameServer{newGS(agonesv1.GameServerStateReady, 1), newGS(agonesv1.GameServerStateAllocated, 60)}, now))
+	assert.Equal(t, 10*time.Second, nextWarmUp([]*agonesv1.GameServer{
+		newGS(agonesv1.GameServerStateReady, 60),
+		newGS(agonesv1.GameServerStateReady, 15),
+		newGS(agonesv1.GameServerStateReady, 1),
+	}, now))
+}
+
+func TestSplitPostMortemGameServers(t *testing.T) {
+	t.Parallel()
+	utilruntime.FeatureTestMutex.Lock()
+	defer utilruntime.FeatureTestMutex.Unlock()
+	require.NoError(t, utilruntime.ParseFeatures(fmt.Sprintf("%s=true", utilruntime.FeaturePostMortemRetention)))
+
+	// the post-mortem until annotation has a precision of a second
+	now := time.Now().Truncate(time.Second)
+	newGS := func(name string, keptAt *time.Time) *agonesv1.GameServer {
+		gs := &agonesv1.GameServer{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       agonesv1.GameServerSpec{PostMortem: &agonesv1.PostMortem{Seconds: 60}},
+			Status:     agonesv1.GameServerStatus{State: agonesv1.GameServerStateUnhealthy},
+		}
+		if keptAt != nil {
+			gs.KeepForPostMortem(*keptAt)
+		}
+		return gs
+	}
+	recent := now.Add(-30 * time.Second)
+	recentest := now.Add(-10 * time.Second)
+	old := now.Add(-time.Hour)
+
+	gs1 := newGS("gs1", nil)
+	gs2 := newGS("gs2", &recent)
+	gs3 := newGS("gs3", &recentest)
+	gs4 := newGS("gs4", &old)
+	gs5 := newGS("gs5", &recent)
+	gs5.ObjectMeta.Annotations[agonesv1.GameServerPostMortemUntilAnnotation] = "invalid"
+
+	others, kept, expired, next := splitPostMortemGameServers([]*agonesv1.GameServer{gs1, gs2, gs3, gs4, gs5}, now)
+	assert.Equal(t, []*agonesv1.GameServer{gs1}, others)
+	assert.Equal(t, []*agonesv1.GameServer{gs2, gs3}, kept)
+	assert.Equal(t, []*agonesv1.GameServer{gs4, gs5}, expired)
+	assert.Equal(t, 30*time.Second, next)
+
+	require.NoError(t, utilruntime.ParseFeatures(fmt.Sprintf("%s=false", utilruntime.FeaturePostMortemRetention)))
+	others, kept, expired, next = splitPostMortemGameServers([]*agonesv1.GameServer{gs1, gs2}, now)
+	assert.Equal(t, []*agonesv1.GameServer{gs1, gs2}, others)
+	assert.Empty(t, kept)
+	assert.Empty(t, expired)
+	assert.Equal(t, time.Duration(0), next)
+}
+
+func TestComputePostMortemAction(t *testing.T) {
+	t.Parallel()
+
+	newGS := func(state agonesv1.GameServerState) *agonesv1.GameServer {
+		return &agonesv1.GameServer{Status: agonesv1.GameServerStatus{State: state}}
+	}
+	unhealthy := newGS(agonesv1.GameServerStateUnhealthy)
+	errored := newGS(agonesv1.GameServerStateError)
+	ready := newGS(agonesv1.GameServerStateReady)
+	toDelete := []*agonesv1.GameServer{ready, unhealthy, errored}
+
+	stillToDelete, toKeep := computePostMortemAction(&agonesv1.PostMortem{MaxGameServers: 2}, toDelete, 0)
+	assert.Equal(t, []*agonesv1.GameServer{ready}, stillToDelete)
+	assert.Equal(t, []*agonesv1.GameServer{unhealthy, errored}, toKeep)
+
+	stillToDelete, toKeep = computePostMortemAction(&agonesv1.PostMortem{MaxGameServers: 2}, toDelete, 1)
+	assert.Equal(t, []*agonesv1.GameServer{ready, errored}, stillToDelete)
+	assert.Equal(t, []*agonesv1.GameServer{unhealthy}, toKeep)
+
+	stillToDelete, toKeep = computePostMortemAction(&agonesv1.PostMortem{MaxGameServers: 2}, toDelete, 2)
+	assert.Equal(t, toDelete, stillToDelete)
+	assert.Empty(t, toKeep)
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"time"
 
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	listerv1 "agones.dev/agones/pkg/client/listers/agones/v1"
 	mt "agones.dev/agones/pkg/metrics"
 	"agones.dev/agones/pkg/util/runtime"
@@ -36,7 +37,9 @@ var (
 	keyFleetName = mt.MustTagKey("fleet_name")
 	keyType      = mt.MustTagKey("type")
 
-	gameServerCreationDuration = stats.Float64("gameserver_creation/duration", "The duration of gameserver creation", "s")
+	gameServerCreationDuration   = stats.Float64("gameserver_creation/duration", "The duration of gameserver creation", "s")
+	gameServersFailedBeforeReady = stats.Int64("gameserversets/failed_before_ready", "The number of gameservers that failed before Ready", "1")
+	crashLoopBackoffs            = stats.Int64("gameserversets/crash_loop_backoffs", "The number of crash loop backoffs", "1")
 
 	stateViews = []*view.View{
 		{
@@ -46,6 +49,20 @@ var (
 			Aggregation: view.Distribution(0, 0.01, 0.025, 0.05, 0.075, 0.1, 0.25, 0.5, 0.75, 1, 2, 3),
 			TagKeys:     []tag.Key{keyName, keyType, keyFleetName, keyNamespace},
 		},
+		{
+			Name:        "gameserversets_failed_before_ready_total",
+			Measure:     gameServersFailedBeforeReady,
+			Description: "The total of gameservers of a gameserverset that became Unhealthy or Error before they were Ready",
+			Aggregation: view.Sum(),
+			TagKeys:     []tag.Key{keyName, keyFleetName, keyNamespace},
+		},
+		{
+			Name:        "gameserversets_crash_loop_backoffs_total",
+			Measure:     crashLoopBackoffs,
+			Description: "The total of times the creation of replacement gameservers of a gameserverset was backed off",
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{keyName, keyFleetName, keyNamespace},
+		},
 	}
 )
 
@@ -102,3 +119,23 @@ func (r *metrics) setError(errorType string) {
 func (r *metrics) setRequest(count int) {
 	r.mutate(tag.Update(keyName, fmt.Sprint(count)))
 }
+
+// recordCrashLoop records the gameservers of the gameserverset that failed before Ready, and whether the
+// creation of their replacements has been backed off.
+func recordCrashLoop(ctx context.Context, gsSet *agonesv1.GameServerSet, failed int, backedOff bool) {
+	fleetName := gsSet.ObjectMeta.Labels[agonesv1.FleetNameLabel]
+	if fleetName == "" {
+		fleetName = "none"
+	}
+	tags := []tag.Mutator{
+		tag.Upsert(keyName, gsSet.ObjectMeta.Name),
+		tag.Upsert(keyFleetName, fleetName),
+		tag.Upsert(keyNamespace, gsSet.ObjectMeta.Namespace),
+	}
+	if failed > 0 {
+		mt.RecordWithTags(ctx, tags, gameServersFailedBeforeReady.M(int64(failed)))
+	}
+	if backedOff {
+		mt.RecordWithTags(ctx, tags, crashLoopBackoffs.M(1))
+	}
+}
@@ -83,6 +83,7 @@ func TestGSSMetrics(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expected, count)
 	agtesting.AssertEventContains(t, m.FakeRecorder.Events, "SuccessfulCreate")
+	recordCrashLoop(ctx, gsSet, 1, true)
 
 	ctxHTTP, cancelHTTP := context.WithCancel(context.Background())
 	defer cancelHTTP()
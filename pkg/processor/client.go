@@ -17,6 +17,7 @@ package processor
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -27,6 +28,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/apimachinery/pkg/util/uuid"
 
 	allocationpb "agones.dev/agones/pkg/allocation/go"
@@ -48,6 +50,9 @@ type Config struct {
 
 	// ReconnectInterval is the time to wait before retrying a failed connection
 	ReconnectInterval time.Duration
+
+	// UnhealthyThreshold is how long the processor can be unreachable before the client reports itself unhealthy
+	UnhealthyThreshold time.Duration
 }
 
 // client implements client interface
@@ -68,6 +73,12 @@ type client struct {
 	batchMutex sync.RWMutex
 	// requestIDMapping is a map to correlate request IDs to pendingRequest objects for response handling
 	requestIDMapping map[string]*pendingRequest
+
+	healthMutex sync.RWMutex
+	// connected is true while the client has a registered stream with the processor
+	connected bool
+	// disconnectedAt is when the client last lost (or started without) its stream with the processor
+	disconnectedAt time.Time
 }
 
 // pendingRequest represents a request waiting for processing
@@ -83,6 +94,34 @@ type pendingRequest struct {
 
 	// id is the unique identifier for this request
 	id string
+
+	// deadline is when the caller stops waiting for a response
+	deadline time.Time
+
+	// queued is when the request was added to the hot batch
+	queued time.Time
+}
+
+// wrapper returns the request wrapped for sending in a batch
+func (r *pendingRequest) wrapper() *allocationpb.RequestWrapper {
+	return &allocationpb.RequestWrapper{
+		RequestId: r.id,
+		Request:   r.request,
+		Priority:  r.request.GetRequestPriority(),
+		Deadline:  timestamppb.New(r.deadline),
+	}
+}
+
+// laneRank orders priority lanes, lowest rank first
+func laneRank(priority allocationpb.AllocationRequest_RequestPriority) int {
+	switch priority {
+	case allocationpb.AllocationRequest_High:
+		return 0
+	case allocationpb.AllocationRequest_Low:
+		return 2
+	default:
+		return 1
+	}
 }
 
 // Client interface for allocation operations
@@ -93,6 +132,9 @@ type Client interface {
 
 	// Allocate performs a batch allocation request
 	Allocate(ctx context.Context, req *allocationpb.AllocationRequest) (*allocationpb.AllocationResponse, error)
+
+	// Healthy returns false once the processor has been unreachable for longer than the UnhealthyThreshold
+	Healthy() bool
 }
 
 // NewClient creates a new processor client
@@ -109,9 +151,28 @@ func NewClient(config Config, logger logrus.FieldLogger) Client {
 		},
 		pendingRequests:  make([]*pendingRequest, 0, config.MaxBatchSize),
 		requestIDMapping: make(map[string]*pendingRequest),
+		disconnectedAt:   time.Now(),
 	}
 }
 
+// Healthy returns true while the client is connected to the processor, or has been
+// disconnected for less than the UnhealthyThreshold
+func (p *client) Healthy() bool {
+	p.healthMutex.RLock()
+	defer p.healthMutex.RUnlock()
+	return p.connected || time.Since(p.disconnectedAt) < p.config.UnhealthyThreshold
+}
+
+// setConnected records whether the client has a registered stream with the processor
+func (p *client) setConnected(connected bool) {
+	p.healthMutex.Lock()
+	defer p.healthMutex.Unlock()
+	if p.connected && !connected {
+		p.disconnectedAt = time.Now()
+	}
+	p.connected = connected
+}
+
 // Run starts the processor client and manages the connection lifecycle
 // It will retry connecting to the processor service until the context is cancelled
 func (p *client) Run(ctx context.Context) error {
@@ -145,28 +206,30 @@ func (p *client) Run(ctx context.Context) error {
 func (p *client) Allocate(ctx context.Context, req *allocationpb.AllocationRequest) (*allocationpb.AllocationResponse, error) {
 	requestID := string(uuid.NewUUID())
 
+	// Wait for response, error, cancellation, or timeout
+	timeout := p.config.AllocationTimeout
+	now := time.Now()
+	deadline := now.Add(timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+
 	// Create a pendingRequest to track this allocation request and its response/error
 	pendingReq := &pendingRequest{
 		id:       requestID,
 		request:  req,
 		response: make(chan *allocationpb.AllocationResponse, 1),
 		error:    make(chan error, 1),
-	}
-
-	// Wrap the request for batching.
-	wrapper := &allocationpb.RequestWrapper{
-		RequestId: requestID,
-		Request:   req,
+		deadline: deadline,
+		queued:   now,
 	}
 
 	p.batchMutex.Lock()
 	p.requestIDMapping[requestID] = pendingReq
-	p.hotBatch.Requests = append(p.hotBatch.Requests, wrapper)
+	p.hotBatch.Requests = append(p.hotBatch.Requests, pendingReq.wrapper())
 	p.pendingRequests = append(p.pendingRequests, pendingReq)
 	p.batchMutex.Unlock()
-
-	// Wait for response, error, cancellation, or timeout
-	timeout := p.config.AllocationTimeout
+	recordQueued(req.GetRequestPriority())
 
 	select {
 	case response := <-pendingReq.response:
@@ -193,6 +256,25 @@ func (p *client) Allocate(ctx context.Context, req *allocationpb.AllocationReque
 	}
 }
 
+// failPending fails every request waiting on this client with err and empties the hot batch,
+// so that callers can retry them against another processor
+func (p *client) failPending(err error) {
+	p.batchMutex.Lock()
+	defer p.batchMutex.Unlock()
+
+	for requestID, req := range p.requestIDMapping {
+		select {
+		case req.error <- err:
+		default:
+		}
+		delete(p.requestIDMapping, requestID)
+	}
+	p.hotBatch = &allocationpb.BatchRequest{
+		Requests: make([]*allocationpb.RequestWrapper, 0, p.config.MaxBatchSize),
+	}
+	p.pendingRequests = make([]*pendingRequest, 0, p.config.MaxBatchSize)
+}
+
 // handleStream processes incoming messages from the processor stream
 // It listens for pull requests and batch responses, dispatching them to appropriate handlers
 func (p *client) handleStream(ctx context.Context, stream allocationpb.Processor_StreamBatchesClient) error {
@@ -259,10 +341,10 @@ func (p *client) pullRequestHandler(ctx context.Context, stream allocationpb.Pro
 
 // handlePullRequest responds to pull requests by sending the current batch of allocation requests
 // It swaps out the hot batch, resets it for new requests, and sends the ready batch to the processor
+// with the highest priority lanes first
 func (p *client) handlePullRequest(stream allocationpb.Processor_StreamBatchesClient) {
 	// Swap out the hot batch and pending requests
 	p.batchMutex.Lock()
-	readyBatch := p.hotBatch
 	readyRequests := p.pendingRequests
 
 	// Reset hot batch for next requests
@@ -272,15 +354,68 @@ func (p *client) handlePullRequest(stream allocationpb.Processor_StreamBatchesCl
 	p.pendingRequests = make([]*pendingRequest, 0, p.config.MaxBatchSize)
 	p.batchMutex.Unlock()
 
+	readyRequests = p.prioritise(readyRequests, time.Now())
 	if len(readyRequests) == 0 {
 		p.logger.Debug("No requests to send in batch")
 		return
 	}
 
+	readyBatch := &allocationpb.BatchRequest{
+		Requests: make([]*allocationpb.RequestWrapper, 0, len(readyRequests)),
+	}
+	for _, req := range readyRequests {
+		readyBatch.Requests = append(readyBatch.Requests, req.wrapper())
+		recordSent(req.request.GetRequestPriority(), time.Since(req.queued))
+	}
+
 	// Send batch to processor
 	p.sendBatch(stream, readyBatch, readyRequests)
 }
 
+// prioritise drops requests whose caller deadline has passed, or whose caller has stopped waiting,
+// and orders the rest by priority lane, keeping arrival order within a lane. At most MaxBatchSize
+// requests are returned; the rest are returned to the front of the hot batch for the next pull.
+func (p *client) prioritise(requests []*pendingRequest, now time.Time) []*pendingRequest {
+	p.batchMutex.Lock()
+	defer p.batchMutex.Unlock()
+
+	live := make([]*pendingRequest, 0, len(requests))
+	for _, req := range requests {
+		if _, waiting := p.requestIDMapping[req.id]; !waiting {
+			recordExpired(req.request.GetRequestPriority())
+			continue
+		}
+		if !now.Before(req.deadline) {
+			delete(p.requestIDMapping, req.id)
+			recordExpired(req.request.GetRequestPriority())
+			select {
+			case req.error <- status.Errorf(codes.DeadlineExceeded, "allocation request deadline exceeded before processing"):
+			default:
+			}
+			continue
+		}
+		live = append(live, req)
+	}
+
+	sort.SliceStable(live, func(i, j int) bool {
+		return laneRank(live[i].request.GetRequestPriority()) < laneRank(live[j].request.GetRequestPriority())
+	})
+
+	if p.config.MaxBatchSize <= 0 || len(live) <= p.config.MaxBatchSize {
+		return live
+	}
+
+	overflow := live[p.config.MaxBatchSize:]
+	requeued := make([]*allocationpb.RequestWrapper, 0, len(overflow)+len(p.hotBatch.Requests))
+	for _, req := range overflow {
+		requeued = append(requeued, req.wrapper())
+	}
+	p.hotBatch.Requests = append(requeued, p.hotBatch.Requests...)
+	p.pendingRequests = append(append(make([]*pendingRequest, 0, len(overflow)+len(p.pendingRequests)), overflow...), p.pendingRequests...)
+
+	return live[:p.config.MaxBatchSize]
+}
+
 // sendBatch sends a batch of allocation requests to the processor
 func (p *client) sendBatch(stream allocationpb.Processor_StreamBatchesClient, batch *allocationpb.BatchRequest, requests []*pendingRequest) {
 	batch.BatchId = string(uuid.NewUUID())
@@ -300,10 +435,7 @@ func (p *client) sendBatch(stream allocationpb.Processor_StreamBatchesClient, ba
 		// Re-add the request to the hot batch and pendingRequests for the next pull
 		p.batchMutex.Lock()
 		for _, req := range requests {
-			p.hotBatch.Requests = append(p.hotBatch.Requests, &allocationpb.RequestWrapper{
-				RequestId: req.id,
-				Request:   req.request,
-			})
+			p.hotBatch.Requests = append(p.hotBatch.Requests, req.wrapper())
 			p.pendingRequests = append(p.pendingRequests, req)
 		}
 		p.batchMutex.Unlock()
@@ -458,6 +590,8 @@ func (p *client) connectAndRun(ctx context.Context) error {
 	}
 
 	p.logger.Info("Connected to processor")
+	p.setConnected(true)
+	defer p.setConnected(false)
 
 	// Handle the stream until an error occurs or the context is cancelled
 	return p.handleStream(ctx, stream)
@@ -23,8 +23,11 @@ import (
 	allocationpb "agones.dev/agones/pkg/allocation/go"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
 )
 
 // Mock for Processor_StreamBatchesClient
@@ -228,3 +231,69 @@ func TestProcessorClient_Allocate(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessorClient_Prioritise(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	p := &client{
+		config:           Config{MaxBatchSize: 3},
+		logger:           logrus.New(),
+		hotBatch:         &allocationpb.BatchRequest{},
+		requestIDMapping: make(map[string]*pendingRequest),
+	}
+
+	newReq := func(id string, priority allocationpb.AllocationRequest_RequestPriority, deadline time.Time, waiting bool) *pendingRequest {
+		req := &pendingRequest{
+			id:       id,
+			request:  &allocationpb.AllocationRequest{RequestPriority: priority},
+			response: make(chan *allocationpb.AllocationResponse, 1),
+			error:    make(chan error, 1),
+			deadline: deadline,
+		}
+		if waiting {
+			p.requestIDMapping[id] = req
+		}
+		return req
+	}
+
+	later := now.Add(time.Minute)
+	expired := newReq("expired", allocationpb.AllocationRequest_High, now.Add(-time.Second), true)
+	requests := []*pendingRequest{
+		newReq("low", allocationpb.AllocationRequest_Low, later, true),
+		newReq("normal-1", allocationpb.AllocationRequest_Normal, later, true),
+		expired,
+		newReq("gone", allocationpb.AllocationRequest_High, later, false),
+		newReq("high", allocationpb.AllocationRequest_High, later, true),
+		newReq("normal-2", allocationpb.AllocationRequest_Normal, later, true),
+	}
+	// a request that arrived after the pull
+	p.pendingRequests = []*pendingRequest{newReq("new", allocationpb.AllocationRequest_Normal, later, true)}
+	p.hotBatch.Requests = []*allocationpb.RequestWrapper{p.pendingRequests[0].wrapper()}
+
+	ready := p.prioritise(requests, now)
+
+	var ids []string
+	for _, r := range ready {
+		ids = append(ids, r.id)
+	}
+	assert.Equal(t, []string{"high", "normal-1", "normal-2"}, ids)
+
+	// the expired request is failed without being sent
+	select {
+	case err := <-expired.error:
+		assert.Equal(t, codes.DeadlineExceeded, grpcstatus.Code(err))
+	default:
+		assert.Fail(t, "expired request was not failed")
+	}
+	assert.NotContains(t, p.requestIDMapping, "expired")
+
+	// overflow goes back to the front of the hot batch, ahead of newer requests
+	require.Len(t, p.pendingRequests, 2)
+	assert.Equal(t, "low", p.pendingRequests[0].id)
+	assert.Equal(t, "new", p.pendingRequests[1].id)
+	require.Len(t, p.hotBatch.Requests, 2)
+	assert.Equal(t, "low", p.hotBatch.Requests[0].RequestId)
+	assert.Equal(t, allocationpb.AllocationRequest_Low, p.hotBatch.Requests[0].Priority)
+	assert.Equal(t, later.Unix(), p.hotBatch.Requests[0].Deadline.AsTime().Unix())
+}
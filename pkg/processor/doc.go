@@ -23,6 +23,17 @@
 // - Config: Configuration for processor client behavior
 // - Batch handling: Accumulates requests and sends them in batches to the processor
 //
+// Requests are sent in priority lanes: High before Normal before Low, in arrival order
+// within a lane. Requests whose caller deadline has passed are dropped before they are sent.
+//
+// Clients report whether the processor is reachable, so that with Fallback the allocator and
+// extensions allocate locally while the processor is unhealthy, and switch back once it recovers.
+//
+// With the ProcessorSharding feature, several processors are active at once. Each one
+// announces itself with a membership Lease, and the sharded client routes every request
+// to the processor owning its partition (namespace and Fleet), rebalancing as processors
+// join or leave
+//
 // The client establishes a bidirectional gRPC stream with the processor service,
 // registers itself, and then handles pull requests (to send batched allocations)
 // and batch responses (containing allocation results)
@@ -0,0 +1,83 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	allocationpb "agones.dev/agones/pkg/allocation/go"
+)
+
+// requestRouter is implemented by clients that route each request to one of several processors,
+// so that only the requests routed to an unhealthy processor fall back
+type requestRouter interface {
+	HealthyFor(req *allocationpb.AllocationRequest) bool
+}
+
+// Fallback decides whether allocations go through the processor, or fall back to
+// being made locally while the processor is unhealthy
+type Fallback struct {
+	client Client
+	logger logrus.FieldLogger
+
+	mutex    sync.Mutex
+	degraded bool
+}
+
+// NewFallback returns a Fallback that follows the health of the processor client
+func NewFallback(client Client, logger logrus.FieldLogger) *Fallback {
+	recordDegraded(false)
+	return &Fallback{client: client, logger: logger}
+}
+
+// Degraded returns true when the allocation request should be made locally rather than through
+// the processor, because the processor it is routed to is unhealthy. It is called once per
+// allocation request, and logs and records every change between processor and local mode.
+// A nil Fallback is never degraded.
+func (f *Fallback) Degraded(req *allocationpb.AllocationRequest) bool {
+	if f == nil {
+		return false
+	}
+
+	f.updateMode(!f.client.Healthy())
+
+	degraded := !f.client.Healthy()
+	if r, ok := f.client.(requestRouter); ok {
+		degraded = !r.HealthyFor(req)
+	}
+	if degraded {
+		recordFallbackAllocation()
+	}
+	return degraded
+}
+
+// updateMode logs and records a change between processor and local mode
+func (f *Fallback) updateMode(degraded bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if degraded == f.degraded {
+		return
+	}
+	f.degraded = degraded
+	recordMode(degraded)
+	if degraded {
+		f.logger.Warn("Processor is unhealthy, falling back to local allocation")
+	} else {
+		f.logger.Info("Processor is healthy again, switching back to processor allocation")
+	}
+}
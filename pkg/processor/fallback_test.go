@@ -0,0 +1,105 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	allocationpb "agones.dev/agones/pkg/allocation/go"
+)
+
+func TestFallbackDegraded(t *testing.T) {
+	t.Parallel()
+
+	req := &allocationpb.AllocationRequest{Namespace: "default"}
+	var nilFallback *Fallback
+	assert.False(t, nilFallback.Degraded(req))
+
+	c := &fakeShardClient{}
+	f := NewFallback(c, logrus.New())
+	assert.False(t, f.Degraded(req))
+
+	c.unhealthy = true
+	assert.True(t, f.Degraded(req))
+	assert.True(t, f.Degraded(req))
+
+	c.unhealthy = false
+	assert.False(t, f.Degraded(req))
+}
+
+func TestFallbackDegradedSharded(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	a := &fakeShardClient{address: "a:9090"}
+	b := &fakeShardClient{address: "b:9090", unhealthy: true}
+	s := newTestShardedClient(map[string]*fakeShardClient{"a:9090": a, "b:9090": b})
+	members := []Member{{ID: "a", Address: "a:9090"}, {ID: "b", Address: "b:9090"}}
+	s.rebalance(ctx, members)
+	ring := NewRing(members)
+
+	// find a request for each shard
+	requests := map[string]*allocationpb.AllocationRequest{}
+	for i := 0; len(requests) < 2 && i < 1000; i++ {
+		req := &allocationpb.AllocationRequest{Namespace: fmt.Sprintf("ns%d", i)}
+		m, ok := ring.Owner(PartitionKey(req))
+		require.True(t, ok)
+		requests[m.ID] = req
+	}
+	require.Len(t, requests, 2)
+
+	f := NewFallback(s, logrus.New())
+	assert.False(t, f.Degraded(requests["a"]))
+	assert.True(t, f.Degraded(requests["b"]))
+	assert.False(t, f.degraded)
+
+	a.unhealthy = true
+	assert.True(t, f.Degraded(requests["a"]))
+	assert.True(t, f.degraded)
+}
+
+func TestProcessorClient_Healthy(t *testing.T) {
+	t.Parallel()
+
+	p := NewClient(Config{UnhealthyThreshold: time.Hour}, logrus.New()).(*client)
+	// not connected yet, but within the threshold since starting
+	assert.True(t, p.Healthy())
+
+	p.setConnected(true)
+	assert.True(t, p.Healthy())
+
+	p.config.UnhealthyThreshold = 0
+	assert.True(t, p.Healthy())
+
+	p.setConnected(false)
+	assert.False(t, p.Healthy())
+
+	p.config.UnhealthyThreshold = time.Hour
+	assert.True(t, p.Healthy())
+
+	p.healthMutex.Lock()
+	p.disconnectedAt = time.Now().Add(-2 * time.Hour)
+	p.healthMutex.Unlock()
+	assert.False(t, p.Healthy())
+}
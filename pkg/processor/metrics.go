@@ -0,0 +1,151 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	allocationpb "agones.dev/agones/pkg/allocation/go"
+	mt "agones.dev/agones/pkg/metrics"
+	"agones.dev/agones/pkg/util/runtime"
+)
+
+var (
+	metricsLogger = runtime.NewLoggerWithSource("processor-metrics")
+
+	keyLane = mt.MustTagKey("lane")
+	keyMode = mt.MustTagKey("mode")
+
+	processorRequestsTotal        = stats.Int64("processor_client/requests", "The allocation requests queued for the processor", "1")
+	processorExpiredRequestsTotal = stats.Int64("processor_client/expired_requests", "The allocation requests dropped because their deadline passed before processing", "1")
+	processorQueueLatency         = stats.Float64("processor_client/queue_latency", "The time allocation requests wait before being sent to the processor", "s")
+	processorDegraded             = stats.Int64("processor_client/degraded", "Whether allocations are falling back to local allocation", "1")
+	processorModeChangesTotal     = stats.Int64("processor_client/mode_changes", "The changes between processor and local allocation", "1")
+	processorFallbackTotal        = stats.Int64("processor_client/fallback_allocations", "The allocation requests made locally while the processor was unhealthy", "1")
+
+	processorViews = []*view.View{
+		{
+			Name:        "processor_client_requests_total",
+			Measure:     processorRequestsTotal,
+			Description: "The count of allocation requests queued for the processor, per priority lane.",
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{keyLane},
+		},
+		{
+			Name:        "processor_client_expired_requests_total",
+			Measure:     processorExpiredRequestsTotal,
+			Description: "The count of allocation requests dropped before processing because their deadline passed, per priority lane.",
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{keyLane},
+		},
+		{
+			Name:        "processor_client_queue_duration_seconds",
+			Measure:     processorQueueLatency,
+			Description: "The distribution of time allocation requests wait before being sent to the processor, per priority lane.",
+			Aggregation: view.Distribution(0, 0.01, 0.025, 0.05, 0.075, 0.1, 0.25, 0.5, 0.75, 1, 2, 3),
+			TagKeys:     []tag.Key{keyLane},
+		},
+		{
+			Name:        "processor_client_degraded",
+			Measure:     processorDegraded,
+			Description: "1 while allocations fall back to local allocation because the processor is unhealthy, otherwise 0.",
+			Aggregation: view.LastValue(),
+		},
+		{
+			Name:        "processor_client_mode_changes_total",
+			Measure:     processorModeChangesTotal,
+			Description: "The count of changes between processor and local allocation, by the mode changed to.",
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{keyMode},
+		},
+		{
+			Name:        "processor_client_fallback_allocations_total",
+			Measure:     processorFallbackTotal,
+			Description: "The count of allocation requests made locally while the processor was unhealthy.",
+			Aggregation: view.Count(),
+		},
+	}
+)
+
+func init() {
+	registerViews()
+}
+
+// register all our views to OpenCensus
+func registerViews() {
+	for _, v := range processorViews {
+		if err := view.Register(v); err != nil {
+			metricsLogger.WithError(err).Error("could not register view")
+		}
+	}
+}
+
+// laneName returns the metrics tag value for a priority lane
+func laneName(priority allocationpb.AllocationRequest_RequestPriority) string {
+	return strings.ToLower(priority.String())
+}
+
+func record(priority allocationpb.AllocationRequest_RequestPriority, m stats.Measurement) {
+	if err := stats.RecordWithTags(context.Background(), []tag.Mutator{tag.Upsert(keyLane, laneName(priority))}, m); err != nil {
+		metricsLogger.WithError(err).Warn("failed to record processor client metric")
+	}
+}
+
+// recordQueued records a request added to the hot batch
+func recordQueued(priority allocationpb.AllocationRequest_RequestPriority) {
+	record(priority, processorRequestsTotal.M(1))
+}
+
+// recordExpired records a request dropped because its deadline passed
+func recordExpired(priority allocationpb.AllocationRequest_RequestPriority) {
+	record(priority, processorExpiredRequestsTotal.M(1))
+}
+
+// recordSent records how long a request waited before being sent to the processor
+func recordSent(priority allocationpb.AllocationRequest_RequestPriority, wait time.Duration) {
+	record(priority, processorQueueLatency.M(wait.Seconds()))
+}
+
+// recordDegraded records whether allocations are falling back to local allocation
+func recordDegraded(degraded bool) {
+	value := int64(0)
+	if degraded {
+		value = 1
+	}
+	stats.Record(context.Background(), processorDegraded.M(value))
+}
+
+// recordMode records a change between processor and local allocation
+func recordMode(degraded bool) {
+	recordDegraded(degraded)
+	mode := "processor"
+	if degraded {
+		mode = "local"
+	}
+	if err := stats.RecordWithTags(context.Background(), []tag.Mutator{tag.Upsert(keyMode, mode)}, processorModeChangesTotal.M(1)); err != nil {
+		metricsLogger.WithError(err).Warn("failed to record processor client metric")
+	}
+}
+
+// recordFallbackAllocation records an allocation made locally while the processor was unhealthy
+func recordFallbackAllocation() {
+	stats.Record(context.Background(), processorFallbackTotal.M(1))
+}
@@ -0,0 +1,247 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"context"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	allocationpb "agones.dev/agones/pkg/allocation/go"
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+)
+
+const (
+	// ShardLabel is the label applied to the membership Lease of each active processor shard
+	ShardLabel = "agones.dev/processor-shard"
+	// ShardAddressAnnotation is the annotation on a membership Lease holding the gRPC address of the processor
+	ShardAddressAnnotation = "agones.dev/processor-address"
+
+	shardLeasePrefix = "agones-processor-shard-"
+)
+
+// Member is an active processor shard
+type Member struct {
+	// ID is the unique identity of the processor
+	ID string
+	// Address is the gRPC address allocation clients connect to
+	Address string
+}
+
+// Ring assigns allocation partitions to processor shards using rendezvous hashing,
+// so that when a processor joins or leaves only the partitions it owned (or will own) move.
+type Ring struct {
+	members []Member
+}
+
+// NewRing returns a Ring over the given members
+func NewRing(members []Member) *Ring {
+	m := make([]Member, len(members))
+	copy(m, members)
+	sort.Slice(m, func(i, j int) bool { return m[i].ID < m[j].ID })
+	return &Ring{members: m}
+}
+
+// Members returns the members of the Ring, sorted by ID
+func (r *Ring) Members() []Member {
+	return r.members
+}
+
+// Owner returns the member that owns the partition key, and false if the Ring is empty
+func (r *Ring) Owner(key string) (Member, bool) {
+	var (
+		owner Member
+		best  uint64
+		found bool
+	)
+	for _, m := range r.members {
+		score := rendezvousScore(m.ID, key)
+		if !found || score > best {
+			owner, best, found = m, score, true
+		}
+	}
+	return owner, found
+}
+
+// rendezvousScore is the highest random weight of a member for a key
+func rendezvousScore(id, key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(id))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(key))
+	// fnv has poor avalanche on short suffixes, so finish with a splitmix64 round
+	x := h.Sum64()
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// PartitionKey returns the partition an allocation request belongs to.
+// Requests are partitioned by namespace and the Fleet of their first selector that targets one, so that
+// all allocations from a Fleet are processed by a single shard. Requests without a Fleet selector are
+// partitioned by the labels of their first selector.
+func PartitionKey(req *allocationpb.AllocationRequest) string {
+	selectors := req.GetGameServerSelectors()
+	if len(selectors) == 0 && req.GetRequiredGameServerSelector() != nil {
+		selectors = []*allocationpb.GameServerSelector{req.GetRequiredGameServerSelector()}
+	}
+	for _, s := range selectors {
+		if fleet, ok := s.GetMatchLabels()[agonesv1.FleetNameLabel]; ok {
+			return req.GetNamespace() + "/" + fleet
+		}
+	}
+	if len(selectors) == 0 {
+		return req.GetNamespace() + "/"
+	}
+	return req.GetNamespace() + "/" + labels.Set(selectors[0].GetMatchLabels()).String()
+}
+
+// ListMembers returns the processor shards in the namespace whose membership Lease has not expired
+func ListMembers(ctx context.Context, kubeClient kubernetes.Interface, namespace string, now time.Time) ([]Member, error) {
+	list, err := kubeClient.CoordinationV1().Leases(namespace).List(ctx, metav1.ListOptions{LabelSelector: ShardLabel})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list processor shard leases")
+	}
+
+	var members []Member
+	for i := range list.Items {
+		lease := &list.Items[i]
+		if lease.Spec.HolderIdentity == nil || lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+			continue
+		}
+		expiry := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+		if !now.Before(expiry) {
+			continue
+		}
+		address := lease.ObjectMeta.Annotations[ShardAddressAnnotation]
+		if address == "" {
+			continue
+		}
+		members = append(members, Member{ID: *lease.Spec.HolderIdentity, Address: address})
+	}
+	return members, nil
+}
+
+// Membership maintains the membership Lease of a processor shard
+//
+//nolint:govet // fieldalignment: struct alignment is not critical for our use case
+type Membership struct {
+	kubeClient    kubernetes.Interface
+	namespace     string
+	member        Member
+	leaseDuration time.Duration
+	renewPeriod   time.Duration
+	logger        logrus.FieldLogger
+	now           func() time.Time
+}
+
+// NewMembership returns a Membership that announces member as an active shard in namespace
+func NewMembership(kubeClient kubernetes.Interface, namespace string, member Member, leaseDuration, renewPeriod time.Duration, logger logrus.FieldLogger) *Membership {
+	return &Membership{
+		kubeClient:    kubeClient,
+		namespace:     namespace,
+		member:        member,
+		leaseDuration: leaseDuration,
+		renewPeriod:   renewPeriod,
+		logger:        logger,
+		now:           time.Now,
+	}
+}
+
+// leaseName returns the name of the membership Lease, which must be a valid Kubernetes name
+func (m *Membership) leaseName() string {
+	return shardLeasePrefix + strings.ToLower(m.member.ID)
+}
+
+// Run renews the membership Lease until the context is cancelled, then deletes it so that
+// clients rebalance away from this shard straight away
+func (m *Membership) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.renewPeriod)
+	defer ticker.Stop()
+
+	for {
+		if err := m.renew(ctx); err != nil {
+			m.logger.WithError(err).Warn("Could not renew processor shard lease")
+		}
+
+		select {
+		case <-ctx.Done():
+			// use a fresh context, as ctx is already cancelled
+			err := m.kubeClient.CoordinationV1().Leases(m.namespace).Delete(context.Background(), m.leaseName(), metav1.DeleteOptions{})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				m.logger.WithError(err).Warn("Could not delete processor shard lease")
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// renew creates or updates the membership Lease
+func (m *Membership) renew(ctx context.Context) error {
+	leases := m.kubeClient.CoordinationV1().Leases(m.namespace)
+	now := metav1.NewMicroTime(m.now())
+	seconds := int32(m.leaseDuration.Seconds())
+
+	lease, err := leases.Get(ctx, m.leaseName(), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		_, err = leases.Create(ctx, &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        m.leaseName(),
+				Namespace:   m.namespace,
+				Labels:      map[string]string{ShardLabel: "true"},
+				Annotations: map[string]string{ShardAddressAnnotation: m.member.Address},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &m.member.ID,
+				LeaseDurationSeconds: &seconds,
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}, metav1.CreateOptions{})
+		return errors.Wrap(err, "could not create processor shard lease")
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not get processor shard lease")
+	}
+
+	lease = lease.DeepCopy()
+	if lease.ObjectMeta.Labels == nil {
+		lease.ObjectMeta.Labels = map[string]string{}
+	}
+	lease.ObjectMeta.Labels[ShardLabel] = "true"
+	if lease.ObjectMeta.Annotations == nil {
+		lease.ObjectMeta.Annotations = map[string]string{}
+	}
+	lease.ObjectMeta.Annotations[ShardAddressAnnotation] = m.member.Address
+	lease.Spec.HolderIdentity = &m.member.ID
+	lease.Spec.LeaseDurationSeconds = &seconds
+	lease.Spec.RenewTime = &now
+	_, err = leases.Update(ctx, lease, metav1.UpdateOptions{})
+	return errors.Wrap(err, "could not update processor shard lease")
+}
@@ -0,0 +1,208 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	allocationpb "agones.dev/agones/pkg/allocation/go"
+)
+
+func TestRingOwner(t *testing.T) {
+	t.Parallel()
+
+	_, ok := NewRing(nil).Owner("default/fleet")
+	assert.False(t, ok)
+
+	members := []Member{{ID: "a"}, {ID: "b"}, {ID: "c"}}
+	ring := NewRing(members)
+
+	keys := make([]string, 1000)
+	counts := map[string]int{}
+	for i := range keys {
+		keys[i] = fmt.Sprintf("default/fleet-%d", i)
+		m, ok := ring.Owner(keys[i])
+		require.True(t, ok)
+		counts[m.ID]++
+	}
+	// partitions are spread over every member
+	for _, m := range members {
+		assert.Greater(t, counts[m.ID], 200, m.ID)
+	}
+
+	// member order does not change ownership
+	reordered := NewRing([]Member{{ID: "c"}, {ID: "a"}, {ID: "b"}})
+	for _, k := range keys {
+		a, _ := ring.Owner(k)
+		b, _ := reordered.Owner(k)
+		assert.Equal(t, a, b)
+	}
+
+	// when a member leaves, only its partitions move
+	left := NewRing([]Member{{ID: "a"}, {ID: "c"}})
+	for _, k := range keys {
+		before, _ := ring.Owner(k)
+		after, _ := left.Owner(k)
+		if before.ID != "b" {
+			assert.Equal(t, before, after)
+		}
+	}
+
+	// when a member joins, partitions only move to it
+	joined := NewRing(append(members, Member{ID: "d"}))
+	for _, k := range keys {
+		before, _ := ring.Owner(k)
+		after, _ := joined.Owner(k)
+		if after.ID != "d" {
+			assert.Equal(t, before, after)
+		}
+	}
+}
+
+func TestPartitionKey(t *testing.T) {
+	t.Parallel()
+
+	fixtures := map[string]struct {
+		req  *allocationpb.AllocationRequest
+		want string
+	}{
+		"fleet selector": {
+			req: &allocationpb.AllocationRequest{
+				Namespace: "default",
+				GameServerSelectors: []*allocationpb.GameServerSelector{
+					{MatchLabels: map[string]string{"agones.dev/fleet": "simple-game-server"}},
+				},
+			},
+			want: "default/simple-game-server",
+		},
+		"fleet in a later selector": {
+			req: &allocationpb.AllocationRequest{
+				Namespace: "default",
+				GameServerSelectors: []*allocationpb.GameServerSelector{
+					{MatchLabels: map[string]string{"version": "1"}},
+					{MatchLabels: map[string]string{"agones.dev/fleet": "blue"}},
+				},
+			},
+			want: "default/blue",
+		},
+		"deprecated required selector": {
+			req: &allocationpb.AllocationRequest{
+				Namespace:                  "other",
+				RequiredGameServerSelector: &allocationpb.GameServerSelector{MatchLabels: map[string]string{"agones.dev/fleet": "green"}},
+			},
+			want: "other/green",
+		},
+		"labels without a fleet": {
+			req: &allocationpb.AllocationRequest{
+				Namespace: "default",
+				GameServerSelectors: []*allocationpb.GameServerSelector{
+					{MatchLabels: map[string]string{"version": "1", "mode": "deathmatch"}},
+				},
+			},
+			want: "default/mode=deathmatch,version=1",
+		},
+		"no selectors": {
+			req:  &allocationpb.AllocationRequest{Namespace: "default"},
+			want: "default/",
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, v.want, PartitionKey(v.req))
+		})
+	}
+}
+
+func TestMembershipAndListMembers(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	kubeClient := fake.NewSimpleClientset()
+
+	m := NewMembership(kubeClient, "agones-system", Member{ID: "Processor-1", Address: "10.0.0.1:9090"}, 15*time.Second, time.Second, logrus.New())
+	m.now = func() time.Time { return now }
+	require.NoError(t, m.renew(context.Background()))
+
+	lease, err := kubeClient.CoordinationV1().Leases("agones-system").Get(context.Background(), "agones-processor-shard-processor-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "Processor-1", *lease.Spec.HolderIdentity)
+	assert.Equal(t, "true", lease.ObjectMeta.Labels[ShardLabel])
+
+	// renewing updates the existing lease
+	m.member.Address = "10.0.0.2:9090"
+	require.NoError(t, m.renew(context.Background()))
+
+	expired := int32(15)
+	stale := metav1.NewMicroTime(now.Add(-time.Minute))
+	holder := "processor-2"
+	_, err = kubeClient.CoordinationV1().Leases("agones-system").Create(context.Background(), &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "agones-processor-shard-processor-2",
+			Labels:      map[string]string{ShardLabel: "true"},
+			Annotations: map[string]string{ShardAddressAnnotation: "10.0.0.3:9090"},
+		},
+		Spec: coordinationv1.LeaseSpec{HolderIdentity: &holder, LeaseDurationSeconds: &expired, RenewTime: &stale},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	members, err := ListMembers(context.Background(), kubeClient, "agones-system", now)
+	require.NoError(t, err)
+	assert.Equal(t, []Member{{ID: "Processor-1", Address: "10.0.0.2:9090"}}, members)
+
+	members, err = ListMembers(context.Background(), kubeClient, "agones-system", now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, members)
+}
+
+func TestMembershipRunDeletesLease(t *testing.T) {
+	t.Parallel()
+
+	kubeClient := fake.NewSimpleClientset()
+	m := NewMembership(kubeClient, "agones-system", Member{ID: "processor-1", Address: "10.0.0.1:9090"}, 15*time.Second, time.Second, logrus.New())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan error)
+	go func() {
+		stopped <- m.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		_, err := kubeClient.CoordinationV1().Leases("agones-system").Get(context.Background(), "agones-processor-shard-processor-1", metav1.GetOptions{})
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the Lease is deleted on shutdown, by the time Run returns
+	cancel()
+	select {
+	case err := <-stopped:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "membership did not stop")
+	}
+	_, err := kubeClient.CoordinationV1().Leases("agones-system").Get(context.Background(), "agones-processor-shard-processor-1", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err), "lease should be deleted: %v", err)
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes"
+
+	allocationpb "agones.dev/agones/pkg/allocation/go"
+)
+
+// errShardLeft is returned to requests that were waiting on a processor that left the ring
+var errShardLeft = status.Error(codes.Unavailable, "processor shard left before responding")
+
+// shard is a connection to a single processor shard
+type shard struct {
+	member Member
+	client Client
+	cancel context.CancelFunc
+}
+
+// shardedClient implements Client over multiple active processors, routing each
+// request to the processor that owns its partition
+//
+//nolint:govet // fieldalignment: struct alignment is not critical for our use case
+type shardedClient struct {
+	config     Config
+	kubeClient kubernetes.Interface
+	namespace  string
+	logger     logrus.FieldLogger
+	newClient  func(Config, logrus.FieldLogger) Client
+	now        func() time.Time
+
+	mutex  sync.RWMutex
+	ring   *Ring
+	shards map[string]*shard
+}
+
+// NewShardedClient creates a processor client that discovers the active processor shards from their
+// membership Leases in namespace, and routes each allocation request to the shard owning its partition.
+// Membership is refreshed every ReconnectInterval.
+func NewShardedClient(config Config, kubeClient kubernetes.Interface, namespace string, logger logrus.FieldLogger) Client {
+	return &shardedClient{
+		config:     config,
+		kubeClient: kubeClient,
+		namespace:  namespace,
+		logger:     logger,
+		newClient:  NewClient,
+		now:        time.Now,
+		ring:       NewRing(nil),
+		shards:     map[string]*shard{},
+	}
+}
+
+// Run keeps the set of processor shards up to date until the context is cancelled
+func (s *shardedClient) Run(ctx context.Context) error {
+	s.logger.Info("Starting sharded processor client")
+
+	ticker := time.NewTicker(s.config.ReconnectInterval)
+	defer ticker.Stop()
+
+	for {
+		members, err := ListMembers(ctx, s.kubeClient, s.namespace, s.now())
+		if err != nil {
+			s.logger.WithError(err).Warn("Could not refresh processor shards, keeping the current set")
+		} else {
+			s.rebalance(ctx, members)
+		}
+
+		select {
+		case <-ctx.Done():
+			s.rebalance(ctx, nil)
+			s.logger.Info("Sharded processor client stopping")
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// rebalance connects to processors that joined, disconnects from processors that left,
+// and swaps in a Ring over the current members
+func (s *shardedClient) rebalance(ctx context.Context, members []Member) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	current := make(map[string]Member, len(members))
+	for _, m := range members {
+		current[m.ID] = m
+	}
+
+	var left []*shard
+	for id, sh := range s.shards {
+		if m, ok := current[id]; !ok || m.Address != sh.member.Address {
+			left = append(left, sh)
+			delete(s.shards, id)
+		}
+	}
+
+	for _, m := range members {
+		if _, ok := s.shards[m.ID]; ok {
+			continue
+		}
+		config := s.config
+		config.ProcessorAddress = m.Address
+		logger := s.logger.WithField("shard", m.ID)
+		shardCtx, cancel := context.WithCancel(ctx)
+		sh := &shard{member: m, client: s.newClient(config, logger), cancel: cancel}
+		s.shards[m.ID] = sh
+
+		logger.WithField("address", m.Address).Info("Processor shard joined")
+		go func() {
+			if err := sh.client.Run(shardCtx); err != nil && shardCtx.Err() == nil {
+				logger.WithError(err).Error("Processor shard client stopped")
+			}
+		}()
+	}
+
+	s.ring = NewRing(members)
+
+	// fail requests waiting on removed shards after the new ring is in place, so retries go to the new owner
+	for _, sh := range left {
+		s.logger.WithField("shard", sh.member.ID).Info("Processor shard left")
+		sh.cancel()
+		if f, ok := sh.client.(interface{ failPending(error) }); ok {
+			f.failPending(errShardLeft)
+		}
+	}
+}
+
+// Healthy returns true while at least one processor shard is healthy. Use HealthyFor to
+// check the shard that a request is routed to.
+func (s *shardedClient) Healthy() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, sh := range s.shards {
+		if sh.client.Healthy() {
+			return true
+		}
+	}
+	return false
+}
+
+// HealthyFor returns true when the processor shard that owns the partition of the request is healthy
+func (s *shardedClient) HealthyFor(req *allocationpb.AllocationRequest) bool {
+	sh, ok := s.owner(PartitionKey(req))
+	return ok && sh.client.Healthy()
+}
+
+// owner returns the shard that owns the request partition
+func (s *shardedClient) owner(key string) (*shard, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	m, ok := s.ring.Owner(key)
+	if !ok {
+		return nil, false
+	}
+	sh, ok := s.shards[m.ID]
+	return sh, ok
+}
+
+// Allocate sends the request to the processor shard that owns its partition. If that shard leaves
+// the ring before responding, the request is retried once on the new owner.
+func (s *shardedClient) Allocate(ctx context.Context, req *allocationpb.AllocationRequest) (*allocationpb.AllocationResponse, error) {
+	key := PartitionKey(req)
+
+	sh, ok := s.owner(key)
+	if !ok {
+		return nil, status.Error(codes.Unavailable, "no processor shards available")
+	}
+
+	resp, err := sh.client.Allocate(ctx, req)
+	if status.Code(err) != codes.Unavailable {
+		return resp, err
+	}
+
+	next, ok := s.owner(key)
+	if !ok || next.member.ID == sh.member.ID {
+		return resp, err
+	}
+	s.logger.WithField("partition", key).WithField("shard", next.member.ID).Debug("Retrying allocation on new partition owner")
+	return next.client.Allocate(ctx, req)
+}
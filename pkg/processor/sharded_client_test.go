@@ -0,0 +1,178 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes/fake"
+
+	allocationpb "agones.dev/agones/pkg/allocation/go"
+)
+
+// fakeShardClient is a Client that answers with its processor address
+type fakeShardClient struct {
+	address   string
+	block     chan error
+	unhealthy bool
+}
+
+func (f *fakeShardClient) Run(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (f *fakeShardClient) Allocate(_ context.Context, _ *allocationpb.AllocationRequest) (*allocationpb.AllocationResponse, error) {
+	if f.block != nil {
+		if err := <-f.block; err != nil {
+			return nil, err
+		}
+	}
+	return &allocationpb.AllocationResponse{GameServerName: f.address}, nil
+}
+
+func (f *fakeShardClient) Healthy() bool {
+	return !f.unhealthy
+}
+
+func (f *fakeShardClient) failPending(err error) {
+	if f.block != nil {
+		f.block <- err
+	}
+}
+
+func newTestShardedClient(clients map[string]*fakeShardClient) *shardedClient {
+	var mu sync.Mutex
+	s := NewShardedClient(Config{ReconnectInterval: time.Second}, fake.NewSimpleClientset(), "agones-system", logrus.New()).(*shardedClient)
+	s.newClient = func(config Config, _ logrus.FieldLogger) Client {
+		mu.Lock()
+		defer mu.Unlock()
+		if c, ok := clients[config.ProcessorAddress]; ok {
+			return c
+		}
+		return &fakeShardClient{address: config.ProcessorAddress}
+	}
+	return s
+}
+
+func TestShardedClientAllocate(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := newTestShardedClient(nil)
+	req := &allocationpb.AllocationRequest{
+		Namespace:           "default",
+		GameServerSelectors: []*allocationpb.GameServerSelector{{MatchLabels: map[string]string{"agones.dev/fleet": "simple-game-server"}}},
+	}
+
+	_, err := s.Allocate(ctx, req)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	members := []Member{{ID: "a", Address: "a:9090"}, {ID: "b", Address: "b:9090"}, {ID: "c", Address: "c:9090"}}
+	s.rebalance(ctx, members)
+
+	owner, ok := NewRing(members).Owner(PartitionKey(req))
+	require.True(t, ok)
+	resp, err := s.Allocate(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, owner.Address, resp.GameServerName)
+
+	// rebalances away from a shard that left
+	var remaining []Member
+	for _, m := range members {
+		if m.ID != owner.ID {
+			remaining = append(remaining, m)
+		}
+	}
+	s.rebalance(ctx, remaining)
+	assert.Len(t, s.shards, 2)
+	next, _ := NewRing(remaining).Owner(PartitionKey(req))
+	resp, err = s.Allocate(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, next.Address, resp.GameServerName)
+}
+
+func TestShardedClientRetriesWhenShardLeaves(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	members := []Member{{ID: "a", Address: "a:9090"}, {ID: "b", Address: "b:9090"}}
+	req := &allocationpb.AllocationRequest{Namespace: "default"}
+	owner, _ := NewRing(members).Owner(PartitionKey(req))
+
+	blocked := &fakeShardClient{address: owner.Address, block: make(chan error, 1)}
+	s := newTestShardedClient(map[string]*fakeShardClient{owner.Address: blocked})
+	s.rebalance(ctx, members)
+
+	type result struct {
+		resp *allocationpb.AllocationResponse
+		err  error
+	}
+	done := make(chan result)
+	go func() {
+		resp, err := s.Allocate(ctx, req)
+		done <- result{resp: resp, err: err}
+	}()
+
+	// give the request time to reach the owning shard
+	time.Sleep(50 * time.Millisecond)
+	for _, m := range members {
+		if m.ID != owner.ID {
+			s.rebalance(ctx, []Member{m})
+		}
+	}
+
+	select {
+	case r := <-done:
+		require.NoError(t, r.err)
+		assert.NotEqual(t, owner.Address, r.resp.GameServerName)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "allocation was not retried")
+	}
+}
+
+func TestShardedClientHealthy(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	unhealthy := &fakeShardClient{address: "b:9090", unhealthy: true}
+	s := newTestShardedClient(map[string]*fakeShardClient{"b:9090": unhealthy})
+	assert.False(t, s.Healthy())
+
+	s.rebalance(ctx, []Member{{ID: "b", Address: "b:9090"}})
+	assert.False(t, s.Healthy())
+
+	// one unhealthy shard does not make the other shards unhealthy
+	s.rebalance(ctx, []Member{{ID: "a", Address: "a:9090"}, {ID: "b", Address: "b:9090"}})
+	assert.True(t, s.Healthy())
+
+	s.rebalance(ctx, []Member{{ID: "a", Address: "a:9090"}})
+	assert.True(t, s.Healthy())
+	assert.True(t, s.HealthyFor(&allocationpb.AllocationRequest{Namespace: "default"}))
+}
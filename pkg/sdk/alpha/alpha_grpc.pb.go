@@ -91,6 +91,29 @@ type SDKClient interface {
 	//
 	// If GameServer.Status.Players.IDs is set manually through the Kubernetes API, use SDK.GameServer() or SDK.WatchGameServer() instead to view this value.
 	GetConnectedPlayers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PlayerIDList, error)
+	// Returns the JSON Web Key Set that verifies the connection tokens issued when this GameServer is allocated.
+	// Game servers that verify tokens themselves can use these keys, instead of calling VerifyConnectionToken.
+	GetConnectionTokenKeys(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ConnectionTokenKeys, error)
+	// Verifies the signature and expiry of a connection token presented by a game client, and that it was issued
+	// for an allocation of this GameServer. Returns the claims of the token, or an error if it is not valid.
+	VerifyConnectionToken(ctx context.Context, in *ConnectionToken, opts ...grpc.CallOption) (*ConnectionTokenClaims, error)
+	// Returns the payload of the allocation this GameServer was last allocated with, such as a match configuration.
+	// The payload is empty if the allocation had no payload, or once the GameServer has moved back to Ready.
+	GetAllocationPayload(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AllocationPayload, error)
+	// Sets a condition of this GameServer to True or False. A GameServer that has readiness gates stays in
+	// RequestReady after Ready() is called, until every condition its readiness gates refer to is True.
+	SetCondition(ctx context.Context, in *Condition, opts ...grpc.CallOption) (*Empty, error)
+	// Resets this GameServer for a new session, and moves it back to Ready, keeping its Pod and ports.
+	// Labels and annotations are reset to the ones of the Fleet template, and Counters, Lists and player tracking
+	// are reset to their initial values.
+	Recycle(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	// Sends a notice whenever this GameServer is about to be terminated, with the reason and the deadline by which
+	// it will have been stopped, so the game server can save its state or warn its players before SIGTERM.
+	// A notice is sent again if its reason or deadline changes.
+	WatchTermination(ctx context.Context, in *Empty, opts ...grpc.CallOption) (SDK_WatchTerminationClient, error)
+	// Reports the health of this GameServer, with a reason. A Healthy or Degraded report counts as a health ping.
+	// A Degraded GameServer can no longer be allocated, but keeps running, and an Unhealthy one is moved to Unhealthy.
+	ReportHealth(ctx context.Context, in *HealthReport, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type sDKClient struct {
@@ -164,6 +187,92 @@ func (c *sDKClient) GetConnectedPlayers(ctx context.Context, in *Empty, opts ...
 	return out, nil
 }
 
+func (c *sDKClient) GetConnectionTokenKeys(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ConnectionTokenKeys, error) {
+	out := new(ConnectionTokenKeys)
+	err := c.cc.Invoke(ctx, "/agones.dev.sdk.alpha.SDK/GetConnectionTokenKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDKClient) VerifyConnectionToken(ctx context.Context, in *ConnectionToken, opts ...grpc.CallOption) (*ConnectionTokenClaims, error) {
+	out := new(ConnectionTokenClaims)
+	err := c.cc.Invoke(ctx, "/agones.dev.sdk.alpha.SDK/VerifyConnectionToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDKClient) GetAllocationPayload(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AllocationPayload, error) {
+	out := new(AllocationPayload)
+	err := c.cc.Invoke(ctx, "/agones.dev.sdk.alpha.SDK/GetAllocationPayload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDKClient) SetCondition(ctx context.Context, in *Condition, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/agones.dev.sdk.alpha.SDK/SetCondition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDKClient) Recycle(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/agones.dev.sdk.alpha.SDK/Recycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDKClient) WatchTermination(ctx context.Context, in *Empty, opts ...grpc.CallOption) (SDK_WatchTerminationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SDK_ServiceDesc.Streams[0], "/agones.dev.sdk.alpha.SDK/WatchTermination", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sDKWatchTerminationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SDK_WatchTerminationClient interface {
+	Recv() (*TerminationNotice, error)
+	grpc.ClientStream
+}
+
+type sDKWatchTerminationClient struct {
+	grpc.ClientStream
+}
+
+func (x *sDKWatchTerminationClient) Recv() (*TerminationNotice, error) {
+	m := new(TerminationNotice)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sDKClient) ReportHealth(ctx context.Context, in *HealthReport, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/agones.dev.sdk.alpha.SDK/ReportHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SDKServer is the server API for SDK service.
 // All implementations should embed UnimplementedSDKServer
 // for forward compatibility
@@ -221,6 +330,29 @@ type SDKServer interface {
 	//
 	// If GameServer.Status.Players.IDs is set manually through the Kubernetes API, use SDK.GameServer() or SDK.WatchGameServer() instead to view this value.
 	GetConnectedPlayers(context.Context, *Empty) (*PlayerIDList, error)
+	// Returns the JSON Web Key Set that verifies the connection tokens issued when this GameServer is allocated.
+	// Game servers that verify tokens themselves can use these keys, instead of calling VerifyConnectionToken.
+	GetConnectionTokenKeys(context.Context, *Empty) (*ConnectionTokenKeys, error)
+	// Verifies the signature and expiry of a connection token presented by a game client, and that it was issued
+	// for an allocation of this GameServer. Returns the claims of the token, or an error if it is not valid.
+	VerifyConnectionToken(context.Context, *ConnectionToken) (*ConnectionTokenClaims, error)
+	// Returns the payload of the allocation this GameServer was last allocated with, such as a match configuration.
+	// The payload is empty if the allocation had no payload, or once the GameServer has moved back to Ready.
+	GetAllocationPayload(context.Context, *Empty) (*AllocationPayload, error)
+	// Sets a condition of this GameServer to True or False. A GameServer that has readiness gates stays in
+	// RequestReady after Ready() is called, until every condition its readiness gates refer to is True.
+	SetCondition(context.Context, *Condition) (*Empty, error)
+	// Resets this GameServer for a new session, and moves it back to Ready, keeping its Pod and ports.
+	// Labels and annotations are reset to the ones of the Fleet template, and Counters, Lists and player tracking
+	// are reset to their initial values.
+	Recycle(context.Context, *Empty) (*Empty, error)
+	// Sends a notice whenever this GameServer is about to be terminated, with the reason and the deadline by which
+	// it will have been stopped, so the game server can save its state or warn its players before SIGTERM.
+	// A notice is sent again if its reason or deadline changes.
+	WatchTermination(*Empty, SDK_WatchTerminationServer) error
+	// Reports the health of this GameServer, with a reason. A Healthy or Degraded report counts as a health ping.
+	// A Degraded GameServer can no longer be allocated, but keeps running, and an Unhealthy one is moved to Unhealthy.
+	ReportHealth(context.Context, *HealthReport) (*Empty, error)
 }
 
 // UnimplementedSDKServer should be embedded to have forward compatible implementations.
@@ -248,6 +380,27 @@ func (UnimplementedSDKServer) IsPlayerConnected(context.Context, *PlayerID) (*Bo
 func (UnimplementedSDKServer) GetConnectedPlayers(context.Context, *Empty) (*PlayerIDList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConnectedPlayers not implemented")
 }
+func (UnimplementedSDKServer) GetConnectionTokenKeys(context.Context, *Empty) (*ConnectionTokenKeys, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnectionTokenKeys not implemented")
+}
+func (UnimplementedSDKServer) VerifyConnectionToken(context.Context, *ConnectionToken) (*ConnectionTokenClaims, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyConnectionToken not implemented")
+}
+func (UnimplementedSDKServer) GetAllocationPayload(context.Context, *Empty) (*AllocationPayload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllocationPayload not implemented")
+}
+func (UnimplementedSDKServer) SetCondition(context.Context, *Condition) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCondition not implemented")
+}
+func (UnimplementedSDKServer) Recycle(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recycle not implemented")
+}
+func (UnimplementedSDKServer) WatchTermination(*Empty, SDK_WatchTerminationServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTermination not implemented")
+}
+func (UnimplementedSDKServer) ReportHealth(context.Context, *HealthReport) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportHealth not implemented")
+}
 
 // UnsafeSDKServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to SDKServer will
@@ -386,6 +539,135 @@ func _SDK_GetConnectedPlayers_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SDK_GetConnectionTokenKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDKServer).GetConnectionTokenKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agones.dev.sdk.alpha.SDK/GetConnectionTokenKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDKServer).GetConnectionTokenKeys(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDK_VerifyConnectionToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDKServer).VerifyConnectionToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agones.dev.sdk.alpha.SDK/VerifyConnectionToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDKServer).VerifyConnectionToken(ctx, req.(*ConnectionToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDK_GetAllocationPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDKServer).GetAllocationPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agones.dev.sdk.alpha.SDK/GetAllocationPayload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDKServer).GetAllocationPayload(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDK_SetCondition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Condition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDKServer).SetCondition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agones.dev.sdk.alpha.SDK/SetCondition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDKServer).SetCondition(ctx, req.(*Condition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDK_Recycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDKServer).Recycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agones.dev.sdk.alpha.SDK/Recycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDKServer).Recycle(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDK_WatchTermination_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SDKServer).WatchTermination(m, &sDKWatchTerminationServer{stream})
+}
+
+type SDK_WatchTerminationServer interface {
+	Send(*TerminationNotice) error
+	grpc.ServerStream
+}
+
+type sDKWatchTerminationServer struct {
+	grpc.ServerStream
+}
+
+func (x *sDKWatchTerminationServer) Send(m *TerminationNotice) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SDK_ReportHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDKServer).ReportHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agones.dev.sdk.alpha.SDK/ReportHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDKServer).ReportHealth(ctx, req.(*HealthReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SDK_ServiceDesc is the grpc.ServiceDesc for SDK service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -421,7 +703,37 @@ var SDK_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetConnectedPlayers",
 			Handler:    _SDK_GetConnectedPlayers_Handler,
 		},
+		{
+			MethodName: "GetConnectionTokenKeys",
+			Handler:    _SDK_GetConnectionTokenKeys_Handler,
+		},
+		{
+			MethodName: "VerifyConnectionToken",
+			Handler:    _SDK_VerifyConnectionToken_Handler,
+		},
+		{
+			MethodName: "GetAllocationPayload",
+			Handler:    _SDK_GetAllocationPayload_Handler,
+		},
+		{
+			MethodName: "SetCondition",
+			Handler:    _SDK_SetCondition_Handler,
+		},
+		{
+			MethodName: "Recycle",
+			Handler:    _SDK_Recycle_Handler,
+		},
+		{
+			MethodName: "ReportHealth",
+			Handler:    _SDK_ReportHealth_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTermination",
+			Handler:       _SDK_WatchTermination_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "alpha.proto",
 }
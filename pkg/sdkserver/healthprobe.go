@@ -0,0 +1,153 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdkserver
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+)
+
+// probeHost is the host the game server process is probed on, as the SDK server
+// shares the network namespace of the GameServer Pod.
+const probeHost = "localhost"
+
+// healthProbeInterval is how long to wait between health probes: half of the health period. With a
+// probe timeout of a quarter of the period, there is less than a period between two successful
+// probes, so the health check does not count a failure from how its loop drifts against the probes.
+func (s *SDKServer) healthProbeInterval() time.Duration {
+	return s.healthTimeout / 2
+}
+
+// probeHealth runs the configured health probe against the game server process,
+// and counts a successful probe as a health ping.
+func (s *SDKServer) probeHealth() {
+	ctx, cancel := context.WithTimeout(s.ctx, s.healthTimeout/4)
+	defer cancel()
+
+	if err := runHealthProbe(ctx, s.health.Probe); err != nil {
+		s.logger.WithError(err).Debug("Health probe failed")
+		return
+	}
+	s.logger.Debug("Health probe succeeded")
+	s.touchHealthLastUpdated()
+}
+
+// runHealthProbe runs whichever kind of probe is set, and returns an error if it fails.
+func runHealthProbe(ctx context.Context, p *agonesv1.HealthProbe) error {
+	switch {
+	case p.UDP != nil:
+		return probeUDP(ctx, p.UDP)
+	case p.TCP != nil:
+		return probeTCP(ctx, p.TCP)
+	case p.HTTP != nil:
+		return probeHTTP(ctx, p.HTTP)
+	case p.GRPC != nil:
+		return probeGRPC(ctx, p.GRPC)
+	}
+	return errors.New("no health probe configured")
+}
+
+func probeAddress(port int32) string {
+	return net.JoinHostPort(probeHost, strconv.Itoa(int(port)))
+}
+
+// probeUDP sends the request payload, and checks that the response payload matches the expected one.
+func probeUDP(ctx context.Context, p *agonesv1.UDPHealthProbe) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "udp", probeAddress(p.Port))
+	if err != nil {
+		return errors.Wrap(err, "could not dial UDP health probe")
+	}
+	defer conn.Close() // nolint: errcheck
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return errors.Wrap(err, "could not set UDP health probe deadline")
+		}
+	}
+
+	if _, err := conn.Write([]byte(p.Request)); err != nil {
+		return errors.Wrap(err, "could not send UDP health probe request")
+	}
+	b := make([]byte, 64*1024)
+	n, err := conn.Read(b)
+	if err != nil {
+		return errors.Wrap(err, "could not read UDP health probe response")
+	}
+	if string(b[:n]) != p.Response {
+		return errors.Errorf("unexpected UDP health probe response: %q", b[:n])
+	}
+	return nil
+}
+
+// probeTCP checks that a connection can be opened.
+func probeTCP(ctx context.Context, p *agonesv1.TCPHealthProbe) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", probeAddress(p.Port))
+	if err != nil {
+		return errors.Wrap(err, "could not connect TCP health probe")
+	}
+	return conn.Close()
+}
+
+// probeHTTP sends a GET request, and checks that the response status is 2xx or 3xx.
+// Redirects are not followed.
+func probeHTTP(ctx context.Context, p *agonesv1.HTTPHealthProbe) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+probeAddress(p.Port)+p.Path, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not create HTTP health probe request")
+	}
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not send HTTP health probe request")
+	}
+	defer resp.Body.Close() // nolint: errcheck
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		return errors.Errorf("unexpected HTTP health probe status: %s", resp.Status)
+	}
+	return nil
+}
+
+// probeGRPC calls the standard gRPC health checking service, and checks that the service is SERVING.
+func probeGRPC(ctx context.Context, p *agonesv1.GRPCHealthProbe) error {
+	conn, err := grpc.NewClient(probeAddress(p.Port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return errors.Wrap(err, "could not create gRPC health probe client")
+	}
+	defer conn.Close() // nolint: errcheck
+
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: p.Service})
+	if err != nil {
+		return errors.Wrap(err, "could not call gRPC health probe")
+	}
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return errors.Errorf("unexpected gRPC health probe status: %s", resp.GetStatus())
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdkserver
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	agtesting "agones.dev/agones/pkg/testing"
+)
+
+func TestRunHealthProbe(t *testing.T) {
+	t.Parallel()
+
+	// UDP game server that echoes back "PONG" to "PING"
+	udp, err := net.ListenPacket("udp", net.JoinHostPort(probeHost, "0"))
+	require.NoError(t, err)
+	defer udp.Close() // nolint: errcheck
+	go func() {
+		b := make([]byte, 1024)
+		for {
+			n, addr, err := udp.ReadFrom(b)
+			if err != nil {
+				return
+			}
+			if string(b[:n]) == "PING" {
+				_, _ = udp.WriteTo([]byte("PONG"), addr)
+			}
+		}
+	}()
+	udpPort := listenerPort(t, udp.LocalAddr())
+
+	// TCP, HTTP and gRPC game servers
+	tcp, err := net.Listen("tcp", net.JoinHostPort(probeHost, "0"))
+	require.NoError(t, err)
+	defer tcp.Close() // nolint: errcheck
+	tcpPort := listenerPort(t, tcp.Addr())
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	httpServer := httptest.NewServer(mux)
+	defer httpServer.Close()
+	httpPort := listenerPort(t, httpServer.Listener.Addr())
+
+	grpcListener, err := net.Listen("tcp", net.JoinHostPort(probeHost, "0"))
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("game", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("lobby", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	go func() {
+		_ = grpcServer.Serve(grpcListener)
+	}()
+	defer grpcServer.Stop()
+	grpcPort := listenerPort(t, grpcListener.Addr())
+
+	fixtures := map[string]struct {
+		probe   agonesv1.HealthProbe
+		healthy bool
+	}{
+		"udp expected response": {
+			probe:   agonesv1.HealthProbe{UDP: &agonesv1.UDPHealthProbe{Port: udpPort, Request: "PING", Response: "PONG"}},
+			healthy: true,
+		},
+		"udp unexpected response": {
+			probe:   agonesv1.HealthProbe{UDP: &agonesv1.UDPHealthProbe{Port: udpPort, Request: "PING", Response: "PANG"}},
+			healthy: false,
+		},
+		"udp no response": {
+			probe:   agonesv1.HealthProbe{UDP: &agonesv1.UDPHealthProbe{Port: udpPort, Request: "HELLO", Response: "PONG"}},
+			healthy: false,
+		},
+		"tcp listening": {
+			probe:   agonesv1.HealthProbe{TCP: &agonesv1.TCPHealthProbe{Port: tcpPort}},
+			healthy: true,
+		},
+		"http ok": {
+			probe:   agonesv1.HealthProbe{HTTP: &agonesv1.HTTPHealthProbe{Port: httpPort, Path: "/healthz"}},
+			healthy: true,
+		},
+		"http not found": {
+			probe:   agonesv1.HealthProbe{HTTP: &agonesv1.HTTPHealthProbe{Port: httpPort, Path: "/missing"}},
+			healthy: false,
+		},
+		"grpc serving": {
+			probe:   agonesv1.HealthProbe{GRPC: &agonesv1.GRPCHealthProbe{Port: grpcPort, Service: "game"}},
+			healthy: true,
+		},
+		"grpc not serving": {
+			probe:   agonesv1.HealthProbe{GRPC: &agonesv1.GRPCHealthProbe{Port: grpcPort, Service: "lobby"}},
+			healthy: false,
+		},
+		"no probe": {
+			probe:   agonesv1.HealthProbe{},
+			healthy: false,
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			err := runHealthProbe(ctx, &v.probe)
+			if v.healthy {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSDKServerProbeHealth(t *testing.T) {
+	t.Parallel()
+
+	tcp, err := net.Listen("tcp", net.JoinHostPort(probeHost, "0"))
+	require.NoError(t, err)
+	port := listenerPort(t, tcp.Addr())
+
+	m := agtesting.NewMocks()
+	sc, err := defaultSidecar(m)
+	require.NoError(t, err)
+	sc.ctx = context.Background()
+	sc.health = agonesv1.Health{PeriodSeconds: 1, FailureThreshold: 1, Probe: &agonesv1.HealthProbe{TCP: &agonesv1.TCPHealthProbe{Port: port}}}
+	sc.healthTimeout = time.Second
+	sc.healthFailureCount = 1
+	assert.False(t, sc.healthy())
+
+	// probes run within the period, so drift against the health check does not count as a failure
+	assert.Equal(t, 500*time.Millisecond, sc.healthProbeInterval())
+
+	// a successful probe counts as a health ping
+	sc.probeHealth()
+	assert.True(t, sc.healthy())
+
+	// a failed probe doesn't
+	require.NoError(t, tcp.Close())
+	sc.healthLastUpdated = time.Time{}
+	sc.probeHealth()
+	sc.checkHealth()
+	assert.False(t, sc.healthy())
+}
+
+func listenerPort(t *testing.T, addr net.Addr) int32 {
+	_, port, err := net.SplitHostPort(addr.String())
+	require.NoError(t, err)
+	p, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return int32(p)
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"agones.dev/agones/pkg/sdk"
+	"agones.dev/agones/pkg/sdk/alpha"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/metadata"
@@ -137,3 +138,21 @@ func (*gameServerMockStream) SendMsg(_ interface{}) error {
 func (*gameServerMockStream) RecvMsg(_ interface{}) error {
 	panic("implement me")
 }
+
+type terminationMockStream struct {
+	gameServerMockStream
+	notices chan *alpha.TerminationNotice
+}
+
+// newTerminationMockStream implements SDK_WatchTerminationServer for testing
+func newTerminationMockStream() *terminationMockStream {
+	return &terminationMockStream{
+		gameServerMockStream: gameServerMockStream{ctx: context.Background()},
+		notices:              make(chan *alpha.TerminationNotice, 10),
+	}
+}
+
+func (m *terminationMockStream) Send(notice *alpha.TerminationNotice) error {
+	m.notices <- notice
+	return nil
+}
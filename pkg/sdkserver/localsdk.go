@@ -30,12 +30,14 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/yaml"
 
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	"agones.dev/agones/pkg/sdk"
 	"agones.dev/agones/pkg/sdk/alpha"
 	"agones.dev/agones/pkg/sdk/beta"
+	"agones.dev/agones/pkg/util/connectiontoken"
 	"agones.dev/agones/pkg/util/runtime"
 )
 
@@ -103,6 +105,7 @@ type LocalSDKServer struct {
 	reserveTimer      *time.Timer
 	testMode          bool
 	testSdkName       string
+	allocationPayload []byte
 }
 
 // NewLocalSDKServer returns the default LocalSDKServer
@@ -269,6 +272,38 @@ func (l *LocalSDKServer) Ready(context.Context, *sdk.Empty) (*sdk.Empty, error)
 	return &sdk.Empty{}, nil
 }
 
+// Recycle removes the labels, annotations and players set through the SDK, and moves the local GameServer to Ready
+// [Stage:Dev]
+// [FeatureFlag:RecycleGameServers]
+func (l *LocalSDKServer) Recycle(context.Context, *alpha.Empty) (*alpha.Empty, error) {
+	if !runtime.FeatureEnabled(runtime.FeatureRecycleGameServers) {
+		return nil, errors.Errorf("%s not enabled", runtime.FeatureRecycleGameServers)
+	}
+	l.logger.Info("Recycle request has been received!")
+	l.recordRequest("recycle")
+	l.gsMutex.Lock()
+	defer l.gsMutex.Unlock()
+
+	for k := range l.gs.ObjectMeta.Labels {
+		if strings.HasPrefix(k, metadataPrefix) {
+			delete(l.gs.ObjectMeta.Labels, k)
+		}
+	}
+	for k := range l.gs.ObjectMeta.Annotations {
+		if strings.HasPrefix(k, metadataPrefix) {
+			delete(l.gs.ObjectMeta.Annotations, k)
+		}
+	}
+	if l.gs.Status.Players != nil {
+		l.gs.Status.Players.Ids = nil
+		l.gs.Status.Players.Count = 0
+	}
+	l.updateState(agonesv1.GameServerStateReady)
+	l.stopReserveTimer()
+	l.update <- struct{}{}
+	return &alpha.Empty{}, nil
+}
+
 // Allocate logs that an allocate request has been received
 func (l *LocalSDKServer) Allocate(context.Context, *sdk.Empty) (*sdk.Empty, error) {
 	l.logger.Info("Allocate request has been received!")
@@ -386,6 +421,45 @@ func (l *LocalSDKServer) WatchGameServer(_ *sdk.Empty, stream sdk.SDK_WatchGameS
 	return nil
 }
 
+// WatchTermination sends a notice whenever the termination annotations of the local GameServer change
+// [Stage:Dev]
+// [FeatureFlag:TerminationNotices]
+func (l *LocalSDKServer) WatchTermination(_ *alpha.Empty, stream alpha.SDK_WatchTerminationServer) error {
+	if !runtime.FeatureEnabled(runtime.FeatureTerminationNotices) {
+		return errors.Errorf("%s not enabled", runtime.FeatureTerminationNotices)
+	}
+	l.logger.Info("Connected to watch termination...")
+	observer := make(chan struct{}, 1)
+
+	defer func() {
+		l.updateObservers.Delete(observer)
+	}()
+
+	l.updateObservers.Store(observer, true)
+
+	l.recordRequest("watchtermination")
+
+	// send the initial notice, if there is one
+	observer <- struct{}{}
+
+	var last *alpha.TerminationNotice
+	for range observer {
+		l.gsMutex.RLock()
+		notice := convertTerminationNotice(&agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Annotations: l.gs.ObjectMeta.Annotations}})
+		l.gsMutex.RUnlock()
+		if notice == nil || (last != nil && last.Reason == notice.Reason && last.Deadline == notice.Deadline) {
+			continue
+		}
+		last = notice
+		if err := stream.Send(notice); err != nil {
+			l.logger.WithError(err).Error("error sending termination notice")
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Reserve moves this GameServer to the Reserved state for the Duration specified
 func (l *LocalSDKServer) Reserve(ctx context.Context, d *sdk.Duration) (*sdk.Empty, error) {
 	l.logger.WithField("duration", d).Info("Reserve request has been received!")
@@ -542,6 +616,80 @@ func (l *LocalSDKServer) GetConnectedPlayers(_ context.Context, _ *alpha.Empty)
 	return result, nil
 }
 
+// GetConnectionTokenKeys returns an empty JSON Web Key Set, as the local SDK server has no allocator to sign connection tokens.
+// [Stage:Dev]
+// [FeatureFlag:ConnectionTokens]
+func (l *LocalSDKServer) GetConnectionTokenKeys(_ context.Context, _ *alpha.Empty) (*alpha.ConnectionTokenKeys, error) {
+	if !runtime.FeatureEnabled(runtime.FeatureConnectionTokens) {
+		return nil, errors.Errorf("%s not enabled", runtime.FeatureConnectionTokens)
+	}
+	l.logger.Info("Getting connection token keys")
+	l.recordRequest("getconnectiontokenkeys")
+	return &alpha.ConnectionTokenKeys{Jwks: `{"keys":[]}`}, nil
+}
+
+// VerifyConnectionToken returns the claims of a connection token. The local SDK server does not verify
+// the signature or expiry of the token, so tokens can be created for local development without an allocator.
+// [Stage:Dev]
+// [FeatureFlag:ConnectionTokens]
+func (l *LocalSDKServer) VerifyConnectionToken(_ context.Context, in *alpha.ConnectionToken) (*alpha.ConnectionTokenClaims, error) {
+	if !runtime.FeatureEnabled(runtime.FeatureConnectionTokens) {
+		return nil, errors.Errorf("%s not enabled", runtime.FeatureConnectionTokens)
+	}
+	l.logger.Info("Verifying connection token, without checking its signature")
+	l.recordRequest("verifyconnectiontoken")
+
+	claims, err := connectiontoken.ParseUnverified(in.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	return convertConnectionTokenClaims(claims), nil
+}
+
+// GetAllocationPayload returns the status.allocationPayload of the GameServer in the local configuration file.
+// [Stage:Dev]
+// [FeatureFlag:AllocationPayload]
+func (l *LocalSDKServer) GetAllocationPayload(_ context.Context, _ *alpha.Empty) (*alpha.AllocationPayload, error) {
+	if !runtime.FeatureEnabled(runtime.FeatureAllocationPayload) {
+		return nil, errors.Errorf("%s not enabled", runtime.FeatureAllocationPayload)
+	}
+	l.logger.Info("Getting allocation payload")
+	l.recordRequest("getallocationpayload")
+	l.gsMutex.RLock()
+	defer l.gsMutex.RUnlock()
+	return &alpha.AllocationPayload{Payload: l.allocationPayload}, nil
+}
+
+// SetCondition logs the condition. The local SDK server has no readiness gates to hold the GameServer in RequestReady.
+// [Stage:Dev]
+// [FeatureFlag:ReadinessGates]
+func (l *LocalSDKServer) SetCondition(_ context.Context, in *alpha.Condition) (*alpha.Empty, error) {
+	if !runtime.FeatureEnabled(runtime.FeatureReadinessGates) {
+		return nil, errors.Errorf("%s not enabled", runtime.FeatureReadinessGates)
+	}
+	l.logger.WithField("condition", in).Info("Setting condition")
+	l.recordRequest("setcondition")
+	return &alpha.Empty{}, nil
+}
+
+// ReportHealth logs the health report, and moves the local GameServer to Unhealthy if it is an Unhealthy report.
+// [Stage:Dev]
+// [FeatureFlag:HealthReports]
+func (l *LocalSDKServer) ReportHealth(_ context.Context, in *alpha.HealthReport) (*alpha.Empty, error) {
+	if !runtime.FeatureEnabled(runtime.FeatureHealthReports) {
+		return nil, errors.Errorf("%s not enabled", runtime.FeatureHealthReports)
+	}
+	l.logger.WithField("report", in).Info("Health report received")
+	l.recordRequest("reporthealth")
+	if agonesv1.GameServerHealthStatus(in.GetStatus()) == agonesv1.GameServerHealthStatusUnhealthy {
+		l.gsMutex.Lock()
+		defer l.gsMutex.Unlock()
+		l.updateState(agonesv1.GameServerStateUnhealthy)
+		l.update <- struct{}{}
+	}
+	return &alpha.Empty{}, nil
+}
+
 // GetPlayerCount returns the current player count.
 // [Stage:Alpha]
 // [FeatureFlag:PlayerTracking]
@@ -910,6 +1058,7 @@ func (l *LocalSDKServer) setGameServerFromFilePath(filePath string) error {
 	l.gsMutex.Lock()
 	defer l.gsMutex.Unlock()
 	l.gs = convert(&gs)
+	l.allocationPayload = gs.Status.AllocationPayload
 
 	// Set LogLevel if specified
 	logLevel := agonesv1.SdkServerLogLevelInfo
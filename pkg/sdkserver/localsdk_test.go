@@ -415,6 +415,69 @@ func TestLocalSDKServerPlayerConnectAndDisconnectWithoutPlayerTracking(t *testin
 	assert.False(t, ok.Bool)
 }
 
+func TestLocalSDKServerConnectionTokens(t *testing.T) {
+	t.Parallel()
+
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(string(runtime.FeatureConnectionTokens)+"=true"))
+
+	l, err := NewLocalSDKServer("", "")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	keys, err := l.GetConnectionTokenKeys(ctx, &alpha.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, `{"keys":[]}`, keys.Jwks)
+
+	// tokens are not verified, so any well formed token is accepted.
+	// {"alg":"HS256"} . {"sub":"local","gameServerName":"local","playerIDs":["player1"]}
+	token := "eyJhbGciOiJIUzI1NiJ9.eyJzdWIiOiJsb2NhbCIsImdhbWVTZXJ2ZXJOYW1lIjoibG9jYWwiLCJwbGF5ZXJJRHMiOlsicGxheWVyMSJdfQ.c2ln"
+	_, err = l.VerifyConnectionToken(ctx, &alpha.ConnectionToken{Token: token})
+	assert.Error(t, err, "symmetric algorithms are not supported")
+
+	// {"alg":"ES256"} . {"sub":"local","gameServerName":"local","playerIDs":["player1"]}
+	token = "eyJhbGciOiJFUzI1NiJ9.eyJzdWIiOiJsb2NhbCIsImdhbWVTZXJ2ZXJOYW1lIjoibG9jYWwiLCJwbGF5ZXJJRHMiOlsicGxheWVyMSJdfQ.c2ln"
+	claims, err := l.VerifyConnectionToken(ctx, &alpha.ConnectionToken{Token: token})
+	require.NoError(t, err)
+	assert.Equal(t, "local", claims.GameServerName)
+	assert.Equal(t, []string{"player1"}, claims.PlayerIDs)
+	assert.Equal(t, int64(0), claims.Expiry)
+
+	_, err = l.VerifyConnectionToken(ctx, &alpha.ConnectionToken{Token: "nope"})
+	assert.Error(t, err)
+}
+
+func TestLocalSDKServerGetAllocationPayload(t *testing.T) {
+	t.Parallel()
+
+	runtime.FeatureTestMutex.Lock()
+	defer runtime.FeatureTestMutex.Unlock()
+	require.NoError(t, runtime.ParseFeatures(string(runtime.FeatureAllocationPayload)+"=true"))
+
+	ctx := context.Background()
+	l, err := NewLocalSDKServer("", "")
+	require.NoError(t, err)
+
+	payload, err := l.GetAllocationPayload(ctx, &alpha.Empty{})
+	require.NoError(t, err)
+	assert.Empty(t, payload.Payload)
+
+	path, err := gsToTmpFile(&agonesv1.GameServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "stuff"},
+		Status:     agonesv1.GameServerStatus{AllocationPayload: []byte(`{"teams":2}`)},
+	})
+	require.NoError(t, err)
+	defer os.Remove(path) // nolint: errcheck
+
+	l, err = NewLocalSDKServer(path, "")
+	require.NoError(t, err)
+
+	payload, err = l.GetAllocationPayload(ctx, &alpha.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`{"teams":2}`), payload.Payload)
+}
+
 func TestLocalSDKServerPlayerConnectAndDisconnect(t *testing.T) {
 	t.Parallel()
 
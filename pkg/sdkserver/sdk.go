@@ -17,6 +17,8 @@ package sdkserver
 import (
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	"agones.dev/agones/pkg/sdk"
+	"agones.dev/agones/pkg/sdk/alpha"
+	"agones.dev/agones/pkg/util/connectiontoken"
 	"agones.dev/agones/pkg/util/runtime"
 	"google.golang.org/protobuf/proto"
 )
@@ -105,3 +107,35 @@ func convert(gs *agonesv1.GameServer) *sdk.GameServer {
 
 	return result
 }
+
+// convertConnectionTokenClaims converts verified connection token claims into a gRPC SDK ConnectionTokenClaims object
+func convertConnectionTokenClaims(claims *connectiontoken.Claims) *alpha.ConnectionTokenClaims {
+	result := &alpha.ConnectionTokenClaims{
+		Id:             claims.ID,
+		GameServerName: claims.GameServerName,
+		Address:        claims.Address,
+		PlayerIDs:      claims.PlayerIDs,
+		Claims:         claims.Claims,
+	}
+	if !claims.Expiry.IsZero() {
+		result.Expiry = claims.Expiry.Unix()
+	}
+	for _, p := range claims.Ports {
+		result.Ports = append(result.Ports, &alpha.ConnectionTokenClaims_Port{Name: p.Name, Port: p.Port})
+	}
+	return result
+}
+
+// convertTerminationNotice converts the termination notice of a K8s GameServer object into a gRPC SDK TerminationNotice
+// object. Returns nil if the GameServer is not about to be terminated.
+func convertTerminationNotice(gs *agonesv1.GameServer) *alpha.TerminationNotice {
+	reason, deadline, ok := gs.TerminationNotice()
+	if !ok {
+		return nil
+	}
+	result := &alpha.TerminationNotice{Reason: reason}
+	if !deadline.IsZero() {
+		result.Deadline = deadline.Unix()
+	}
+	return result
+}
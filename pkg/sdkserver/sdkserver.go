@@ -19,6 +19,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 	"sync"
@@ -56,6 +58,8 @@ import (
 	"agones.dev/agones/pkg/sdk"
 	"agones.dev/agones/pkg/sdk/alpha"
 	"agones.dev/agones/pkg/sdk/beta"
+	"agones.dev/agones/pkg/util/connectiontoken"
+	"agones.dev/agones/pkg/util/fswatch"
 	"agones.dev/agones/pkg/util/logfields"
 	"agones.dev/agones/pkg/util/runtime"
 	"agones.dev/agones/pkg/util/workerqueue"
@@ -77,6 +81,8 @@ const (
 	updateConnectedPlayers Operation     = "updateConnectedPlayers"
 	updateCounters         Operation     = "updateCounters"
 	updateLists            Operation     = "updateLists"
+	updateConditions       Operation     = "updateConditions"
+	updateHealth           Operation     = "updateHealth"
 	updatePeriod           time.Duration = time.Second
 )
 
@@ -106,6 +112,11 @@ type listUpdateRequest struct {
 	valuesToAppend []string
 }
 
+type conditionUpdateRequest struct {
+	status  corev1.ConditionStatus
+	message string
+}
+
 // SDKServer is a gRPC server, that is meant to be a sidecar
 // for a GameServer that will update the game server status on SDK requests
 //
@@ -130,6 +141,8 @@ type SDKServer struct {
 	workerqueue         *workerqueue.WorkerQueue
 	streamMutex         sync.RWMutex
 	connectedStreams    []sdk.SDK_WatchGameServerServer
+	terminationStreams  []alpha.SDK_WatchTerminationServer
+	terminationNotice   *alpha.TerminationNotice
 	ctx                 context.Context
 	recorder            record.EventRecorder
 	gsLabels            map[string]string
@@ -144,13 +157,22 @@ type SDKServer struct {
 	gsConnectedPlayers  []string
 	gsCounterUpdates    map[string]counterUpdateRequest
 	gsListUpdates       map[string]listUpdateRequest
+	gsConditions        map[string]conditionUpdateRequest
+	gsRecycle           bool
+	gsHealth            *agonesv1.GameServerHealth
 	gsCopy              *agonesv1.GameServer
+	// connectionTokensFile is the path of the JSON Web Key Set that verifies connection tokens,
+	// which is reloaded when it changes so that keys can be rotated.
+	connectionTokensFile  string
+	connectionTokensMutex sync.Mutex
+	connectionTokens      *connectiontoken.Verifier
 }
 
 // NewSDKServer creates a SDKServer that sets up an
 // InClusterConfig for Kubernetes
 func NewSDKServer(gameServerName, namespace string, kubeClient kubernetes.Interface,
-	agonesClient versioned.Interface, logLevel logrus.Level, healthPort int, requestsRateLimit time.Duration) (*SDKServer, error) {
+	agonesClient versioned.Interface, logLevel logrus.Level, healthPort int, requestsRateLimit time.Duration,
+	connectionTokenKeysFile string) (*SDKServer, error) {
 	mux := http.NewServeMux()
 	resync := 0 * time.Second
 
@@ -181,6 +203,7 @@ func NewSDKServer(gameServerName, namespace string, kubeClient kubernetes.Interf
 		gsUpdateMutex:      sync.RWMutex{},
 		gsWaitForSync:      sync.WaitGroup{},
 		gsConnectedPlayers: []string{},
+		gsConditions:       map[string]conditionUpdateRequest{},
 		gsStateChannel:     make(chan agonesv1.GameServerState, 2),
 	}
 
@@ -194,10 +217,19 @@ func NewSDKServer(gameServerName, namespace string, kubeClient kubernetes.Interf
 	s.logger = runtime.NewLoggerWithType(s).WithField("gsKey", namespace+"/"+gameServerName)
 	s.logger.Logger.SetLevel(logLevel)
 
+	if runtime.FeatureEnabled(runtime.FeatureConnectionTokens) && connectionTokenKeysFile != "" {
+		s.connectionTokensFile = connectionTokenKeysFile
+		// the keys may not have been published yet, in which case they are loaded once they are.
+		if err := s.loadConnectionTokenKeys(); err != nil && !os.IsNotExist(errors.Cause(err)) {
+			return nil, err
+		}
+	}
+
 	_, _ = gameServers.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		UpdateFunc: func(_, newObj interface{}) {
 			gs := newObj.(*agonesv1.GameServer)
 			s.sendGameServerUpdate(gs)
+			s.sendTerminationNotice(gs)
 		},
 	})
 
@@ -293,10 +325,52 @@ func (s *SDKServer) Run(ctx context.Context) error {
 	}()
 	defer s.server.Close() // nolint: errcheck
 
+	if s.connectionTokensFile != "" {
+		cancel, err := fswatch.Watch(s.logger, filepath.Dir(s.connectionTokensFile), time.Second, func() {
+			if err := s.loadConnectionTokenKeys(); err != nil {
+				s.logger.WithError(err).Warn("Could not reload connection token keys, keeping the current keys")
+			}
+		})
+		if err != nil {
+			s.logger.WithError(err).Warn("Could not watch connection token keys, keys will not be reloaded")
+		} else {
+			defer cancel()
+		}
+	}
+
 	s.workerqueue.Run(ctx, 1)
 	return nil
 }
 
+// loadConnectionTokenKeys (re)loads the JSON Web Key Set that verifies connection tokens
+// from connectionTokensFile.
+func (s *SDKServer) loadConnectionTokenKeys() error {
+	jwks, err := os.ReadFile(s.connectionTokensFile)
+	if err != nil {
+		return errors.Wrap(err, "could not read connection token keys")
+	}
+
+	s.connectionTokensMutex.Lock()
+	defer s.connectionTokensMutex.Unlock()
+	if s.connectionTokens != nil {
+		return s.connectionTokens.SetJWKS(jwks)
+	}
+	v, err := connectiontoken.NewVerifier(s.namespace, s.gameServerName, jwks)
+	if err != nil {
+		return err
+	}
+	s.connectionTokens = v
+	s.logger.Info("Loaded connection token keys")
+	return nil
+}
+
+// connectionTokenVerifier returns the connection token Verifier, or nil if no keys have been loaded.
+func (s *SDKServer) connectionTokenVerifier() *connectiontoken.Verifier {
+	s.connectionTokensMutex.Lock()
+	defer s.connectionTokensMutex.Unlock()
+	return s.connectionTokens
+}
+
 // WaitForConnection attempts a GameServer GET every 3s until the client responds.
 // This is a workaround for the informer hanging indefinitely on first LIST due
 // to a flaky network to the Kubernetes service endpoint.
@@ -350,6 +424,10 @@ func (s *SDKServer) syncGameServer(ctx context.Context, key string) error {
 		return s.updateCounter(ctx)
 	case updateLists:
 		return s.updateList(ctx)
+	case updateConditions:
+		return s.updateConditions(ctx)
+	case updateHealth:
+		return s.updateHealth(ctx)
 	}
 
 	return errors.Errorf("could not sync game server key: %s", key)
@@ -393,7 +471,11 @@ func (s *SDKServer) updateState(ctx context.Context) error {
 
 	s.gsUpdateMutex.RLock()
 	gsCopy := gs.DeepCopy()
-	gsCopy.Status.State = s.gsState
+	reason := "SDK state change"
+	if s.gsState == agonesv1.GameServerStateUnhealthy {
+		reason = s.unhealthyReason()
+	}
+	gsCopy.SetState(s.gsState, reason)
 
 	// If we are setting the Reserved status, check for the duration, and set that too.
 	if gsCopy.Status.State == agonesv1.GameServerStateReserved && s.gsReserveDuration != nil {
@@ -402,6 +484,15 @@ func (s *SDKServer) updateState(ctx context.Context) error {
 	} else {
 		gsCopy.Status.ReservedUntil = nil
 	}
+
+	// If we are recycling, have the controller reset the GameServer as it moves it to Ready.
+	recycle := s.gsRecycle && gsCopy.Status.State == agonesv1.GameServerStateRequestReady
+	if recycle {
+		if gsCopy.ObjectMeta.Annotations == nil {
+			gsCopy.ObjectMeta.Annotations = map[string]string{}
+		}
+		gsCopy.ObjectMeta.Annotations[agonesv1.GameServerRecycleAnnotation] = agonesv1.True
+	}
 	s.gsUpdateMutex.RUnlock()
 
 	// If we are setting the Allocated status, set the last-allocated annotation as well.
@@ -420,6 +511,11 @@ func (s *SDKServer) updateState(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrapf(err, "could not update GameServer %s/%s to state %s", s.namespace, s.gameServerName, gsCopy.Status.State)
 	}
+	if recycle {
+		s.gsUpdateMutex.Lock()
+		s.gsRecycle = false
+		s.gsUpdateMutex.Unlock()
+	}
 
 	message := "SDK state change"
 	level := corev1.EventTypeNormal
@@ -427,7 +523,7 @@ func (s *SDKServer) updateState(ctx context.Context) error {
 	switch gs.Status.State {
 	case agonesv1.GameServerStateUnhealthy:
 		level = corev1.EventTypeWarning
-		message = "Health check failure"
+		message = reason
 	case agonesv1.GameServerStateReserved:
 		s.gsUpdateMutex.Lock()
 		if s.gsReserveDuration != nil {
@@ -523,6 +619,15 @@ func (s *SDKServer) updateAnnotations(ctx context.Context) error {
 	return err
 }
 
+// unhealthyReason returns the reason of the last Unhealthy health report, or the default health check failure
+// reason if there is none. Callers must hold gsUpdateMutex.
+func (s *SDKServer) unhealthyReason() string {
+	if s.gsHealth != nil && s.gsHealth.Status == agonesv1.GameServerHealthStatusUnhealthy && s.gsHealth.Reason != "" {
+		return s.gsHealth.Reason
+	}
+	return "Health check failure"
+}
+
 // enqueueState enqueue a State change request into the
 // workerqueue
 func (s *SDKServer) enqueueState(state agonesv1.GameServerState) {
@@ -544,6 +649,41 @@ func (s *SDKServer) Ready(_ context.Context, e *sdk.Empty) (*sdk.Empty, error) {
 	return e, nil
 }
 
+// Recycle discards the labels, annotations, Counters, Lists and player tracking values set through the SDK, and
+// enters the RequestReady state change for this GameServer into the workqueue, marked so that the controller resets
+// the GameServer before it moves back to Ready.
+// [Stage:Dev]
+// [FeatureFlag:RecycleGameServers]
+func (s *SDKServer) Recycle(_ context.Context, e *alpha.Empty) (*alpha.Empty, error) {
+	if !runtime.FeatureEnabled(runtime.FeatureRecycleGameServers) {
+		return nil, errors.Errorf("%s not enabled", runtime.FeatureRecycleGameServers)
+	}
+	s.logger.Debug("Received Recycle request, adding to queue")
+	gs, err := s.gameServer()
+	if err != nil {
+		return nil, err
+	}
+
+	s.stopReserveTimer()
+	s.gsUpdateMutex.Lock()
+	s.gsLabels = map[string]string{}
+	s.gsAnnotations = map[string]string{}
+	s.gsConnectedPlayers = []string{}
+	s.gsPlayerCapacity = 0
+	if gs.Spec.Players != nil {
+		s.gsPlayerCapacity = gs.Spec.Players.InitialCapacity
+	}
+	if s.gsCounterUpdates != nil {
+		s.gsCounterUpdates = map[string]counterUpdateRequest{}
+		s.gsListUpdates = map[string]listUpdateRequest{}
+	}
+	s.gsRecycle = true
+	s.gsUpdateMutex.Unlock()
+
+	s.enqueueState(agonesv1.GameServerStateRequestReady)
+	return e, nil
+}
+
 // Allocate enters an Allocate state change into the workqueue, so it can be updated
 func (s *SDKServer) Allocate(_ context.Context, e *sdk.Empty) (*sdk.Empty, error) {
 	s.stopReserveTimer()
@@ -673,6 +813,35 @@ func (s *SDKServer) WatchGameServer(_ *sdk.Empty, stream sdk.SDK_WatchGameServer
 	return nil
 }
 
+// WatchTermination sends a notice through the stream whenever the backing GameServer is about to be terminated,
+// and again if the reason or deadline of the termination changes.
+// [Stage:Dev]
+// [FeatureFlag:TerminationNotices]
+func (s *SDKServer) WatchTermination(_ *alpha.Empty, stream alpha.SDK_WatchTerminationServer) error {
+	if !runtime.FeatureEnabled(runtime.FeatureTerminationNotices) {
+		return errors.Errorf("%s not enabled", runtime.FeatureTerminationNotices)
+	}
+	s.logger.Debug("Received WatchTermination request, adding stream to terminationStreams")
+
+	gs, err := s.gameServer()
+	if err != nil {
+		return err
+	}
+
+	s.streamMutex.Lock()
+	if notice := convertTerminationNotice(gs); notice != nil {
+		if err := stream.Send(notice); err != nil {
+			s.streamMutex.Unlock()
+			return err
+		}
+	}
+	s.terminationStreams = append(s.terminationStreams, stream)
+	s.streamMutex.Unlock()
+	// don't exit until we shutdown, because that will close the stream
+	<-s.ctx.Done()
+	return nil
+}
+
 // Reserve moves this GameServer to the Reserved state for the Duration specified
 func (s *SDKServer) Reserve(_ context.Context, d *sdk.Duration) (*sdk.Empty, error) {
 	s.stopReserveTimer()
@@ -815,6 +984,110 @@ func (s *SDKServer) GetConnectedPlayers(_ context.Context, _ *alpha.Empty) (*alp
 	return &alpha.PlayerIDList{List: s.gsConnectedPlayers}, nil
 }
 
+// GetConnectionTokenKeys returns the JSON Web Key Set that verifies connection tokens for this GameServer.
+// [Stage:Dev]
+// [FeatureFlag:ConnectionTokens]
+func (s *SDKServer) GetConnectionTokenKeys(_ context.Context, _ *alpha.Empty) (*alpha.ConnectionTokenKeys, error) {
+	if !runtime.FeatureEnabled(runtime.FeatureConnectionTokens) {
+		return nil, errors.Errorf("%s not enabled", runtime.FeatureConnectionTokens)
+	}
+	v := s.connectionTokenVerifier()
+	if v == nil {
+		return nil, status.Error(codes.FailedPrecondition, "connection tokens are not configured")
+	}
+	return &alpha.ConnectionTokenKeys{Jwks: v.JWKS()}, nil
+}
+
+// VerifyConnectionToken verifies that a connection token was issued for an allocation of this GameServer,
+// and returns its claims.
+// [Stage:Dev]
+// [FeatureFlag:ConnectionTokens]
+func (s *SDKServer) VerifyConnectionToken(_ context.Context, in *alpha.ConnectionToken) (*alpha.ConnectionTokenClaims, error) {
+	if !runtime.FeatureEnabled(runtime.FeatureConnectionTokens) {
+		return nil, errors.Errorf("%s not enabled", runtime.FeatureConnectionTokens)
+	}
+	v := s.connectionTokenVerifier()
+	if v == nil {
+		return nil, status.Error(codes.FailedPrecondition, "connection tokens are not configured")
+	}
+	claims, err := v.Verify(in.GetToken())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return convertConnectionTokenClaims(claims), nil
+}
+
+// GetAllocationPayload returns the payload of the allocation the GameServer was last allocated with.
+// [Stage:Dev]
+// [FeatureFlag:AllocationPayload]
+func (s *SDKServer) GetAllocationPayload(_ context.Context, _ *alpha.Empty) (*alpha.AllocationPayload, error) {
+	if !runtime.FeatureEnabled(runtime.FeatureAllocationPayload) {
+		return nil, errors.Errorf("%s not enabled", runtime.FeatureAllocationPayload)
+	}
+	gs, err := s.gameServer()
+	if err != nil {
+		return nil, err
+	}
+	return &alpha.AllocationPayload{Payload: gs.Status.AllocationPayload}, nil
+}
+
+// SetCondition sets a condition of the GameServer, such as one that a readiness gate refers to.
+// [Stage:Dev]
+// [FeatureFlag:ReadinessGates]
+func (s *SDKServer) SetCondition(_ context.Context, in *alpha.Condition) (*alpha.Empty, error) {
+	if !runtime.FeatureEnabled(runtime.FeatureReadinessGates) {
+		return nil, errors.Errorf("%s not enabled", runtime.FeatureReadinessGates)
+	}
+	if errs := validation.IsQualifiedName(in.GetType()); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid condition type %q: %s", in.GetType(), strings.Join(errs, ", "))
+	}
+
+	request := conditionUpdateRequest{status: corev1.ConditionFalse, message: in.GetMessage()}
+	if in.GetStatus() {
+		request.status = corev1.ConditionTrue
+	}
+	s.gsUpdateMutex.Lock()
+	s.gsConditions[in.GetType()] = request
+	s.gsUpdateMutex.Unlock()
+	s.workerqueue.Enqueue(cache.ExplicitKey(string(updateConditions)))
+
+	return &alpha.Empty{}, nil
+}
+
+// ReportHealth records the health of the GameServer, with a reason, in its Status, conditions and events.
+// A Healthy or Degraded report counts as a health ping, and an Unhealthy report moves the GameServer to Unhealthy.
+// [Stage:Dev]
+// [FeatureFlag:HealthReports]
+func (s *SDKServer) ReportHealth(_ context.Context, in *alpha.HealthReport) (*alpha.Empty, error) {
+	if !runtime.FeatureEnabled(runtime.FeatureHealthReports) {
+		return nil, errors.Errorf("%s not enabled", runtime.FeatureHealthReports)
+	}
+	healthStatus := agonesv1.GameServerHealthStatus(in.GetStatus())
+	switch healthStatus {
+	case agonesv1.GameServerHealthStatusHealthy, agonesv1.GameServerHealthStatusDegraded:
+		s.touchHealthLastUpdated()
+	case agonesv1.GameServerHealthStatusUnhealthy:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid health status %q, must be one of %s, %s or %s", in.GetStatus(),
+			agonesv1.GameServerHealthStatusHealthy, agonesv1.GameServerHealthStatusDegraded, agonesv1.GameServerHealthStatusUnhealthy)
+	}
+
+	s.logger.WithField("status", healthStatus).WithField("reason", in.GetReason()).Debug("Received health report, adding to queue")
+	s.setHealth(healthStatus, in.GetReason())
+	if healthStatus == agonesv1.GameServerHealthStatusUnhealthy {
+		s.enqueueState(agonesv1.GameServerStateUnhealthy)
+	}
+	return &alpha.Empty{}, nil
+}
+
+// setHealth persists the health report in SDKServer, and enters it into the workqueue so it can be updated.
+func (s *SDKServer) setHealth(healthStatus agonesv1.GameServerHealthStatus, reason string) {
+	s.gsUpdateMutex.Lock()
+	s.gsHealth = &agonesv1.GameServerHealth{Status: healthStatus, Reason: reason}
+	s.gsUpdateMutex.Unlock()
+	s.workerqueue.Enqueue(cache.ExplicitKey(string(updateHealth)))
+}
+
 // GetPlayerCount returns the current player count.
 // [Stage:Alpha]
 // [FeatureFlag:PlayerTracking]
@@ -1445,12 +1718,46 @@ func (s *SDKServer) sendGameServerUpdate(gs *agonesv1.GameServer) {
 	}
 }
 
+// sendTerminationNotice sends a termination notice to the connected WatchTermination streams, if the
+// GameServer is about to be terminated, and the notice has changed since it was last sent
+func (s *SDKServer) sendTerminationNotice(gs *agonesv1.GameServer) {
+	notice := convertTerminationNotice(gs)
+
+	s.streamMutex.Lock()
+	defer s.streamMutex.Unlock()
+
+	if notice == nil || (s.terminationNotice != nil &&
+		s.terminationNotice.Reason == notice.Reason && s.terminationNotice.Deadline == notice.Deadline) {
+		return
+	}
+	s.terminationNotice = notice
+	s.logger.WithField("reason", notice.Reason).Info("GameServer is about to be terminated, sending notice to terminationStreams")
+
+	remainingStreams := s.terminationStreams[:0]
+	for _, stream := range s.terminationStreams {
+		if err := stream.Context().Err(); err != nil {
+			s.logger.WithError(err).Debug("Dropping termination stream")
+			continue
+		}
+		remainingStreams = append(remainingStreams, stream)
+
+		if err := stream.Send(notice); err != nil {
+			s.logger.WithError(errors.WithStack(err)).
+				Error("error sending termination notice")
+		}
+	}
+	s.terminationStreams = remainingStreams
+}
+
 // checkHealthUpdateState checks the health as part of the /gshealthz hook, and if not
 // healthy will push the Unhealthy state into the queue so it can be updated.
 func (s *SDKServer) checkHealthUpdateState() {
 	s.checkHealth()
 	if !s.healthy() {
 		s.logger.WithField("gameServerName", s.gameServerName).Warn("GameServer has failed health check")
+		if runtime.FeatureEnabled(runtime.FeatureHealthReports) {
+			s.setHealth(agonesv1.GameServerHealthStatusUnhealthy, "Health check failure")
+		}
 		s.enqueueState(agonesv1.GameServerStateUnhealthy)
 	}
 }
@@ -1471,6 +1778,11 @@ func (s *SDKServer) ensureHealthChecksRunning() {
 	s.healthChecksRunning.Do(func() {
 		// start health checking running
 		s.logger.Debug("Starting GameServer health checking")
+		if s.health.Probe != nil && runtime.FeatureEnabled(runtime.FeatureSidecarHealthProbes) {
+			// the game server process doesn't send health pings, so probe it instead.
+			s.logger.Debug("Starting GameServer health probes")
+			go wait.Until(s.probeHealth, s.healthProbeInterval(), s.ctx.Done())
+		}
 		go wait.Until(s.checkHealthUpdateState, s.healthTimeout, s.ctx.Done())
 	})
 }
@@ -1527,6 +1839,74 @@ func (s *SDKServer) updatePlayerCapacity(ctx context.Context) error {
 	return err
 }
 
+// updateConditions updates the conditions in the GameServer's Status to the ones persisted in SDKServer,
+// i.e. SDKServer.gsConditions
+func (s *SDKServer) updateConditions(ctx context.Context) error {
+	if !runtime.FeatureEnabled(runtime.FeatureReadinessGates) {
+		return errors.Errorf("%s not enabled", runtime.FeatureReadinessGates)
+	}
+	gs, err := s.gameServer()
+	if err != nil {
+		return err
+	}
+
+	gsCopy := gs.DeepCopy()
+	now := metav1.NewTime(s.clock.Now())
+	s.gsUpdateMutex.RLock()
+	s.logger.WithField("conditions", s.gsConditions).Debug("updating conditions")
+	for conditionType, c := range s.gsConditions {
+		gsCopy.SetCondition(conditionType, c.status, c.message, now)
+	}
+	s.gsUpdateMutex.RUnlock()
+	if apiequality.Semantic.DeepEqual(gs.Status.Conditions, gsCopy.Status.Conditions) {
+		return nil
+	}
+
+	_, err = s.patchGameServer(ctx, gs, gsCopy)
+	return err
+}
+
+// updateHealth updates the health in the GameServer's Status, and its Healthy condition, to the last health report
+// persisted in SDKServer, i.e. SDKServer.gsHealth, and records an event when the health status or reason changes.
+func (s *SDKServer) updateHealth(ctx context.Context) error {
+	if !runtime.FeatureEnabled(runtime.FeatureHealthReports) {
+		return errors.Errorf("%s not enabled", runtime.FeatureHealthReports)
+	}
+	s.gsUpdateMutex.RLock()
+	report := s.gsHealth
+	s.gsUpdateMutex.RUnlock()
+	if report == nil {
+		return nil
+	}
+	s.logger.WithField("health", report).Debug("updating health")
+
+	gs, err := s.gameServer()
+	if err != nil {
+		return err
+	}
+	if gs.Status.Health != nil && gs.Status.Health.Status == report.Status && gs.Status.Health.Reason == report.Reason {
+		return nil
+	}
+
+	gsCopy := gs.DeepCopy()
+	gsCopy.SetHealth(report.Status, report.Reason, metav1.NewTime(s.clock.Now()))
+	gs, err = s.patchGameServer(ctx, gs, gsCopy)
+	if err != nil {
+		return errors.Wrapf(err, "could not update GameServer %s/%s health to %s", s.namespace, s.gameServerName, report.Status)
+	}
+
+	level := corev1.EventTypeWarning
+	if report.Status == agonesv1.GameServerHealthStatusHealthy {
+		level = corev1.EventTypeNormal
+	}
+	message := "SDK health report"
+	if report.Reason != "" {
+		message = report.Reason
+	}
+	s.recorder.Event(gs, level, string(report.Status), message)
+	return nil
+}
+
 // updateConnectedPlayers updates the Player IDs and Count fields in the GameServer's Status.
 func (s *SDKServer) updateConnectedPlayers(ctx context.Context) error {
 	if !runtime.FeatureEnabled(runtime.FeaturePlayerTracking) {
@@ -16,8 +16,15 @@ package sdkserver
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -28,11 +35,13 @@ import (
 	"google.golang.org/grpc/status"
 
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
 	"agones.dev/agones/pkg/gameserverallocations"
 	"agones.dev/agones/pkg/sdk"
 	"agones.dev/agones/pkg/sdk/alpha"
 	"agones.dev/agones/pkg/sdk/beta"
 	agtesting "agones.dev/agones/pkg/testing"
+	"agones.dev/agones/pkg/util/connectiontoken"
 	agruntime "agones.dev/agones/pkg/util/runtime"
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/google/go-cmp/cmp"
@@ -41,6 +50,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -209,7 +219,7 @@ func TestSidecarRun(t *testing.T) {
 				return true, gsCopy, nil
 			})
 
-			sc, err := NewSDKServer("test", "default", m.KubeClient, m.AgonesClient, logrus.DebugLevel, 8080, 500*time.Millisecond)
+			sc, err := NewSDKServer("test", "default", m.KubeClient, m.AgonesClient, logrus.DebugLevel, 8080, 500*time.Millisecond, "")
 			stop := make(chan struct{})
 			defer close(stop)
 			ctx, cancel := context.WithCancel(context.Background())
@@ -466,7 +476,7 @@ func TestSidecarUnhealthyMessage(t *testing.T) {
 	t.Parallel()
 
 	m := agtesting.NewMocks()
-	sc, err := NewSDKServer("test", "default", m.KubeClient, m.AgonesClient, logrus.DebugLevel, 8080, 500*time.Millisecond)
+	sc, err := NewSDKServer("test", "default", m.KubeClient, m.AgonesClient, logrus.DebugLevel, 8080, 500*time.Millisecond, "")
 	require.NoError(t, err)
 
 	gs := agonesv1.GameServer{
@@ -617,7 +627,7 @@ func TestSidecarHealthy(t *testing.T) {
 
 func TestSidecarHTTPHealthCheck(t *testing.T) {
 	m := agtesting.NewMocks()
-	sc, err := NewSDKServer("test", "default", m.KubeClient, m.AgonesClient, logrus.DebugLevel, 8080, 500*time.Millisecond)
+	sc, err := NewSDKServer("test", "default", m.KubeClient, m.AgonesClient, logrus.DebugLevel, 8080, 500*time.Millisecond, "")
 	require.NoError(t, err)
 
 	now := time.Now().Add(time.Hour).UTC()
@@ -774,6 +784,83 @@ func TestSDKServerWatchGameServer(t *testing.T) {
 	assert.Equal(t, 2, totalSendCalls)
 }
 
+func TestSDKServerWatchTermination(t *testing.T) {
+	t.Parallel()
+	agruntime.FeatureTestMutex.Lock()
+	defer agruntime.FeatureTestMutex.Unlock()
+
+	fixture := &agonesv1.GameServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Status: agonesv1.GameServerStatus{
+			State: agonesv1.GameServerStateAllocated,
+		},
+	}
+
+	m := agtesting.NewMocks()
+	fakeWatch := watch.NewFake()
+	m.AgonesClient.AddWatchReactor("gameservers", k8stesting.DefaultWatchReactor(fakeWatch, nil))
+
+	sc, err := defaultSidecar(m)
+	require.NoError(t, err)
+
+	require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureTerminationNotices)+"=false"))
+	err = sc.WatchTermination(&alpha.Empty{}, newTerminationMockStream())
+	require.EqualError(t, err, "TerminationNotices not enabled")
+
+	require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureTerminationNotices)+"=true"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sc.ctx = ctx
+	sc.informerFactory.Start(ctx.Done())
+
+	fakeWatch.Add(fixture.DeepCopy())
+	assert.True(t, cache.WaitForCacheSync(ctx.Done(), sc.gameServerSynced))
+	sc.gsWaitForSync.Done()
+
+	// wait for the GameServer to be populated, as we can't rely on WaitForCacheSync
+	require.Eventually(t, func() bool {
+		_, err := sc.gameServer()
+		return err == nil
+	}, time.Minute, time.Second, "Could not find the GameServer")
+
+	stream := newTerminationMockStream()
+	go func() {
+		err := sc.WatchTermination(&alpha.Empty{}, stream)
+		assert.NoError(t, err)
+	}()
+	require.Eventually(t, func() bool {
+		sc.streamMutex.RLock()
+		defer sc.streamMutex.RUnlock()
+		return len(sc.terminationStreams) == 1
+	}, 10*time.Second, 100*time.Millisecond, "Termination stream was not connected")
+
+	deadline := time.Now().Add(30 * time.Second).Truncate(time.Second)
+	fixture.SetTerminationNotice(agonesv1.TerminationReasonEviction, deadline)
+	fakeWatch.Modify(fixture.DeepCopy())
+
+	select {
+	case notice := <-stream.notices:
+		assert.Equal(t, agonesv1.TerminationReasonEviction, notice.Reason)
+		assert.Equal(t, deadline.Unix(), notice.Deadline)
+	case <-time.After(10 * time.Second):
+		assert.FailNow(t, "Did not receive a termination notice")
+	}
+
+	// the same notice should not be sent twice
+	fixture.ObjectMeta.Labels = map[string]string{"foo": "bar"}
+	fakeWatch.Modify(fixture.DeepCopy())
+
+	select {
+	case notice := <-stream.notices:
+		assert.FailNow(t, "Unexpected termination notice", "notice: %v", notice)
+	case <-time.After(2 * time.Second):
+	}
+}
+
 func TestSDKServerSendGameServerUpdate(t *testing.T) {
 	t.Parallel()
 	fixture := &agonesv1.GameServer{
@@ -2409,8 +2496,379 @@ func TestSDKServerGracefulTerminationGameServerStateChannel(t *testing.T) {
 	}
 }
 
+func TestSDKServerConnectionTokens(t *testing.T) {
+	t.Parallel()
+
+	agruntime.FeatureTestMutex.Lock()
+	defer agruntime.FeatureTestMutex.Unlock()
+
+	newSigner := func() *connectiontoken.Signer {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+		require.NoError(t, err)
+		signer, err := connectiontoken.NewSigner(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes}), time.Minute)
+		require.NoError(t, err)
+		return signer
+	}
+	signer := newSigner()
+	jwks, err := signer.JWKS()
+	require.NoError(t, err)
+	keysFile := filepath.Join(t.TempDir(), "jwks.json")
+
+	gs := &agonesv1.GameServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Status: agonesv1.GameServerStatus{
+			Address: "10.0.0.1",
+			Ports:   []agonesv1.GameServerStatusPort{{Name: "game", Port: 7777}},
+		},
+	}
+	token, err := signer.Sign(gs, &allocationv1.ConnectionTokenRequest{PlayerIDs: []string{"player1"}, Claims: map[string]string{"team": "red"}})
+	require.NoError(t, err)
+	gs.ObjectMeta.Name = "other"
+	otherToken, err := signer.Sign(gs, nil)
+	require.NoError(t, err)
+
+	m := agtesting.NewMocks()
+	ctx := context.Background()
+
+	require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureConnectionTokens)+"=false"))
+	require.NoError(t, os.WriteFile(keysFile, jwks, 0o600))
+	sc, err := NewSDKServer("test", "default", m.KubeClient, m.AgonesClient, logrus.DebugLevel, 8080, 500*time.Millisecond, keysFile)
+	require.NoError(t, err)
+	assert.Nil(t, sc.connectionTokens)
+	_, err = sc.VerifyConnectionToken(ctx, &alpha.ConnectionToken{Token: token})
+	assert.EqualError(t, err, "ConnectionTokens not enabled")
+
+	require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureConnectionTokens)+"=true"))
+	_, err = sc.GetConnectionTokenKeys(ctx, &alpha.Empty{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// keys that have not been published yet are loaded later
+	missing := filepath.Join(t.TempDir(), "jwks.json")
+	sc, err = NewSDKServer("test", "default", m.KubeClient, m.AgonesClient, logrus.DebugLevel, 8080, 500*time.Millisecond, missing)
+	require.NoError(t, err)
+	_, err = sc.GetConnectionTokenKeys(ctx, &alpha.Empty{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.NoError(t, os.WriteFile(missing, jwks, 0o600))
+	require.NoError(t, sc.loadConnectionTokenKeys())
+	_, err = sc.GetConnectionTokenKeys(ctx, &alpha.Empty{})
+	assert.NoError(t, err)
+
+	invalid := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(invalid, []byte(`{"keys":[]}`), 0o600))
+	_, err = NewSDKServer("test", "default", m.KubeClient, m.AgonesClient, logrus.DebugLevel, 8080, 500*time.Millisecond, invalid)
+	assert.Error(t, err)
+
+	sc, err = NewSDKServer("test", "default", m.KubeClient, m.AgonesClient, logrus.DebugLevel, 8080, 500*time.Millisecond, keysFile)
+	require.NoError(t, err)
+
+	keys, err := sc.GetConnectionTokenKeys(ctx, &alpha.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, string(jwks), keys.Jwks)
+
+	claims, err := sc.VerifyConnectionToken(ctx, &alpha.ConnectionToken{Token: token})
+	require.NoError(t, err)
+	assert.Equal(t, "test", claims.GameServerName)
+	assert.Equal(t, "10.0.0.1", claims.Address)
+	assert.Equal(t, []*alpha.ConnectionTokenClaims_Port{{Name: "game", Port: 7777}}, claims.Ports)
+	assert.Equal(t, []string{"player1"}, claims.PlayerIDs)
+	assert.Equal(t, map[string]string{"team": "red"}, claims.Claims)
+	assert.NotEmpty(t, claims.Id)
+	assert.Greater(t, claims.Expiry, time.Now().Unix())
+
+	_, err = sc.VerifyConnectionToken(ctx, &alpha.ConnectionToken{Token: otherToken})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// rotated keys are picked up when reloaded
+	gs.ObjectMeta.Name = "test"
+	rotated := newSigner()
+	rotatedToken, err := rotated.Sign(gs, nil)
+	require.NoError(t, err)
+	_, err = sc.VerifyConnectionToken(ctx, &alpha.ConnectionToken{Token: rotatedToken})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	rotatedJWKS, err := rotated.JWKS()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keysFile, rotatedJWKS, 0o600))
+	require.NoError(t, sc.loadConnectionTokenKeys())
+	_, err = sc.VerifyConnectionToken(ctx, &alpha.ConnectionToken{Token: rotatedToken})
+	assert.NoError(t, err)
+
+	// invalid keys keep the current keys
+	require.NoError(t, os.WriteFile(keysFile, []byte(`nope`), 0o600))
+	assert.Error(t, sc.loadConnectionTokenKeys())
+	_, err = sc.VerifyConnectionToken(ctx, &alpha.ConnectionToken{Token: rotatedToken})
+	assert.NoError(t, err)
+}
+
+func TestSDKServerGetAllocationPayload(t *testing.T) {
+	t.Parallel()
+
+	agruntime.FeatureTestMutex.Lock()
+	defer agruntime.FeatureTestMutex.Unlock()
+
+	fixture := &agonesv1.GameServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Status: agonesv1.GameServerStatus{
+			State:             agonesv1.GameServerStateAllocated,
+			AllocationPayload: []byte(`{"teams":2}`),
+		},
+	}
+
+	m := agtesting.NewMocks()
+	m.AgonesClient.AddReactor("list", "gameservers", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &agonesv1.GameServerList{Items: []agonesv1.GameServer{*fixture}}, nil
+	})
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	sc, err := defaultSidecar(m)
+	require.NoError(t, err)
+
+	sc.informerFactory.Start(stop)
+	assert.True(t, cache.WaitForCacheSync(stop, sc.gameServerSynced))
+	sc.gsWaitForSync.Done()
+
+	require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureAllocationPayload)+"=false"))
+	_, err = sc.GetAllocationPayload(context.Background(), &alpha.Empty{})
+	assert.EqualError(t, err, "AllocationPayload not enabled")
+
+	require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureAllocationPayload)+"=true"))
+	result, err := sc.GetAllocationPayload(context.Background(), &alpha.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, fixture.Status.AllocationPayload, result.Payload)
+}
+
+func TestSDKServerSetCondition(t *testing.T) {
+	t.Parallel()
+	agruntime.FeatureTestMutex.Lock()
+	defer agruntime.FeatureTestMutex.Unlock()
+
+	m := agtesting.NewMocks()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sc, err := defaultSidecar(m)
+	require.NoError(t, err)
+
+	gs := agonesv1.GameServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test", Namespace: "default", ResourceVersion: "0",
+		},
+		Spec: agonesv1.GameServerSpec{
+			SdkServer: agonesv1.SdkServer{
+				LogLevel: "Debug",
+			},
+			ReadinessGates: []agonesv1.GameServerReadinessGate{{ConditionType: "AssetsCached"}},
+		},
+		Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateRequestReady},
+	}
+	gs.ApplyDefaults()
+
+	m.AgonesClient.AddReactor("list", "gameservers", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &agonesv1.GameServerList{Items: []agonesv1.GameServer{*gs.DeepCopy()}}, nil
+	})
+
+	updated := make(chan []agonesv1.GameServerCondition, 10)
+	m.AgonesClient.AddReactor("patch", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		gsCopy := patchGameServer(t, action, &gs)
+		updated <- gsCopy.Status.Conditions
+		return true, gsCopy, nil
+	})
+
+	assert.NoError(t, sc.WaitForConnection(ctx))
+	sc.informerFactory.Start(ctx.Done())
+	assert.True(t, cache.WaitForCacheSync(ctx.Done(), sc.gameServerSynced))
+
+	require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureReadinessGates)+"=false"))
+	_, err = sc.SetCondition(context.Background(), &alpha.Condition{Type: "AssetsCached", Status: true})
+	assert.EqualError(t, err, "ReadinessGates not enabled")
+
+	require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureReadinessGates)+"=true"))
+	_, err = sc.SetCondition(context.Background(), &alpha.Condition{Type: "assets cached", Status: true})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	go func() {
+		err := sc.Run(ctx)
+		assert.NoError(t, err)
+	}()
+
+	_, err = sc.SetCondition(context.Background(), &alpha.Condition{Type: "AssetsCached", Status: true, Message: "all assets loaded"})
+	require.NoError(t, err)
+
+	select {
+	case conditions := <-updated:
+		require.Len(t, conditions, 1)
+		assert.Equal(t, "AssetsCached", conditions[0].Type)
+		assert.Equal(t, corev1.ConditionTrue, conditions[0].Status)
+		assert.Equal(t, "all assets loaded", conditions[0].Message)
+		assert.False(t, conditions[0].LastTransitionTime.IsZero())
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "Should have been patched")
+	}
+}
+
+func TestSDKServerReportHealth(t *testing.T) {
+	t.Parallel()
+	agruntime.FeatureTestMutex.Lock()
+	defer agruntime.FeatureTestMutex.Unlock()
+
+	m := agtesting.NewMocks()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sc, err := defaultSidecar(m)
+	require.NoError(t, err)
+
+	gs := agonesv1.GameServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test", Namespace: "default", ResourceVersion: "0",
+		},
+		Spec: agonesv1.GameServerSpec{
+			SdkServer: agonesv1.SdkServer{
+				LogLevel: "Debug",
+			},
+		},
+		Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady},
+	}
+	gs.ApplyDefaults()
+
+	m.AgonesClient.AddReactor("list", "gameservers", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &agonesv1.GameServerList{Items: []agonesv1.GameServer{*gs.DeepCopy()}}, nil
+	})
+
+	updated := make(chan *agonesv1.GameServer, 10)
+	m.AgonesClient.AddReactor("patch", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		gsCopy := patchGameServer(t, action, &gs)
+		updated <- gsCopy
+		return true, gsCopy, nil
+	})
+
+	assert.NoError(t, sc.WaitForConnection(ctx))
+	sc.informerFactory.Start(ctx.Done())
+	assert.True(t, cache.WaitForCacheSync(ctx.Done(), sc.gameServerSynced))
+
+	require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureHealthReports)+"=false"))
+	_, err = sc.ReportHealth(context.Background(), &alpha.HealthReport{Status: "Degraded"})
+	assert.EqualError(t, err, "HealthReports not enabled")
+
+	require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureHealthReports)+"=true"))
+	_, err = sc.ReportHealth(context.Background(), &alpha.HealthReport{Status: "Sluggish"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// a Degraded report counts as a health ping
+	sc.health = agonesv1.Health{PeriodSeconds: 1, FailureThreshold: 1}
+	sc.healthFailureCount = 1
+	assert.False(t, sc.healthy())
+	_, err = sc.ReportHealth(context.Background(), &alpha.HealthReport{Status: "Degraded", Reason: "lost connection to backend"})
+	require.NoError(t, err)
+	assert.True(t, sc.healthy())
+
+	go func() {
+		err := sc.Run(ctx)
+		assert.NoError(t, err)
+	}()
+
+	select {
+	case gsCopy := <-updated:
+		require.NotNil(t, gsCopy.Status.Health)
+		assert.Equal(t, agonesv1.GameServerHealthStatusDegraded, gsCopy.Status.Health.Status)
+		assert.Equal(t, "lost connection to backend", gsCopy.Status.Health.Reason)
+		assert.False(t, gsCopy.Status.Health.LastTransitionTime.IsZero())
+		assert.Equal(t, agonesv1.GameServerStateReady, gsCopy.Status.State)
+		c := gsCopy.Condition(agonesv1.GameServerHealthyCondition)
+		require.NotNil(t, c)
+		assert.Equal(t, corev1.ConditionFalse, c.Status)
+		assert.Equal(t, "lost connection to backend", c.Message)
+		assert.True(t, gsCopy.IsDegraded())
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "Should have been patched")
+	}
+	agtesting.AssertEventContains(t, m.FakeRecorder.Events, "Warning Degraded lost connection to backend")
+
+	// an Unhealthy report moves the GameServer to Unhealthy
+	_, err = sc.ReportHealth(context.Background(), &alpha.HealthReport{Status: "Unhealthy", Reason: "tick loop stalled"})
+	require.NoError(t, err)
+	sc.gsUpdateMutex.RLock()
+	assert.Equal(t, agonesv1.GameServerStateUnhealthy, sc.gsState)
+	assert.Equal(t, &agonesv1.GameServerHealth{Status: agonesv1.GameServerHealthStatusUnhealthy, Reason: "tick loop stalled"}, sc.gsHealth)
+	sc.gsUpdateMutex.RUnlock()
+}
+
+func TestSDKServerRecycle(t *testing.T) {
+	t.Parallel()
+	agruntime.FeatureTestMutex.Lock()
+	defer agruntime.FeatureTestMutex.Unlock()
+
+	m := agtesting.NewMocks()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sc, err := defaultSidecar(m)
+	require.NoError(t, err)
+
+	gs := agonesv1.GameServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test", Namespace: "default", ResourceVersion: "0",
+		},
+		Spec: agonesv1.GameServerSpec{
+			SdkServer: agonesv1.SdkServer{
+				LogLevel: "Debug",
+			},
+		},
+		Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateAllocated},
+	}
+	gs.ApplyDefaults()
+
+	m.AgonesClient.AddReactor("list", "gameservers", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &agonesv1.GameServerList{Items: []agonesv1.GameServer{*gs.DeepCopy()}}, nil
+	})
+
+	updated := make(chan *agonesv1.GameServer, 10)
+	m.AgonesClient.AddReactor("patch", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		gsCopy := patchGameServer(t, action, &gs)
+		updated <- gsCopy
+		return true, gsCopy, nil
+	})
+
+	assert.NoError(t, sc.WaitForConnection(ctx))
+	sc.informerFactory.Start(ctx.Done())
+	assert.True(t, cache.WaitForCacheSync(ctx.Done(), sc.gameServerSynced))
+
+	require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureRecycleGameServers)+"=false"))
+	_, err = sc.Recycle(context.Background(), &alpha.Empty{})
+	assert.EqualError(t, err, "RecycleGameServers not enabled")
+
+	require.NoError(t, agruntime.ParseFeatures(string(agruntime.FeatureRecycleGameServers)+"=true"))
+	go func() {
+		err := sc.Run(ctx)
+		assert.NoError(t, err)
+	}()
+
+	_, err = sc.Recycle(context.Background(), &alpha.Empty{})
+	require.NoError(t, err)
+
+	select {
+	case gsCopy := <-updated:
+		assert.Equal(t, agonesv1.GameServerStateRequestReady, gsCopy.Status.State)
+		assert.Equal(t, agonesv1.True, gsCopy.ObjectMeta.Annotations[agonesv1.GameServerRecycleAnnotation])
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "Should have been patched")
+	}
+	agtesting.AssertEventContains(t, m.FakeRecorder.Events, "SDK state change")
+
+	sc.gsUpdateMutex.RLock()
+	defer sc.gsUpdateMutex.RUnlock()
+	assert.False(t, sc.gsRecycle)
+}
+
 func defaultSidecar(m agtesting.Mocks) (*SDKServer, error) {
-	server, err := NewSDKServer("test", "default", m.KubeClient, m.AgonesClient, logrus.DebugLevel, 8080, 500*time.Millisecond)
+	server, err := NewSDKServer("test", "default", m.KubeClient, m.AgonesClient, logrus.DebugLevel, 8080, 500*time.Millisecond, "")
 	if err != nil {
 		return server, err
 	}
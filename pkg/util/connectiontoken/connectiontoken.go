@@ -0,0 +1,302 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package connectiontoken signs and verifies the connection tokens that are returned with
+// an allocation, so game clients can prove to a GameServer that they were allocated to it.
+package connectiontoken
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"sync"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+const (
+	// Issuer is the issuer ("iss" claim) of connection tokens
+	Issuer = "agones.dev"
+
+	// DefaultTTL is the default lifetime of a connection token
+	DefaultTTL = 5 * time.Minute
+)
+
+var (
+	// ErrInvalidToken is returned when a connection token cannot be verified
+	ErrInvalidToken = errors.New("invalid connection token")
+
+	supportedAlgorithms = []jose.SignatureAlgorithm{jose.ES256, jose.ES384, jose.ES512, jose.RS256, jose.EdDSA}
+)
+
+// Claims are the verified claims of a connection token
+type Claims struct {
+	// ID is the unique identifier of the token, which can be used to reject a token that is presented more than once
+	ID string `json:"-"`
+	// Expiry is when the token expires
+	Expiry time.Time `json:"-"`
+	// Namespace of the allocated GameServer
+	Namespace string `json:"namespace"`
+	// GameServerName is the name of the allocated GameServer
+	GameServerName string `json:"gameServerName"`
+	// Address of the allocated GameServer
+	Address string `json:"address,omitempty"`
+	// Ports of the allocated GameServer
+	Ports []agonesv1.GameServerStatusPort `json:"ports,omitempty"`
+	// PlayerIDs are the players the token was requested for
+	PlayerIDs []string `json:"playerIDs,omitempty"`
+	// Claims are the custom claims the token was requested with
+	Claims map[string]string `json:"claims,omitempty"`
+}
+
+// Signer signs connection tokens
+type Signer struct {
+	signer jose.Signer
+	keys   jose.JSONWebKeySet
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// LoadSigner returns a Signer for the PEM encoded private key in the file at path.
+// Supported keys are ECDSA, RSA and Ed25519 keys, in PKCS #8, SEC 1 or PKCS #1 form.
+func LoadSigner(path string, ttl time.Duration) (*Signer, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read connection token signing key")
+	}
+	return NewSigner(b, ttl)
+}
+
+// NewSigner returns a Signer for the PEM encoded private key, that signs tokens that are valid for ttl.
+// A ttl of zero uses DefaultTTL.
+func NewSigner(keyPEM []byte, ttl time.Duration) (*Signer, error) {
+	key, err := parsePrivateKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	alg, err := algorithm(key)
+	if err != nil {
+		return nil, err
+	}
+
+	public := jose.JSONWebKey{Key: key.(crypto.Signer).Public(), Algorithm: string(alg), Use: "sig"}
+	thumbprint, err := public.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute connection token key id")
+	}
+	public.KeyID = base64.RawURLEncoding.EncodeToString(thumbprint)
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: jose.JSONWebKey{Key: key, KeyID: public.KeyID}},
+		(&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create connection token signer")
+	}
+
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+
+	return &Signer{
+		signer: signer,
+		keys:   jose.JSONWebKeySet{Keys: []jose.JSONWebKey{public}},
+		ttl:    ttl,
+		now:    time.Now,
+	}, nil
+}
+
+// Sign returns a connection token for the allocated GameServer, with the players and claims of the request.
+func (s *Signer) Sign(gs *agonesv1.GameServer, req *allocationv1.ConnectionTokenRequest) (string, error) {
+	now := s.now()
+	registered := jwt.Claims{
+		Issuer:    Issuer,
+		Subject:   gs.ObjectMeta.Name,
+		ID:        string(uuid.NewUUID()),
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now),
+		Expiry:    jwt.NewNumericDate(now.Add(s.ttl)),
+	}
+	claims := Claims{
+		Namespace:      gs.ObjectMeta.Namespace,
+		GameServerName: gs.ObjectMeta.Name,
+		Address:        gs.Status.Address,
+		Ports:          gs.Status.Ports,
+	}
+	if req != nil {
+		claims.PlayerIDs = req.PlayerIDs
+		claims.Claims = req.Claims
+	}
+
+	token, err := jwt.Signed(s.signer).Claims(registered).Claims(claims).Serialize()
+	return token, errors.Wrap(err, "could not sign connection token")
+}
+
+// JWKS returns the JSON Web Key Set of the public keys that verify the tokens of this Signer.
+func (s *Signer) JWKS() ([]byte, error) {
+	return json.Marshal(s.keys)
+}
+
+// Verifier verifies connection tokens for a single GameServer.
+// Its keys can be replaced with SetJWKS, such as when they are rotated.
+type Verifier struct {
+	namespace      string
+	gameServerName string
+	now            func() time.Time
+
+	mu   sync.RWMutex
+	keys jose.JSONWebKeySet
+	jwks string
+}
+
+// NewVerifier returns a Verifier for tokens of the GameServer, that are signed by one of the keys in
+// the JSON Web Key Set.
+func NewVerifier(namespace, gameServerName string, jwks []byte) (*Verifier, error) {
+	v := &Verifier{namespace: namespace, gameServerName: gameServerName, now: time.Now}
+	if err := v.SetJWKS(jwks); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// SetJWKS replaces the JSON Web Key Set that tokens are verified with.
+// The current keys are kept if the JSON Web Key Set is not valid.
+func (v *Verifier) SetJWKS(jwks []byte) error {
+	var keys jose.JSONWebKeySet
+	if err := json.Unmarshal(jwks, &keys); err != nil {
+		return errors.Wrap(err, "could not parse connection token JWKS")
+	}
+	if len(keys.Keys) == 0 {
+		return errors.New("connection token JWKS has no keys")
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.keys = keys
+	v.jwks = string(jwks)
+	return nil
+}
+
+// JWKS returns the JSON Web Key Set that tokens are verified with.
+func (v *Verifier) JWKS() string {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.jwks
+}
+
+// Verify verifies the signature and expiry of the token, and that it was issued for this GameServer.
+// Returns an error that wraps ErrInvalidToken if it is not valid.
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	tok, err := jwt.ParseSigned(token, supportedAlgorithms)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidToken, err.Error())
+	}
+
+	v.mu.RLock()
+	keys := v.keys
+	v.mu.RUnlock()
+
+	var registered jwt.Claims
+	var claims Claims
+	if err := tok.Claims(keys, &registered, &claims); err != nil {
+		return nil, errors.Wrap(ErrInvalidToken, err.Error())
+	}
+	if registered.Expiry == nil {
+		return nil, errors.Wrap(ErrInvalidToken, "token has no expiry")
+	}
+	// the default leeway of a minute allows for clock skew between the allocator and the node of the GameServer
+	if err := registered.Validate(jwt.Expected{
+		Issuer:  Issuer,
+		Subject: v.gameServerName,
+		Time:    v.now(),
+	}); err != nil {
+		return nil, errors.Wrap(ErrInvalidToken, err.Error())
+	}
+	if claims.Namespace != v.namespace || claims.GameServerName != v.gameServerName {
+		return nil, errors.Wrapf(ErrInvalidToken, "token was issued for GameServer %s/%s", claims.Namespace, claims.GameServerName)
+	}
+
+	claims.ID = registered.ID
+	claims.Expiry = registered.Expiry.Time()
+	return &claims, nil
+}
+
+// ParseUnverified returns the claims of the token without verifying its signature or expiry.
+// It must only be used where tokens cannot be verified, such as local development.
+func ParseUnverified(token string) (*Claims, error) {
+	tok, err := jwt.ParseSigned(token, supportedAlgorithms)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidToken, err.Error())
+	}
+
+	var registered jwt.Claims
+	var claims Claims
+	if err := tok.UnsafeClaimsWithoutVerification(&registered, &claims); err != nil {
+		return nil, errors.Wrap(ErrInvalidToken, err.Error())
+	}
+
+	claims.ID = registered.ID
+	if registered.Expiry != nil {
+		claims.Expiry = registered.Expiry.Time()
+	}
+	return &claims, nil
+}
+
+func parsePrivateKey(keyPEM []byte) (interface{}, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("connection token signing key is not PEM encoded")
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, errors.New("connection token signing key is not a supported private key")
+}
+
+func algorithm(key interface{}) (jose.SignatureAlgorithm, error) {
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		switch k.Curve {
+		case elliptic.P256():
+			return jose.ES256, nil
+		case elliptic.P384():
+			return jose.ES384, nil
+		case elliptic.P521():
+			return jose.ES512, nil
+		}
+	case *rsa.PrivateKey:
+		return jose.RS256, nil
+	case ed25519.PrivateKey:
+		return jose.EdDSA, nil
+	}
+	return "", errors.Errorf("connection token signing key of type %T is not supported", key)
+}
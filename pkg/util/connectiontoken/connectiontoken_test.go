@@ -0,0 +1,244 @@
+// Copyright 2026 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectiontoken
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newKeyPEM(t *testing.T, key interface{}) []byte {
+	b, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: b})
+}
+
+func newTestSigner(t *testing.T) *Signer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	s, err := NewSigner(newKeyPEM(t, key), time.Minute)
+	require.NoError(t, err)
+	return s
+}
+
+func newTestVerifier(t *testing.T, s *Signer, namespace, name string) *Verifier {
+	jwks, err := s.JWKS()
+	require.NoError(t, err)
+	v, err := NewVerifier(namespace, name, jwks)
+	require.NoError(t, err)
+	return v
+}
+
+func TestNewSigner(t *testing.T) {
+	t.Parallel()
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	ecBytes, err := x509.MarshalECPrivateKey(ecKey)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	fixtures := map[string]struct {
+		keyPEM  []byte
+		wantAlg string
+	}{
+		"ecdsa pkcs8": {keyPEM: newKeyPEM(t, ecKey), wantAlg: "ES384"},
+		"ecdsa sec1":  {keyPEM: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecBytes}), wantAlg: "ES384"},
+		"rsa pkcs1":   {keyPEM: pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}), wantAlg: "RS256"},
+		"ed25519":     {keyPEM: newKeyPEM(t, edKey), wantAlg: "EdDSA"},
+		"not pem":     {keyPEM: []byte("not a key")},
+		"not a key":   {keyPEM: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("nope")})},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			s, err := NewSigner(v.keyPEM, 0)
+			if v.wantAlg == "" {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, DefaultTTL, s.ttl)
+			require.Len(t, s.keys.Keys, 1)
+			assert.Equal(t, v.wantAlg, s.keys.Keys[0].Algorithm)
+			assert.True(t, s.keys.Keys[0].IsPublic())
+			assert.NotEmpty(t, s.keys.Keys[0].KeyID)
+		})
+	}
+}
+
+func TestLoadSigner(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "tls.key")
+	require.NoError(t, os.WriteFile(path, newKeyPEM(t, key), 0o600))
+
+	s, err := LoadSigner(path, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, s.ttl)
+
+	_, err = LoadSigner(filepath.Join(t.TempDir(), "missing"), time.Hour)
+	assert.Error(t, err)
+}
+
+func TestSignAndVerify(t *testing.T) {
+	t.Parallel()
+
+	s := newTestSigner(t)
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	gs := &agonesv1.GameServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "gs1", Namespace: "default"},
+		Status: agonesv1.GameServerStatus{
+			Address: "10.0.0.1",
+			Ports:   []agonesv1.GameServerStatusPort{{Name: "game", Port: 7777}},
+		},
+	}
+	token, err := s.Sign(gs, &allocationv1.ConnectionTokenRequest{
+		PlayerIDs: []string{"player1", "player2"},
+		Claims:    map[string]string{"team": "red"},
+	})
+	require.NoError(t, err)
+
+	claims, err := newTestVerifier(t, s, "default", "gs1").Verify(token)
+	require.NoError(t, err)
+	assert.NotEmpty(t, claims.ID)
+	assert.Equal(t, now.Add(time.Minute).Unix(), claims.Expiry.Unix())
+	assert.Equal(t, "default", claims.Namespace)
+	assert.Equal(t, "gs1", claims.GameServerName)
+	assert.Equal(t, "10.0.0.1", claims.Address)
+	assert.Equal(t, gs.Status.Ports, claims.Ports)
+	assert.Equal(t, []string{"player1", "player2"}, claims.PlayerIDs)
+	assert.Equal(t, map[string]string{"team": "red"}, claims.Claims)
+
+	unverified, err := ParseUnverified(token)
+	require.NoError(t, err)
+	assert.Equal(t, claims, unverified)
+
+	// each token is unique
+	again, err := s.Sign(gs, nil)
+	require.NoError(t, err)
+	againClaims, err := newTestVerifier(t, s, "default", "gs1").Verify(again)
+	require.NoError(t, err)
+	assert.NotEqual(t, claims.ID, againClaims.ID)
+	assert.Empty(t, againClaims.PlayerIDs)
+}
+
+func TestVerifierVerify(t *testing.T) {
+	t.Parallel()
+
+	s := newTestSigner(t)
+	gs := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "gs1", Namespace: "default"}}
+	token, err := s.Sign(gs, nil)
+	require.NoError(t, err)
+
+	expired := newTestSigner(t)
+	expired.now = func() time.Time { return time.Now().Add(-time.Hour) }
+	expiredToken, err := expired.Sign(gs, nil)
+	require.NoError(t, err)
+
+	fixtures := map[string]struct {
+		verifier *Verifier
+		token    string
+	}{
+		"other GameServer": {
+			verifier: newTestVerifier(t, s, "default", "gs2"),
+			token:    token,
+		},
+		"other namespace": {
+			verifier: newTestVerifier(t, s, "other", "gs1"),
+			token:    token,
+		},
+		"other key": {
+			verifier: newTestVerifier(t, newTestSigner(t), "default", "gs1"),
+			token:    token,
+		},
+		"expired": {
+			verifier: newTestVerifier(t, expired, "default", "gs1"),
+			token:    expiredToken,
+		},
+		"not a token": {
+			verifier: newTestVerifier(t, s, "default", "gs1"),
+			token:    "not.a.token",
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			_, err := v.verifier.Verify(v.token)
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+}
+
+func TestNewVerifier(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewVerifier("default", "gs1", []byte(`{"keys":[]}`))
+	assert.Error(t, err)
+	_, err = NewVerifier("default", "gs1", []byte(`nope`))
+	assert.Error(t, err)
+
+	v := newTestVerifier(t, newTestSigner(t), "default", "gs1")
+	assert.Contains(t, v.JWKS(), `"kid"`)
+	assert.NotContains(t, v.JWKS(), `"d"`)
+}
+
+func TestVerifierSetJWKS(t *testing.T) {
+	t.Parallel()
+
+	old := newTestSigner(t)
+	v := newTestVerifier(t, old, "default", "gs1")
+	gs := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "gs1", Namespace: "default"}}
+
+	rotated := newTestSigner(t)
+	token, err := rotated.Sign(gs, nil)
+	require.NoError(t, err)
+	_, err = v.Verify(token)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	// invalid keys are ignored
+	before := v.JWKS()
+	assert.Error(t, v.SetJWKS([]byte(`{"keys":[]}`)))
+	assert.Equal(t, before, v.JWKS())
+
+	jwks, err := rotated.JWKS()
+	require.NoError(t, err)
+	require.NoError(t, v.SetJWKS(jwks))
+	assert.Equal(t, string(jwks), v.JWKS())
+	_, err = v.Verify(token)
+	assert.NoError(t, err)
+}
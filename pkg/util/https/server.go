@@ -79,6 +79,9 @@ func (s *Server) setupServer() {
 		Handler: s.Mux,
 		TLSConfig: &cryptotls.Config{
 			GetCertificate: s.getCertificate,
+			// the Kubernetes API server only presents its front proxy client certificate if one is requested,
+			// which is verified by the handlers that trust the user it proxies
+			ClientAuth: cryptotls.RequestClientCert,
 		},
 	}
 
@@ -82,9 +82,75 @@ const (
 	////////////////
 	// Dev features
 
+	// FeatureAllocationPayload is a feature flag to enable/disable opaque allocation payloads that game servers retrieve through the SDK.
+	FeatureAllocationPayload Feature = "AllocationPayload"
+
+	// FeatureAllocatorQuotas is a feature flag to enable/disable per-client quotas and rate limiting in the allocator service.
+	FeatureAllocatorQuotas Feature = "AllocatorQuotas"
+
+	// FeatureAllocatorRelease is a feature flag to enable/disable releasing Allocated GameServers through the allocator service.
+	FeatureAllocatorRelease Feature = "AllocatorRelease"
+
+	// FeatureAllocatorTokenAuth is a feature flag to enable/disable bearer token (JWT/OIDC) authentication in the allocator service.
+	FeatureAllocatorTokenAuth Feature = "AllocatorTokenAuth"
+
+	// FeatureCapacityQuery is a feature flag to enable/disable counting the GameServers matching allocation selectors without allocating.
+	FeatureCapacityQuery Feature = "CapacityQuery"
+
+	// FeatureConnectionTokens is a feature flag to enable/disable signed connection tokens for allocated GameServers.
+	FeatureConnectionTokens Feature = "ConnectionTokens"
+
+	// FeatureCrashLoopBackoff is a feature flag to enable/disable backing off the creation of replacement GameServers when those of a GameServerSet keep failing before Ready.
+	FeatureCrashLoopBackoff Feature = "CrashLoopBackoff"
+
+	// FeatureCrossNamespaceAllocation is a feature flag to enable/disable allocating from multiple namespaces with a single GameServerAllocation.
+	FeatureCrossNamespaceAllocation Feature = "CrossNamespaceAllocation"
+
+	// FeatureGameServerLifetime is a feature flag to enable/disable limits on the total lifetime of GameServers, and how long they can stay Ready or Allocated.
+	FeatureGameServerLifetime Feature = "GameServerLifetime"
+
+	// FeatureGameServerStateHistory is a feature flag to enable/disable recording the recent State transitions of GameServers in their Status.
+	FeatureGameServerStateHistory Feature = "GameServerStateHistory"
+
+	// FeatureHealthReports is a feature flag to enable/disable SDK health reports with a reason, and the Degraded health status.
+	FeatureHealthReports Feature = "HealthReports"
+
+	// FeatureIdleGameServers is a feature flag to enable/disable shutting down, or returning to Ready, Allocated GameServers that have been empty for a period of time.
+	FeatureIdleGameServers Feature = "IdleGameServers"
+
+	// FeatureLifecyclePriorities is a feature flag to enable/disable Priorities by creation time, Ready time and GameServerSet revision.
+	FeatureLifecyclePriorities Feature = "LifecyclePriorities"
+
+	// FeatureMetaPatchTemplates is a feature flag to enable/disable templated values in the MetaPatch of GameServerAllocations.
+	FeatureMetaPatchTemplates Feature = "MetaPatchTemplates"
+
+	// FeatureMinReadySeconds is a feature flag to enable/disable the minReadySeconds warm-up period of GameServers before they can be allocated.
+	FeatureMinReadySeconds Feature = "MinReadySeconds"
+
+	// FeaturePostMortemRetention is a feature flag to enable/disable keeping failed GameServers of a Fleet, and their Pods, for inspection.
+	FeaturePostMortemRetention Feature = "PostMortemRetention"
+
 	// FeatureProcessorAllocator is a feature flag to enable/disable the processor allocator feature.
 	FeatureProcessorAllocator = "ProcessorAllocator"
 
+	// FeatureProcessorSharding is a feature flag to run multiple active processors, each owning a partition of allocation requests.
+	FeatureProcessorSharding Feature = "ProcessorSharding"
+
+	// FeatureReadinessGates is a feature flag to enable/disable readinessGates on GameServers, which hold a GameServer in RequestReady until its conditions are True.
+	FeatureReadinessGates Feature = "ReadinessGates"
+
+	// FeatureRecycleGameServers is a feature flag to enable/disable SDK.Recycle(), which resets a GameServer and returns it to Ready, keeping its Pod.
+	FeatureRecycleGameServers Feature = "RecycleGameServers"
+
+	// FeatureSidecarHealthProbes is a feature flag to enable/disable health probes of the game server process run by the SDK server sidecar, instead of SDK Health() pings.
+	FeatureSidecarHealthProbes Feature = "SidecarHealthProbes"
+
+	// FeatureTerminationNotices is a feature flag to enable/disable notifying game servers through the SDK that their GameServer is about to be terminated.
+	FeatureTerminationNotices Feature = "TerminationNotices"
+
+	// FeatureWasmAllocationScoring is a feature flag to enable/disable scoring of candidate GameServers for allocation with a WebAssembly module.
+	FeatureWasmAllocationScoring Feature = "WasmAllocationScoring"
+
 	////////////////
 	// Example feature
 
@@ -169,7 +235,29 @@ var (
 		FeatureWasmAutoscaler:         false,
 
 		// Dev features
-		FeatureProcessorAllocator: false,
+		FeatureAllocationPayload:        false,
+		FeatureAllocatorQuotas:          false,
+		FeatureAllocatorRelease:         false,
+		FeatureAllocatorTokenAuth:       false,
+		FeatureCapacityQuery:            false,
+		FeatureConnectionTokens:         false,
+		FeatureCrashLoopBackoff:         false,
+		FeatureCrossNamespaceAllocation: false,
+		FeatureGameServerLifetime:       false,
+		FeatureGameServerStateHistory:   false,
+		FeatureHealthReports:            false,
+		FeatureIdleGameServers:          false,
+		FeatureLifecyclePriorities:      false,
+		FeatureMetaPatchTemplates:       false,
+		FeatureMinReadySeconds:          false,
+		FeaturePostMortemRetention:      false,
+		FeatureProcessorAllocator:       false,
+		FeatureProcessorSharding:        false,
+		FeatureReadinessGates:           false,
+		FeatureRecycleGameServers:       false,
+		FeatureSidecarHealthProbes:      false,
+		FeatureTerminationNotices:       false,
+		FeatureWasmAllocationScoring:    false,
 
 		// Example feature
 		FeatureExample: false,
@@ -16,6 +16,7 @@ package sdk
 
 import (
 	"context"
+	"io"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
@@ -23,6 +24,10 @@ import (
 	"agones.dev/agones/pkg/sdk/alpha"
 )
 
+// TerminationCallback is a function definition to be called
+// when the GameServer is about to be terminated.
+type TerminationCallback func(notice *alpha.TerminationNotice)
+
 // Alpha is the struct for Alpha SDK functionality.
 type Alpha struct {
 	client alpha.SDKClient
@@ -83,3 +88,69 @@ func (a *Alpha) GetConnectedPlayers() ([]string, error) {
 	list, err := a.client.GetConnectedPlayers(context.Background(), &alpha.Empty{})
 	return list.GetList(), errors.Wrap(err, "could not list connected players")
 }
+
+// GetConnectionTokenKeys returns the JSON Web Key Set that verifies the connection tokens
+// issued when this GameServer is allocated.
+func (a *Alpha) GetConnectionTokenKeys() (string, error) {
+	keys, err := a.client.GetConnectionTokenKeys(context.Background(), &alpha.Empty{})
+	return keys.GetJwks(), errors.Wrap(err, "could not get connection token keys")
+}
+
+// VerifyConnectionToken verifies that a connection token presented by a game client was issued
+// for an allocation of this GameServer, and returns its claims.
+func (a *Alpha) VerifyConnectionToken(token string) (*alpha.ConnectionTokenClaims, error) {
+	claims, err := a.client.VerifyConnectionToken(context.Background(), &alpha.ConnectionToken{Token: token})
+	return claims, errors.Wrap(err, "could not verify connection token")
+}
+
+// GetAllocationPayload returns the payload of the allocation this GameServer was last allocated with,
+// such as a match configuration. The payload is empty if the allocation had no payload.
+func (a *Alpha) GetAllocationPayload() ([]byte, error) {
+	payload, err := a.client.GetAllocationPayload(context.Background(), &alpha.Empty{})
+	return payload.GetPayload(), errors.Wrap(err, "could not get allocation payload")
+}
+
+// SetCondition sets a condition of this GameServer to True or False. A GameServer that has readiness gates stays
+// in RequestReady after Ready() is called, until every condition its readiness gates refer to is True.
+func (a *Alpha) SetCondition(conditionType string, status bool, message string) error {
+	_, err := a.client.SetCondition(context.Background(), &alpha.Condition{Type: conditionType, Status: status, Message: message})
+	return errors.Wrap(err, "could not set condition")
+}
+
+// Recycle resets this GameServer for a new session, and moves it back to Ready, keeping its Pod and ports.
+// Labels and annotations are reset to the ones of the Fleet template, and Counters, Lists and player tracking
+// are reset to their initial values.
+func (a *Alpha) Recycle() error {
+	_, err := a.client.Recycle(context.Background(), &alpha.Empty{})
+	return errors.Wrap(err, "could not recycle")
+}
+
+// ReportHealth reports the health of this GameServer, one of "Healthy", "Degraded" or "Unhealthy", with a reason.
+// A Healthy or Degraded report counts as a health ping. A Degraded GameServer can no longer be allocated,
+// but keeps running, and an Unhealthy one is moved to Unhealthy.
+func (a *Alpha) ReportHealth(status, reason string) error {
+	_, err := a.client.ReportHealth(context.Background(), &alpha.HealthReport{Status: status, Reason: reason})
+	return errors.Wrap(err, "could not report health")
+}
+
+// WatchTermination asynchronously calls the given TerminationCallback when this GameServer is about to be
+// terminated, with the reason and the deadline by which it will have been stopped, and again if either changes.
+func (a *Alpha) WatchTermination(f TerminationCallback) error {
+	stream, err := a.client.WatchTermination(context.Background(), &alpha.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not watch termination")
+	}
+	go func() {
+		for {
+			notice, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF {
+					Logger("error watching termination", err)
+				}
+				return
+			}
+			f(notice)
+		}
+	}()
+	return nil
+}
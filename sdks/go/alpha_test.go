@@ -16,7 +16,9 @@ package sdk
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
@@ -72,11 +74,100 @@ func TestAlphaGetAndSetPlayerCapacity(t *testing.T) {
 	assert.Equal(t, []string{playerID}, list)
 }
 
+func TestAlphaConnectionTokens(t *testing.T) {
+	mock := &alphaMock{}
+	a := Alpha{
+		client: mock,
+	}
+
+	jwks, err := a.GetConnectionTokenKeys()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"keys":[]}`, jwks)
+
+	claims, err := a.VerifyConnectionToken("token")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"player1"}, claims.PlayerIDs)
+
+	_, err = a.VerifyConnectionToken("invalid")
+	assert.EqualError(t, err, "could not verify connection token: invalid connection token")
+}
+
+func TestAlphaGetAllocationPayload(t *testing.T) {
+	mock := &alphaMock{}
+	a := Alpha{
+		client: mock,
+	}
+
+	payload, err := a.GetAllocationPayload()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`{"teams":2}`), payload)
+}
+
+func TestAlphaSetCondition(t *testing.T) {
+	mock := &alphaMock{}
+	a := Alpha{
+		client: mock,
+	}
+
+	err := a.SetCondition("AssetsCached", true, "all assets loaded")
+	assert.NoError(t, err)
+	assert.Equal(t, "AssetsCached", mock.condition.GetType())
+	assert.True(t, mock.condition.GetStatus())
+	assert.Equal(t, "all assets loaded", mock.condition.GetMessage())
+}
+
+func TestAlphaRecycle(t *testing.T) {
+	mock := &alphaMock{}
+	a := Alpha{
+		client: mock,
+	}
+
+	assert.NoError(t, a.Recycle())
+	assert.True(t, mock.recycled)
+}
+
+func TestAlphaReportHealth(t *testing.T) {
+	mock := &alphaMock{}
+	a := Alpha{
+		client: mock,
+	}
+
+	assert.NoError(t, a.ReportHealth("Degraded", "lost connection to backend"))
+	assert.Equal(t, "Degraded", mock.healthReport.GetStatus())
+	assert.Equal(t, "lost connection to backend", mock.healthReport.GetReason())
+}
+
+func TestAlphaWatchTermination(t *testing.T) {
+	mock := &alphaMock{tm: &terminationWatchMock{msgs: make(chan *alpha.TerminationNotice, 5)}}
+	a := Alpha{
+		client: mock,
+	}
+
+	notices := make(chan *alpha.TerminationNotice, 5)
+	assert.NoError(t, a.WatchTermination(func(notice *alpha.TerminationNotice) {
+		notices <- notice
+	}))
+
+	mock.tm.msgs <- &alpha.TerminationNotice{Reason: "NodeDrain", Deadline: 1700000000}
+
+	select {
+	case notice := <-notices:
+		assert.Equal(t, "NodeDrain", notice.GetReason())
+		assert.Equal(t, int64(1700000000), notice.GetDeadline())
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "termination handler should have fired")
+	}
+}
+
 type alphaMock struct {
 	capacity           int64
 	playerCount        int64
 	playerConnected    string
 	playerDisconnected string
+	condition          *alpha.Condition
+	recycled           bool
+	tm                 *terminationWatchMock
+	healthReport       *alpha.HealthReport
 }
 
 func (a *alphaMock) PlayerConnect(_ context.Context, id *alpha.PlayerID, _ ...grpc.CallOption) (*alpha.Bool, error) {
@@ -111,3 +202,46 @@ func (a *alphaMock) GetPlayerCapacity(_ context.Context, _ *alpha.Empty, _ ...gr
 func (a *alphaMock) GetPlayerCount(_ context.Context, _ *alpha.Empty, _ ...grpc.CallOption) (*alpha.Count, error) {
 	return &alpha.Count{Count: a.playerCount}, nil
 }
+
+func (a *alphaMock) GetConnectionTokenKeys(_ context.Context, _ *alpha.Empty, _ ...grpc.CallOption) (*alpha.ConnectionTokenKeys, error) {
+	return &alpha.ConnectionTokenKeys{Jwks: `{"keys":[]}`}, nil
+}
+
+func (a *alphaMock) VerifyConnectionToken(_ context.Context, in *alpha.ConnectionToken, _ ...grpc.CallOption) (*alpha.ConnectionTokenClaims, error) {
+	if in.Token != "token" {
+		return nil, errors.New("invalid connection token")
+	}
+	return &alpha.ConnectionTokenClaims{PlayerIDs: []string{"player1"}}, nil
+}
+
+func (a *alphaMock) GetAllocationPayload(_ context.Context, _ *alpha.Empty, _ ...grpc.CallOption) (*alpha.AllocationPayload, error) {
+	return &alpha.AllocationPayload{Payload: []byte(`{"teams":2}`)}, nil
+}
+
+func (a *alphaMock) SetCondition(_ context.Context, in *alpha.Condition, _ ...grpc.CallOption) (*alpha.Empty, error) {
+	a.condition = in
+	return &alpha.Empty{}, nil
+}
+
+func (a *alphaMock) Recycle(_ context.Context, e *alpha.Empty, _ ...grpc.CallOption) (*alpha.Empty, error) {
+	a.recycled = true
+	return e, nil
+}
+
+func (a *alphaMock) ReportHealth(_ context.Context, in *alpha.HealthReport, _ ...grpc.CallOption) (*alpha.Empty, error) {
+	a.healthReport = in
+	return &alpha.Empty{}, nil
+}
+
+func (a *alphaMock) WatchTermination(_ context.Context, _ *alpha.Empty, _ ...grpc.CallOption) (alpha.SDK_WatchTerminationClient, error) {
+	return a.tm, nil
+}
+
+type terminationWatchMock struct {
+	grpc.ClientStream
+	msgs chan *alpha.TerminationNotice
+}
+
+func (tm *terminationWatchMock) Recv() (*alpha.TerminationNotice, error) {
+	return <-tm.msgs, nil
+}